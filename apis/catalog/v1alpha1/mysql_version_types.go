@@ -143,6 +143,9 @@ type MySQLUpdateConstraints struct {
 	Allowlist MySQLVersionAllowlist `json:"allowlist,omitempty"`
 	// List of all rejected versions for upgrade request
 	Denylist MySQLVersionDenylist `json:"denylist,omitempty"`
+	// List of target versions an update from this version can be rolled back from.
+	// Each entry is a semver constraint. An empty list indicates updates from this version are not reversible.
+	Reversible []string `json:"reversible,omitempty"`
 }
 
 type MySQLVersionAllowlist struct {
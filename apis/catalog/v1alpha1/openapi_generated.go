@@ -38285,6 +38285,21 @@ func schema_apimachinery_apis_catalog_v1alpha1_MySQLUpdateConstraints(ref common
 							Ref:         ref("kubedb.dev/apimachinery/apis/catalog/v1alpha1.MySQLVersionDenylist"),
 						},
 					},
+					"reversible": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of target versions an update from this version can be rolled back from. Each entry is a semver constraint. An empty list indicates updates from this version are not reversible.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -42238,6 +42253,21 @@ func schema_apimachinery_apis_catalog_v1alpha1_UpdateConstraints(ref common.Refe
 							},
 						},
 					},
+					"reversible": {
+						SchemaProps: spec.SchemaProps{
+							Description: "List of target versions an update from this version can be rolled back from. Each entry is a semver constraint. An empty list indicates updates from this version are not reversible.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
@@ -33,6 +33,9 @@ type UpdateConstraints struct {
 	// List of all rejected versions for upgrade request.
 	// An empty list indicates no version is rejected.
 	Denylist []string `json:"denylist,omitempty"`
+	// List of target versions an update from this version can be rolled back from.
+	// Each entry is a semver constraint. An empty list indicates updates from this version are not reversible.
+	Reversible []string `json:"reversible,omitempty"`
 }
 
 type ArchiverSpec struct {
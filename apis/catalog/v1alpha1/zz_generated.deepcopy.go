@@ -2744,6 +2744,11 @@ func (in *MySQLUpdateConstraints) DeepCopyInto(out *MySQLUpdateConstraints) {
 	*out = *in
 	in.Allowlist.DeepCopyInto(&out.Allowlist)
 	in.Denylist.DeepCopyInto(&out.Denylist)
+	if in.Reversible != nil {
+		in, out := &in.Reversible, &out.Reversible
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -4998,6 +5003,11 @@ func (in *UpdateConstraints) DeepCopyInto(out *UpdateConstraints) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Reversible != nil {
+		in, out := &in.Reversible, &out.Reversible
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -44,5 +44,14 @@ var Funcs = func(codecs runtimeserializer.CodecFactory) []any {
 		func(s *v1alpha1.MSSQLServerMigration, c randfill.Continue) {
 			c.Fill(s) // fuzz self without calling this function again
 		},
+		func(s *v1alpha1.RedisMigration, c randfill.Continue) {
+			c.Fill(s) // fuzz self without calling this function again
+		},
+		func(s *v1alpha1.ElasticsearchMigration, c randfill.Continue) {
+			c.Fill(s) // fuzz self without calling this function again
+		},
+		func(s *v1alpha1.MySQLToPostgresMigration, c randfill.Continue) {
+			c.Fill(s) // fuzz self without calling this function again
+		},
 	}
 }
@@ -38,6 +38,10 @@ func TestPruneTypes(t *testing.T) {
 		(v1alpha1.MariaDBMigration{}).CustomResourceDefinition(),
 		(v1alpha1.MongoDBMigration{}).CustomResourceDefinition(),
 		(v1alpha1.MSSQLServerMigration{}).CustomResourceDefinition(),
+		(v1alpha1.RedisMigration{}).CustomResourceDefinition(),
+		(v1alpha1.ElasticsearchMigration{}).CustomResourceDefinition(),
+		(v1alpha1.MySQLToPostgresMigration{}).CustomResourceDefinition(),
+		(v1alpha1.BranchQuota{}).CustomResourceDefinition(),
 	}
 
 	// CRD v1
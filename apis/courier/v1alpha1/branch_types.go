@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
 )
@@ -64,7 +65,8 @@ type Branch struct {
 // BranchSpec defines the desired state of Branch. One Branch CR is one branch, and it doubles as the
 // session object.
 type BranchSpec struct {
-	// Source is the KubeDB Database whose storage is cloned. Branch has no external source.
+	// Source is the KubeDB Database whose storage is cloned, or whose archiver backups are restored.
+	// Branch has no external source.
 	Source BranchSource `json:"source"`
 
 	// Target describes only what differs from the source: the target cluster, namespace, name,
@@ -81,6 +83,11 @@ type BranchSpec struct {
 	// +optional
 	DataMassageImage string `json:"dataMassageImage,omitempty"`
 
+	// Masking is a built-in column masking and row subsetting policy applied after provisioning and
+	// before DataMassageImage. Supported for Postgres, MySQL, MariaDB and MongoDB branches.
+	// +optional
+	Masking *BranchMaskingPolicy `json:"masking,omitempty"`
+
 	// Schedule optionally refreshes the branch on a cron cadence. Omit for a one-shot branch.
 	// +optional
 	Schedule *BranchSchedule `json:"schedule,omitempty"`
@@ -100,6 +107,48 @@ type BranchSpec struct {
 	// +kubebuilder:default=Delete
 	// +optional
 	DeletionPolicy BranchDeletionPolicy `json:"deletionPolicy,omitempty"`
+
+	// Expiry deletes the Branch, honoring DeletionPolicy, once it expires. Omit to keep the branch
+	// until it is deleted by hand.
+	// +optional
+	Expiry *BranchExpiry `json:"expiry,omitempty"`
+
+	// SchemaDiff requests a schema diff of the branch against its source. Supported for Postgres,
+	// MySQL and MariaDB branches.
+	// +optional
+	SchemaDiff *BranchSchemaDiffRequest `json:"schemaDiff,omitempty"`
+}
+
+// BranchSchemaDiffRequest requests a schema diff. The diff is computed once per RequestID, so a new
+// diff is taken on demand by changing RequestID.
+type BranchSchemaDiffRequest struct {
+	// RequestID identifies the request. status.schemaDiff.requestID is set to it once the diff is done.
+	RequestID string `json:"requestID"`
+
+	// Databases limits the diff to these databases (Postgres schemas are compared within each
+	// database). All non-system databases are compared when empty.
+	// +optional
+	Databases []string `json:"databases,omitempty"`
+
+	// ExcludeObjectTypes skips these kinds of objects in the diff.
+	// +optional
+	ExcludeObjectTypes []BranchSchemaObjectType `json:"excludeObjectTypes,omitempty"`
+}
+
+// BranchExpiry sets when a Branch expires. Exactly one of TTL and ExpiresAt must be set.
+type BranchExpiry struct {
+	// TTL is the lifetime of the branch, counted from its creation.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+
+	// ExpiresAt is the absolute time the branch expires.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// WarningPeriod is how long before expiry the BranchExpiringSoon condition is raised (default 24h).
+	// +kubebuilder:default="24h"
+	// +optional
+	WarningPeriod *metav1.Duration `json:"warningPeriod,omitempty"`
 }
 
 // BranchSource points at a KubeDB Database.
@@ -110,6 +159,37 @@ type BranchSource struct {
 	// Namespace of the source Database. Defaults to the Branch's namespace when empty.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+
+	// Archiver restores the branch from the source's archiver backups (full backup plus WAL/binlog/oplog
+	// archive) as of a point in time, instead of cloning the source's live PVCs through VolumeSnapshots.
+	// The source Database's volumes are never touched and no CSI snapshot support is needed, so
+	// spec.volumeSnapshotClassName must not be set.
+	// +optional
+	Archiver *BranchArchiverSource `json:"archiver,omitempty"`
+}
+
+// BranchArchiverSource selects the archiver backups and the point in time a branch is restored from.
+// It mirrors the fields of the KubeDB ArchiverRecovery (kubedb.com/v1) the operator sets as
+// spec.init.archiver on the target Database; it is redeclared here because the kubedb API group
+// imports courier.
+type BranchArchiverSource struct {
+	// RecoveryTimestamp is the point in time the branch is restored to. When empty, the latest
+	// recoverable point is used, and every scheduled refresh moves the branch to the then latest point.
+	// +optional
+	RecoveryTimestamp *metav1.Time `json:"recoveryTimestamp,omitempty"`
+
+	// EncryptionSecret refers to the Secret holding the encryption key of the backup repositories.
+	// +optional
+	EncryptionSecret *kmapi.ObjectReference `json:"encryptionSecret,omitempty"`
+
+	// ManifestRepository refers to the repository holding the source Database manifest backups. Required.
+	// +optional
+	ManifestRepository *kmapi.ObjectReference `json:"manifestRepository,omitempty"`
+
+	// FullDBRepository refers to the repository holding the full database backups (db restore +
+	// manifest restore). Required.
+	// +optional
+	FullDBRepository *kmapi.ObjectReference `json:"fullDBRepository,omitempty"`
 }
 
 // BranchTarget describes the target Database. spec.target.cluster equal to the source's cluster is a
@@ -143,6 +223,105 @@ type BranchTarget struct {
 	IssuerRef *corev1.TypedLocalObjectReference `json:"issuerRef,omitempty"`
 }
 
+// BranchMaskingPolicy lists the masking rules applied to the branch data.
+type BranchMaskingPolicy struct {
+	// SaltSecretRef selects a Secret key used to salt Hash rules, so hashed values stay consistent
+	// across tables but cannot be reversed with a dictionary of known inputs. A random salt is
+	// generated per branch when empty.
+	// +optional
+	SaltSecretRef *corev1.SecretKeySelector `json:"saltSecretRef,omitempty"`
+
+	// Rules are the per-table (or per-collection) masking rules, applied in order.
+	Rules []BranchMaskingRule `json:"rules"`
+}
+
+// BranchMaskingRule masks the columns of one table and optionally drops the rows outside a filter.
+type BranchMaskingRule struct {
+	// Table is the table or collection, in schema.table (Postgres) or database.table (MySQL, MariaDB,
+	// MongoDB) form.
+	Table string `json:"table"`
+
+	// Columns are the column (or MongoDB field path) rules of the table.
+	// +optional
+	Columns []BranchColumnMask `json:"columns,omitempty"`
+
+	// RowFilter keeps only the rows matching it and deletes the rest. It is a SQL boolean expression
+	// (the WHERE clause without the keyword) for SQL engines and a JSON query document for MongoDB.
+	// +optional
+	RowFilter string `json:"rowFilter,omitempty"`
+}
+
+// BranchMaskStrategy is how a column value is replaced.
+// +kubebuilder:validation:Enum=Hash;Redact;Faker;Null
+type BranchMaskStrategy string
+
+const (
+	// BranchMaskStrategyHash replaces the value with a salted SHA-256 hash, preserving joins on the column.
+	BranchMaskStrategyHash BranchMaskStrategy = "Hash"
+	// BranchMaskStrategyRedact replaces the value with a fixed mask, optionally keeping a prefix and suffix.
+	BranchMaskStrategyRedact BranchMaskStrategy = "Redact"
+	// BranchMaskStrategyFaker replaces the value with a realistic generated value of FakerType.
+	BranchMaskStrategyFaker BranchMaskStrategy = "Faker"
+	// BranchMaskStrategyNull sets the value to NULL (or unsets the field for MongoDB).
+	BranchMaskStrategyNull BranchMaskStrategy = "Null"
+)
+
+// BranchFakerType is the kind of value generated by the Faker strategy.
+// +kubebuilder:validation:Enum=Name;FirstName;LastName;Email;Phone;Address;City;Country;PostalCode;Company;Username;IPAddress;CreditCard;UUID;Date;Text
+type BranchFakerType string
+
+const (
+	BranchFakerTypeName       BranchFakerType = "Name"
+	BranchFakerTypeFirstName  BranchFakerType = "FirstName"
+	BranchFakerTypeLastName   BranchFakerType = "LastName"
+	BranchFakerTypeEmail      BranchFakerType = "Email"
+	BranchFakerTypePhone      BranchFakerType = "Phone"
+	BranchFakerTypeAddress    BranchFakerType = "Address"
+	BranchFakerTypeCity       BranchFakerType = "City"
+	BranchFakerTypeCountry    BranchFakerType = "Country"
+	BranchFakerTypePostalCode BranchFakerType = "PostalCode"
+	BranchFakerTypeCompany    BranchFakerType = "Company"
+	BranchFakerTypeUsername   BranchFakerType = "Username"
+	BranchFakerTypeIPAddress  BranchFakerType = "IPAddress"
+	BranchFakerTypeCreditCard BranchFakerType = "CreditCard"
+	BranchFakerTypeUUID       BranchFakerType = "UUID"
+	BranchFakerTypeDate       BranchFakerType = "Date"
+	BranchFakerTypeText       BranchFakerType = "Text"
+)
+
+// BranchColumnMask masks a single column.
+type BranchColumnMask struct {
+	// Column is the column name, or the dotted field path for MongoDB.
+	Column string `json:"column"`
+
+	// Strategy is how the column value is replaced.
+	Strategy BranchMaskStrategy `json:"strategy"`
+
+	// FakerType is the kind of generated value. Required for the Faker strategy.
+	// +optional
+	FakerType BranchFakerType `json:"fakerType,omitempty"`
+
+	// Redact configures the Redact strategy.
+	// +optional
+	Redact *BranchRedactOptions `json:"redact,omitempty"`
+}
+
+// BranchRedactOptions configures the Redact strategy.
+type BranchRedactOptions struct {
+	// Mask is the replacement text (default "****").
+	// +kubebuilder:default="****"
+	// +optional
+	Mask string `json:"mask,omitempty"`
+
+	// KeepPrefix is the number of leading characters kept as is.
+	// +optional
+	KeepPrefix int32 `json:"keepPrefix,omitempty"`
+
+	// KeepSuffix is the number of trailing characters kept as is.
+	// +optional
+	KeepSuffix int32 `json:"keepSuffix,omitempty"`
+}
+
 // BranchSchedule holds the refresh cadence.
 type BranchSchedule struct {
 	// Cron is the refresh schedule in standard cron syntax.
@@ -191,6 +370,11 @@ type BranchStatus struct {
 	// +optional
 	Snapshot *BranchSnapshotRef `json:"snapshot,omitempty"`
 
+	// RecoveryPoint records the point in time the current branch data was restored to, for branches
+	// with spec.source.archiver.
+	// +optional
+	RecoveryPoint *BranchRecoveryPoint `json:"recoveryPoint,omitempty"`
+
 	// LastRefreshAt is the time of the last successful refresh.
 	// +optional
 	LastRefreshAt *metav1.Time `json:"lastRefreshAt,omitempty"`
@@ -199,10 +383,26 @@ type BranchStatus struct {
 	// +optional
 	Freshness metav1.Duration `json:"freshness,omitempty"`
 
+	// ExpiresAt is the resolved expiry time of the branch, if spec.expiry is set.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// ClonedStorage is the storage the branch holds in the target; it is counted against BranchQuotas.
+	// +optional
+	ClonedStorage *resource.Quantity `json:"clonedStorage,omitempty"`
+
 	// History is the bounded refresh history (bounded by spec.historyLimit).
 	// +optional
 	History []BranchRun `json:"history,omitempty"`
 
+	// Masking records the masking rules applied to the current branch data.
+	// +optional
+	Masking *BranchMaskingStatus `json:"masking,omitempty"`
+
+	// SchemaDiff is the result of the last spec.schemaDiff request.
+	// +optional
+	SchemaDiff *BranchSchemaDiffStatus `json:"schemaDiff,omitempty"`
+
 	// The status of each condition is one of True, False, or Unknown.
 	// +listType=map
 	// +listMapKey=type
@@ -211,13 +411,14 @@ type BranchStatus struct {
 }
 
 // BranchPhase is the lifecycle phase of a Branch.
-// +kubebuilder:validation:Enum=Pending;Snapshotting;Cloning;Provisioning;Massaging;Ready;Refreshing;Deleting;Failed
+// +kubebuilder:validation:Enum=Pending;Snapshotting;Cloning;Restoring;Provisioning;Massaging;Ready;Refreshing;Deleting;Failed
 type BranchPhase string
 
 const (
 	BranchPhasePending      BranchPhase = "Pending"
 	BranchPhaseSnapshotting BranchPhase = "Snapshotting"
 	BranchPhaseCloning      BranchPhase = "Cloning"
+	BranchPhaseRestoring    BranchPhase = "Restoring"
 	BranchPhaseProvisioning BranchPhase = "Provisioning"
 	BranchPhaseMassaging    BranchPhase = "Massaging"
 	BranchPhaseReady        BranchPhase = "Ready"
@@ -256,6 +457,190 @@ type BranchSnapshotRef struct {
 	Ref string `json:"ref,omitempty"`
 }
 
+// BranchMaskingStatus records the masking policy run against the current branch data, as a
+// reviewable artifact of what was masked.
+type BranchMaskingStatus struct {
+	// PolicyHash is the hash of spec.masking at the time it was applied.
+	// +optional
+	PolicyHash string `json:"policyHash,omitempty"`
+
+	// AppliedAt is when the masking finished.
+	// +optional
+	AppliedAt *metav1.Time `json:"appliedAt,omitempty"`
+
+	// Rules are the per-table results, in the order of spec.masking.rules.
+	// +optional
+	Rules []BranchAppliedMaskingRule `json:"rules,omitempty"`
+}
+
+// BranchAppliedMaskingRule is the result of one masking rule.
+type BranchAppliedMaskingRule struct {
+	// Table is the masked table or collection.
+	Table string `json:"table"`
+
+	// Columns are the masked columns with their strategy, e.g. "email:Faker".
+	// +optional
+	Columns []string `json:"columns,omitempty"`
+
+	// RowFilter is the row filter that was applied, if any.
+	// +optional
+	RowFilter string `json:"rowFilter,omitempty"`
+
+	// RowsMasked is the number of rows updated.
+	// +optional
+	RowsMasked int64 `json:"rowsMasked,omitempty"`
+
+	// RowsDeleted is the number of rows removed by RowFilter.
+	// +optional
+	RowsDeleted int64 `json:"rowsDeleted,omitempty"`
+
+	// Result is the outcome of the rule.
+	// +optional
+	Result BranchRunResult `json:"result,omitempty"`
+
+	// Message is an optional human-readable detail (for a failed rule).
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BranchRecoveryPoint is the point in time an archiver-sourced branch was restored to.
+type BranchRecoveryPoint struct {
+	// RequestedTimestamp is spec.source.archiver.recoveryTimestamp, or empty when the latest point was requested.
+	// +optional
+	RequestedTimestamp *metav1.Time `json:"requestedTimestamp,omitempty"`
+
+	// RecoveredTimestamp is the commit time of the last transaction replayed into the branch. It is
+	// at or before RequestedTimestamp.
+	// +optional
+	RecoveredTimestamp *metav1.Time `json:"recoveredTimestamp,omitempty"`
+
+	// Position is the engine specific log position replay stopped at (LSN, GTID set or oplog timestamp).
+	// +optional
+	Position string `json:"position,omitempty"`
+
+	// FullBackup is the name of the full backup snapshot the restore started from.
+	// +optional
+	FullBackup string `json:"fullBackup,omitempty"`
+}
+
+// BranchSchemaDiffPhase is the phase of a schema diff request.
+// +kubebuilder:validation:Enum=Running;Succeeded;Failed
+type BranchSchemaDiffPhase string
+
+const (
+	BranchSchemaDiffPhaseRunning   BranchSchemaDiffPhase = "Running"
+	BranchSchemaDiffPhaseSucceeded BranchSchemaDiffPhase = "Succeeded"
+	BranchSchemaDiffPhaseFailed    BranchSchemaDiffPhase = "Failed"
+)
+
+// BranchSchemaObjectType is the kind of a schema object in a diff.
+// +kubebuilder:validation:Enum=Table;Column;Index;Constraint;Function;View
+type BranchSchemaObjectType string
+
+const (
+	BranchSchemaObjectTable      BranchSchemaObjectType = "Table"
+	BranchSchemaObjectColumn     BranchSchemaObjectType = "Column"
+	BranchSchemaObjectIndex      BranchSchemaObjectType = "Index"
+	BranchSchemaObjectConstraint BranchSchemaObjectType = "Constraint"
+	BranchSchemaObjectFunction   BranchSchemaObjectType = "Function"
+	BranchSchemaObjectView       BranchSchemaObjectType = "View"
+)
+
+// BranchSchemaChangeType is how an object differs on the branch relative to the source.
+// +kubebuilder:validation:Enum=Added;Removed;Modified
+type BranchSchemaChangeType string
+
+const (
+	BranchSchemaChangeAdded    BranchSchemaChangeType = "Added"
+	BranchSchemaChangeRemoved  BranchSchemaChangeType = "Removed"
+	BranchSchemaChangeModified BranchSchemaChangeType = "Modified"
+)
+
+// BranchSchemaDiffStatus is a schema diff of the branch against its source. Forward DDL turns the
+// source schema into the branch schema, so it can be used as a migration script. It is stored inline
+// when small, and in a ConfigMap in the Branch namespace otherwise.
+type BranchSchemaDiffStatus struct {
+	// RequestID is the spec.schemaDiff.requestID this diff answers.
+	// +optional
+	RequestID string `json:"requestID,omitempty"`
+
+	// Phase is the phase of the request.
+	// +optional
+	Phase BranchSchemaDiffPhase `json:"phase,omitempty"`
+
+	// StartTime is when the diff started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the diff finished.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Summary counts the changes.
+	// +optional
+	Summary BranchSchemaDiffSummary `json:"summary,omitempty"`
+
+	// Changes lists the changed objects, ordered by database, schema, table and name. It is truncated
+	// to BranchSchemaDiffMaxChanges entries; the full list is in the ConfigMap referenced by
+	// ForwardDDLRef.
+	// +optional
+	Changes []BranchSchemaChange `json:"changes,omitempty"`
+
+	// ForwardDDL is the generated DDL, when it fits in status.
+	// +optional
+	ForwardDDL string `json:"forwardDDL,omitempty"`
+
+	// ForwardDDLRef refers to the ConfigMap key holding the generated DDL, when it does not fit in status.
+	// +optional
+	ForwardDDLRef *corev1.ConfigMapKeySelector `json:"forwardDDLRef,omitempty"`
+
+	// Message is an optional human-readable detail (for a failed diff).
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BranchSchemaDiffSummary counts the changes of a schema diff.
+type BranchSchemaDiffSummary struct {
+	// +optional
+	Added int32 `json:"added,omitempty"`
+	// +optional
+	Removed int32 `json:"removed,omitempty"`
+	// +optional
+	Modified int32 `json:"modified,omitempty"`
+}
+
+// BranchSchemaChange is one changed object in a schema diff.
+type BranchSchemaChange struct {
+	// Type is the kind of the object.
+	Type BranchSchemaObjectType `json:"type"`
+
+	// Change is how the object differs on the branch.
+	Change BranchSchemaChangeType `json:"change"`
+
+	// Database is the database the object is in.
+	// +optional
+	Database string `json:"database,omitempty"`
+
+	// Schema is the Postgres schema the object is in. Empty for MySQL and MariaDB.
+	// +optional
+	Schema string `json:"schema,omitempty"`
+
+	// Table is the table of a column, index or constraint.
+	// +optional
+	Table string `json:"table,omitempty"`
+
+	// Name is the name of the object. Function names include the argument types.
+	Name string `json:"name"`
+
+	// SourceDefinition is the definition on the source, e.g. "varchar(64) NOT NULL". Empty for an added object.
+	// +optional
+	SourceDefinition string `json:"sourceDefinition,omitempty"`
+
+	// BranchDefinition is the definition on the branch. Empty for a removed object.
+	// +optional
+	BranchDefinition string `json:"branchDefinition,omitempty"`
+}
+
 // BranchRunResult is the outcome of a refresh run.
 // +kubebuilder:validation:Enum=Succeeded;Failed
 type BranchRunResult string
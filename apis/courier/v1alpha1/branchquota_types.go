@@ -0,0 +1,119 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	ResourceKindBranchQuota     = "BranchQuota"
+	ResourceSingularBranchQuota = "branchquota"
+	ResourcePluralBranchQuotas  = "branchquotas"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=branchquotas,singular=branchquota,shortName=brq,categories={kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Branches",type="integer",JSONPath=".status.used.branches"
+// +kubebuilder:printcolumn:name="Max Branches",type="integer",JSONPath=".spec.maxBranches"
+// +kubebuilder:printcolumn:name="Storage",type="string",JSONPath=".status.used.clonedStorage"
+// +kubebuilder:printcolumn:name="Max Storage",type="string",JSONPath=".spec.maxClonedStorage"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+//
+// BranchQuota limits the Branches created in its namespace or, with spec.source set, the Branches of
+// one source Database in its namespace, wherever they are created. The Branch admission webhook
+// rejects a new Branch that would take the namespace or the source Database over any BranchQuota.
+type BranchQuota struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of BranchQuota
+	// +required
+	Spec BranchQuotaSpec `json:"spec"`
+
+	// status defines the observed state of BranchQuota
+	// +optional
+	Status BranchQuotaStatus `json:"status,omitzero"`
+}
+
+// BranchQuotaSpec defines the limits of a BranchQuota. Unset limits are not enforced.
+type BranchQuotaSpec struct {
+	// Source restricts the quota to the Branches of one source Database in the quota's namespace. The
+	// Branches of the source are counted in every namespace. When empty, the quota applies to all
+	// Branches in the quota's namespace.
+	// +optional
+	Source *corev1.TypedLocalObjectReference `json:"source,omitempty"`
+
+	// MaxBranches is the maximum number of Branches.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxBranches *int32 `json:"maxBranches,omitempty"`
+
+	// MaxClonedStorage is the maximum total storage the Branches may hold.
+	// +optional
+	MaxClonedStorage *resource.Quantity `json:"maxClonedStorage,omitempty"`
+}
+
+// BranchQuotaStatus defines the observed state of BranchQuota.
+type BranchQuotaStatus struct {
+	// Used is the current usage counted against the quota.
+	// +optional
+	Used BranchQuotaUsage `json:"used,omitempty"`
+
+	// The status of each condition is one of True, False, or Unknown.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+// BranchQuotaUsage is the usage counted against a BranchQuota.
+type BranchQuotaUsage struct {
+	// Branches is the number of Branches.
+	// +optional
+	Branches int32 `json:"branches,omitempty"`
+
+	// ClonedStorage is the total storage held by the Branches.
+	// +optional
+	ClonedStorage resource.Quantity `json:"clonedStorage,omitempty"`
+}
+
+// BranchQuotaList contains a list of BranchQuota
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type BranchQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []BranchQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BranchQuota{}, &BranchQuotaList{})
+}
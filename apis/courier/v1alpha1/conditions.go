@@ -17,6 +17,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
 	cutil "kmodules.xyz/client-go/conditions"
@@ -41,12 +46,21 @@ func SetMigratorJobTriggeredConditionToFalse(migrator *Migration, err error) {
 }
 
 func (m *Migration) CalculatePhase() MigrationPhase {
+	if cutil.IsConditionTrue(m.Status.Conditions, CutoverRolledBack) {
+		return MigrationPhaseRolledBack
+	}
 	if cutil.IsConditionTrue(m.Status.Conditions, MigrationSucceeded) {
 		return MigrationPhaseSucceeded
 	}
 	if cutil.IsConditionTrue(m.Status.Conditions, MigrationFailed) {
 		return MigrationPhaseFailed
 	}
+	if cutil.IsConditionTrue(m.Status.Conditions, CutoverRunning) {
+		return MigrationPhaseCuttingOver
+	}
+	if cutil.IsConditionTrue(m.Status.Conditions, MigrationPaused) {
+		return MigrationPhasePaused
+	}
 	if cutil.IsConditionTrue(m.Status.Conditions, MigrationRunning) {
 		return MigrationPhaseRunning
 	}
@@ -103,3 +117,218 @@ func SetMigrationFailedCondition(migrator *Migration, err error) {
 	}
 	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, clearCond)
 }
+
+// SetCutoverRunningCondition sets the condition indicating the cutover is in progress
+func SetCutoverRunningCondition(migrator *Migration, phase CutoverPhase) {
+	newCond := kmapi.Condition{
+		Type:    CutoverRunning,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonCutoverRunning,
+		Message: fmt.Sprintf("Cutover is in %s phase.", phase),
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+}
+
+// SetCutoverSucceededCondition sets the condition indicating the applications now use the target
+func SetCutoverSucceededCondition(migrator *Migration) {
+	newCond := kmapi.Condition{
+		Type:    CutoverSucceeded,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonCutoverSucceeded,
+		Message: "Cutover completed successfully.",
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+
+	// Clear running condition
+	clearCond := kmapi.Condition{
+		Type:    CutoverRunning,
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonCutoverSucceeded,
+		Message: "Cutover completed.",
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, clearCond)
+}
+
+// SetCutoverFailedCondition sets the condition indicating the cutover failed
+func SetCutoverFailedCondition(migrator *Migration, err error) {
+	newCond := kmapi.Condition{
+		Type:    CutoverFailed,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonCutoverFailed,
+		Message: err.Error(),
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+
+	// Clear running condition
+	clearCond := kmapi.Condition{
+		Type:    CutoverRunning,
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonCutoverFailed,
+		Message: "Cutover failed.",
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, clearCond)
+}
+
+// SetCutoverRolledBackCondition sets the condition indicating the applications were pointed back at the source
+func SetCutoverRolledBackCondition(migrator *Migration) {
+	newCond := kmapi.Condition{
+		Type:    CutoverRolledBack,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonCutoverRolledBack,
+		Message: "Cutover has been rolled back; the source is writable again.",
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+
+	// Clear running and succeeded conditions
+	for _, t := range []kmapi.ConditionType{CutoverRunning, CutoverSucceeded} {
+		clearCond := kmapi.Condition{
+			Type:    t,
+			Status:  metav1.ConditionFalse,
+			Reason:  ReasonCutoverRolledBack,
+			Message: "Cutover rolled back.",
+		}
+		migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, clearCond)
+	}
+}
+
+// SetSourceReadOnlyCondition records whether writes to the source are currently blocked
+func SetSourceReadOnlyCondition(migrator *Migration, readOnly bool) {
+	newCond := kmapi.Condition{
+		Type:    SourceReadOnly,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonSourceReadOnly,
+		Message: "Source database has been put in read-only mode.",
+	}
+	if !readOnly {
+		newCond.Status = metav1.ConditionFalse
+		newCond.Reason = ReasonSourceWritable
+		newCond.Message = "Source database is writable."
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+}
+
+// SetVerificationSucceededCondition sets the condition indicating the target matches the source
+func SetVerificationSucceededCondition(migrator *Migration) {
+	newCond := kmapi.Condition{
+		Type:    VerificationSucceeded,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonVerificationSucceeded,
+		Message: "Target data matches the source.",
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+}
+
+// SetVerificationFailedCondition sets the condition indicating the target does not match the source.
+// If the migration is configured to fail on mismatch, the migration is marked as failed too.
+func SetVerificationFailedCondition(migrator *Migration, mismatched []string) {
+	newCond := kmapi.Condition{
+		Type:    VerificationFailed,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonVerificationFailed,
+		Message: fmt.Sprintf("%d table(s) do not match the source: %s", len(mismatched), strings.Join(mismatched, ", ")),
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+
+	if v := migrator.Spec.Verification; v == nil || v.FailOnMismatch == nil || *v.FailOnMismatch {
+		SetMigrationFailedCondition(migrator, errors.New(newCond.Message))
+	}
+}
+
+// SetPreflightCheckCondition records the finding of a single preflight check
+func SetPreflightCheckCondition(migrator *Migration, check PreflightCheck, err error) {
+	newCond := kmapi.Condition{
+		Type:    kmapi.ConditionType(check),
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonPreflightCheckPass,
+		Message: fmt.Sprintf("Preflight check %s passed.", check),
+	}
+	if err != nil {
+		newCond.Status = metav1.ConditionFalse
+		newCond.Reason = ReasonPreflightCheckFail
+		newCond.Message = err.Error()
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+}
+
+// SetPreflightSucceededCondition sets the overall preflight condition from the recorded check conditions.
+// If any check failed and the preflight is not WarnOnly, the migration is marked as failed.
+func SetPreflightSucceededCondition(migrator *Migration, checks []PreflightCheck) {
+	var failed []string
+	for _, c := range checks {
+		if cutil.IsConditionFalse(migrator.Status.Conditions, string(c)) {
+			failed = append(failed, string(c))
+		}
+	}
+
+	newCond := kmapi.Condition{
+		Type:    PreflightSucceeded,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonPreflightSucceeded,
+		Message: "All preflight checks passed.",
+	}
+	if len(failed) > 0 {
+		newCond.Status = metav1.ConditionFalse
+		newCond.Reason = ReasonPreflightFailed
+		newCond.Message = fmt.Sprintf("Preflight checks failed: %s", strings.Join(failed, ", "))
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+
+	if len(failed) > 0 && (migrator.Spec.Preflight == nil || !migrator.Spec.Preflight.WarnOnly) {
+		SetMigrationFailedCondition(migrator, errors.New(newCond.Message))
+	}
+}
+
+// SetMigrationPausedCondition sets the condition indicating the migrator Job is stopped at a checkpoint
+func SetMigrationPausedCondition(migrator *Migration) {
+	newCond := kmapi.Condition{
+		Type:    MigrationPaused,
+		Status:  metav1.ConditionTrue,
+		Reason:  ReasonMigrationPaused,
+		Message: "Migration has been paused.",
+	}
+	if cp := migrator.Status.Checkpoint; cp != nil && cp.PositionMethod != "" {
+		newCond.Message = fmt.Sprintf("Migration has been paused at %s checkpoint.", cp.PositionMethod)
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+}
+
+// SetMigrationResumedCondition clears the paused condition once the migrator Job is running again
+func SetMigrationResumedCondition(migrator *Migration) {
+	newCond := kmapi.Condition{
+		Type:    MigrationPaused,
+		Status:  metav1.ConditionFalse,
+		Reason:  ReasonMigrationResume,
+		Message: "Migration has been resumed from its checkpoint.",
+	}
+	migrator.Status.Conditions = cutil.SetCondition(migrator.Status.Conditions, newCond)
+}
+
+// SetBranchExpiryConditions sets the BranchExpiringSoon and BranchExpired conditions from the branch expiry.
+func SetBranchExpiryConditions(b *Branch, now time.Time) {
+	b.Status.ExpiresAt = b.ExpiryTime()
+	if b.Status.ExpiresAt == nil {
+		b.Status.Conditions = cutil.RemoveCondition(b.Status.Conditions, BranchExpiringSoon)
+		b.Status.Conditions = cutil.RemoveCondition(b.Status.Conditions, BranchExpired)
+		return
+	}
+
+	if b.IsExpiringSoon(now) {
+		b.Status.Conditions = cutil.SetCondition(b.Status.Conditions, kmapi.Condition{
+			Type:    BranchExpiringSoon,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonBranchExpiringSoon,
+			Message: fmt.Sprintf("Branch expires at %s and will be deleted with deletionPolicy %s.", b.Status.ExpiresAt.UTC().Format(time.RFC3339), b.Spec.DeletionPolicy),
+		})
+	} else {
+		b.Status.Conditions = cutil.RemoveCondition(b.Status.Conditions, BranchExpiringSoon)
+	}
+
+	if b.IsExpired(now) {
+		b.Status.Conditions = cutil.SetCondition(b.Status.Conditions, kmapi.Condition{
+			Type:    BranchExpired,
+			Status:  metav1.ConditionTrue,
+			Reason:  ReasonBranchExpired,
+			Message: fmt.Sprintf("Branch expired at %s.", b.Status.ExpiresAt.UTC().Format(time.RFC3339)),
+		})
+	}
+}
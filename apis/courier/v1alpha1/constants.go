@@ -16,6 +16,8 @@ limitations under the License.
 
 package v1alpha1
 
+import "time"
+
 const (
 	KindClusterRole           = "ClusterRole"
 	KindRole                  = "Role"
@@ -57,6 +59,66 @@ const (
 
 	MigrationFailed       = "MigrationFailed"
 	ReasonMigrationFailed = "MigrationError"
+
+	// Cutover status conditions
+	CutoverRunning       = "CutoverRunning"
+	ReasonCutoverRunning = "CutoverInProgress"
+
+	CutoverSucceeded       = "CutoverSucceeded"
+	ReasonCutoverSucceeded = "CutoverCompleted"
+
+	CutoverFailed       = "CutoverFailed"
+	ReasonCutoverFailed = "CutoverError"
+
+	CutoverRolledBack       = "CutoverRolledBack"
+	ReasonCutoverRolledBack = "CutoverReverted"
+
+	SourceReadOnly       = "SourceReadOnly"
+	ReasonSourceReadOnly = "SourceWritesBlocked"
+	ReasonSourceWritable = "SourceWritesRestored"
+
+	// Verification status conditions
+	VerificationSucceeded       = "VerificationSucceeded"
+	ReasonVerificationSucceeded = "TargetMatchesSource"
+
+	VerificationFailed       = "VerificationFailed"
+	ReasonVerificationFailed = "TargetMismatch"
+
+	// Preflight status conditions. Each PreflightCheck is recorded as a condition of its own type.
+	PreflightSucceeded       = "PreflightSucceeded"
+	ReasonPreflightSucceeded = "PrerequisitesMet"
+	ReasonPreflightFailed    = "PrerequisitesNotMet"
+	ReasonPreflightCheckPass = "CheckPassed"
+	ReasonPreflightCheckFail = "CheckFailed"
+
+	// Pause status conditions
+	MigrationPaused       = "MigrationPaused"
+	ReasonMigrationPaused = "PausedByUser"
+	ReasonMigrationResume = "ResumedFromCheckpoint"
+)
+
+// Branch status conditions
+const (
+	BranchExpiringSoon       = "BranchExpiringSoon"
+	ReasonBranchExpiringSoon = "ExpiryWithinWarningPeriod"
+
+	BranchExpired       = "BranchExpired"
+	ReasonBranchExpired = "ExpiryReached"
+
+	// BranchDefaultExpiryWarningPeriod is used when spec.expiry.warningPeriod is not set.
+	BranchDefaultExpiryWarningPeriod = 24 * time.Hour
+)
+
+// Branch schema diff
+const (
+	// BranchSchemaDiffMaxChanges bounds status.schemaDiff.changes.
+	BranchSchemaDiffMaxChanges = 200
+	// BranchSchemaDiffMaxInlineDDLBytes is the largest forward DDL kept inline in status.schemaDiff.forwardDDL.
+	BranchSchemaDiffMaxInlineDDLBytes = 32 * 1024
+	// BranchSchemaDiffDDLKey and BranchSchemaDiffChangesKey are the keys of the ConfigMap that holds
+	// the forward DDL and the full change list when they do not fit in status.
+	BranchSchemaDiffDDLKey     = "forward.sql"
+	BranchSchemaDiffChangesKey = "changes.json"
 )
 
 // ============ CLI Constants ==================
@@ -114,9 +176,11 @@ const (
 	StatusSkipped    = "skipped"
 
 	// Phase Constants
+	PhasePreflight = "preflight"
 	PhaseSchema    = "schema"
 	PhaseSnapshot  = "snapshot"
 	PhaseStreaming = "streaming"
+	PhaseVerify    = "verify"
 
 	// Table Names
 	TableMigrationInfo = "migration_info"
@@ -127,6 +191,7 @@ const (
 	// Position methods
 	PositionMethodGTID    = "gtid"
 	PositionMethodFilePos = "file_pos"
+	PositionMethodOplog   = "oplog"
 
 	// migration_info columns
 	ColMigrationID      = "migration_id"
@@ -0,0 +1,230 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ofst "kmodules.xyz/offshoot-api/api/v1"
+)
+
+const (
+	ResourceKindElasticsearchMigration     = "ElasticsearchMigration"
+	ResourceSingularElasticsearchMigration = "elasticsearchmigration"
+	ResourcePluralElasticsearchMigrations  = "elasticsearchmigrations"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=elasticsearchmigrations,singular=elasticsearchmigration,shortName=esmig,categories={kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Stage",type="string",JSONPath=".status.progress.stage"
+// +kubebuilder:printcolumn:name="Lag(s)",type="integer",JSONPath=".status.progress.lag.seconds"
+// +kubebuilder:printcolumn:name="Snapshot(%)",type="integer",JSONPath=".status.progress.snapshotPercent"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type ElasticsearchMigration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of ElasticsearchMigration
+	// +required
+	Spec ElasticsearchMigrationSpec `json:"spec"`
+
+	// status defines the observed state of ElasticsearchMigration.
+	// It reuses the shared MigrationStatus so that the Migration duck type can
+	// project it and the operator's status patches replay onto it unchanged.
+	// Per-index document counts are reported in status.progress.indices.
+	// +optional
+	Status MigrationStatus `json:"status,omitzero"`
+}
+
+// ElasticsearchMigrationSpec defines the desired state of ElasticsearchMigration
+type ElasticsearchMigrationSpec struct {
+	// Source defines the source Elasticsearch or OpenSearch cluster configuration
+	Source ElasticsearchSource `json:"source"`
+
+	// Target defines the target KubeDB Elasticsearch configuration
+	Target ElasticsearchTarget `json:"target"`
+
+	// JobDefaults specifies default settings for migration jobs
+	// +optional
+	JobDefaults *JobDefaults `json:"jobDefaults,omitempty"`
+
+	// JobTemplate specifies runtime configurations for the migration Job
+	// +optional
+	JobTemplate *ofst.PodTemplateSpec `json:"jobTemplate,omitempty"`
+
+	// Cutover configures the managed switch of applications from the source to the target
+	// +optional
+	Cutover *CutoverSpec `json:"cutover,omitempty"`
+
+	// Preflight configures the prerequisite checks run before any data is moved
+	// +optional
+	Preflight *PreflightSpec `json:"preflight,omitempty"`
+
+	// DryRun runs only the preflight checks and reports the findings as conditions; no data is moved
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// ElasticsearchMigrationList contains a list of ElasticsearchMigration
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ElasticsearchMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []ElasticsearchMigration `json:"items"`
+}
+
+// ElasticsearchMigrationStrategy is the way data is moved from the source cluster
+// +kubebuilder:validation:Enum=Snapshot;Reindex
+type ElasticsearchMigrationStrategy string
+
+const (
+	// ElasticsearchMigrationStrategySnapshot restores the indices on the target from a snapshot repository
+	ElasticsearchMigrationStrategySnapshot ElasticsearchMigrationStrategy = "Snapshot"
+	// ElasticsearchMigrationStrategyReindex copies the indices with the target's reindex-from-remote API
+	ElasticsearchMigrationStrategyReindex ElasticsearchMigrationStrategy = "Reindex"
+)
+
+type ElasticsearchSource struct {
+	// ConnectionInfo refers to the source Elasticsearch or OpenSearch connection information.
+	ConnectionInfo ConnectionInfo `yaml:"connectionInfo" json:"connectionInfo"`
+
+	// Strategy selects how the indices are moved to the target.
+	// +kubebuilder:default=Reindex
+	// +optional
+	Strategy ElasticsearchMigrationStrategy `yaml:"strategy" json:"strategy,omitempty"`
+
+	// Snapshot configures the restore from a snapshot repository. Used only when Strategy is Snapshot.
+	// +optional
+	Snapshot *ElasticsearchSnapshotSource `yaml:"snapshot" json:"snapshot,omitempty"`
+
+	// Reindex configures reindex-from-remote. Used only when Strategy is Reindex.
+	// +optional
+	Reindex *ElasticsearchReindex `yaml:"reindex" json:"reindex,omitempty"`
+
+	// Indices selects the indices to migrate. Applies to both strategies.
+	// +optional
+	Indices *ElasticsearchIndexFilter `yaml:"indices" json:"indices,omitempty"`
+}
+
+type ElasticsearchTarget struct {
+	// ConnectionInfo refers to the target Elasticsearch connection information.
+	ConnectionInfo ConnectionInfo `yaml:"connectionInfo" json:"connectionInfo"`
+
+	// AliasSwap configures the alias swap that moves clients onto the migrated indices.
+	// The aliases are swapped by the managed cutover (spec.cutover), once the remaining changes are drained.
+	// +optional
+	AliasSwap *ElasticsearchAliasSwapSpec `yaml:"aliasSwap" json:"aliasSwap,omitempty"`
+}
+
+type ElasticsearchSnapshotSource struct {
+	// Repository is the name of the snapshot repository. It is registered on the target
+	// as read-only if it does not exist there yet.
+	Repository string `yaml:"repository" json:"repository"`
+
+	// RepositoryType is the repository plugin type, e.g. fs, s3, gcs, azure or url.
+	// Required only when the repository has to be registered on the target.
+	// +optional
+	RepositoryType string `yaml:"repositoryType" json:"repositoryType,omitempty"`
+
+	// RepositorySettings are passed as the settings of the repository registration.
+	// +optional
+	RepositorySettings map[string]string `yaml:"repositorySettings" json:"repositorySettings,omitempty"`
+
+	// Snapshot is the name of the snapshot to restore. The latest successful snapshot is used if empty.
+	// +optional
+	Snapshot string `yaml:"snapshot" json:"snapshot,omitempty"`
+
+	// IncludeGlobalState restores the cluster state (templates, pipelines, persistent settings) too.
+	// +optional
+	IncludeGlobalState bool `yaml:"includeGlobalState" json:"includeGlobalState,omitempty"`
+}
+
+type ElasticsearchReindex struct {
+	// Slices is the number of slices each index is split into. One per shard if not set.
+	// +optional
+	Slices *int32 `yaml:"slices" json:"slices,omitempty"`
+
+	// BatchSize is the number of documents fetched from the source per scroll request.
+	// +optional
+	BatchSize *int32 `yaml:"batchSize" json:"batchSize,omitempty"`
+
+	// RequestsPerSecond throttles the reindex. Unthrottled if not set.
+	// +optional
+	RequestsPerSecond *int32 `yaml:"requestsPerSecond" json:"requestsPerSecond,omitempty"`
+
+	// CopySettings creates each target index with the source index settings and mappings
+	// before reindexing into it.
+	// +kubebuilder:default=true
+	// +optional
+	CopySettings *bool `yaml:"copySettings" json:"copySettings,omitempty"`
+}
+
+type ElasticsearchIndexFilter struct {
+	// Include is the list of index patterns to migrate, e.g. logs-*. All indices are included if empty.
+	// +optional
+	Include []string `yaml:"include" json:"include,omitempty"`
+
+	// Exclude is the list of index patterns to skip. Exclude wins over Include.
+	// +optional
+	Exclude []string `yaml:"exclude" json:"exclude,omitempty"`
+
+	// IncludeSystemIndices migrates dot-prefixed system indices as well.
+	// +optional
+	IncludeSystemIndices bool `yaml:"includeSystemIndices" json:"includeSystemIndices,omitempty"`
+}
+
+type ElasticsearchAliasSwapSpec struct {
+	// IndexSuffix is appended to every migrated index name on the target, so that the
+	// aliases can be swapped onto the new indices atomically.
+	// +optional
+	IndexSuffix string `yaml:"indexSuffix" json:"indexSuffix,omitempty"`
+
+	// Aliases is the list of aliases to swap. The aliases of the source indices are used if empty.
+	// +optional
+	Aliases []ElasticsearchAliasSwap `yaml:"aliases" json:"aliases,omitempty"`
+
+	// DeleteOldIndices deletes the indices an alias pointed to before the swap.
+	// +optional
+	DeleteOldIndices bool `yaml:"deleteOldIndices" json:"deleteOldIndices,omitempty"`
+}
+
+type ElasticsearchAliasSwap struct {
+	// Alias is the name of the alias on the target.
+	Alias string `yaml:"alias" json:"alias"`
+
+	// Indices is the list of migrated indices the alias points to after the swap.
+	Indices []string `yaml:"indices" json:"indices"`
+
+	// WriteIndex is the index that receives writes through the alias.
+	// +optional
+	WriteIndex string `yaml:"writeIndex" json:"writeIndex,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ElasticsearchMigration{}, &ElasticsearchMigrationList{})
+}
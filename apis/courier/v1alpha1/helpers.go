@@ -17,8 +17,13 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+	"strings"
+	"time"
+
 	"kubedb.dev/apimachinery/crds"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"kmodules.xyz/client-go/apiextensions"
 )
 
@@ -51,10 +56,26 @@ func (MSSQLServerMigration) CustomResourceDefinition() *apiextensions.CustomReso
 	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralMSSQLServerMigrations))
 }
 
+func (RedisMigration) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralRedisMigrations))
+}
+
+func (ElasticsearchMigration) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralElasticsearchMigrations))
+}
+
+func (MySQLToPostgresMigration) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralMySQLToPostgresMigrations))
+}
+
 func (Branch) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
 	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralBranches))
 }
 
+func (BranchQuota) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralBranchQuotas))
+}
+
 func (BranchWork) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
 	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralBranchWorks))
 }
@@ -71,6 +92,12 @@ func (m Migration) GetDBKindAndCommand() (string, string) {
 		return "MariaDB", "mariadb"
 	case m.Spec.Source.MSSQLServerSource != nil && m.Spec.Target.MSSQLServerTarget != nil:
 		return "MSSQLServer", "mssqlserver"
+	case m.Spec.Source.RedisSource != nil && m.Spec.Target.RedisTarget != nil:
+		return "Redis", "redis"
+	case m.Spec.Source.ElasticsearchSource != nil && m.Spec.Target.ElasticsearchTarget != nil:
+		return "Elasticsearch", "elasticsearch"
+	case m.Spec.Source.MySQLToPostgresSource != nil && m.Spec.Target.PostgresTarget != nil:
+		return "MySQLToPostgres", "mysql-to-postgres"
 	}
 
 	return "", ""
@@ -96,6 +123,216 @@ func (m Migration) GetConnectionInfos() (*ConnectionInfo, *ConnectionInfo) {
 			DBName:     m.Spec.Target.MSSQLServerTarget.ConnectionInfo.Database,
 		}
 		return src, tgt
+	case m.Spec.Source.RedisSource != nil && m.Spec.Target.RedisTarget != nil:
+		return &m.Spec.Source.RedisSource.ConnectionInfo, &m.Spec.Target.RedisTarget.ConnectionInfo
+	case m.Spec.Source.ElasticsearchSource != nil && m.Spec.Target.ElasticsearchTarget != nil:
+		return &m.Spec.Source.ElasticsearchSource.ConnectionInfo, &m.Spec.Target.ElasticsearchTarget.ConnectionInfo
+	case m.Spec.Source.MySQLToPostgresSource != nil && m.Spec.Target.PostgresTarget != nil:
+		return &m.Spec.Source.MySQLToPostgresSource.ConnectionInfo, &m.Spec.Target.PostgresTarget.ConnectionInfo
 	}
 	return nil, nil
 }
+
+// DefaultMySQLToPostgresTypeMappings is the built-in MySQL/MariaDB to Postgres column type mapping table.
+// Parameterized entries come before the bare type they refine.
+var DefaultMySQLToPostgresTypeMappings = []TypeMapping{
+	{Source: "TINYINT(1)", Target: "boolean"},
+	{Source: "BIT(1)", Target: "boolean"},
+	{Source: "BIT", Target: "bit varying"},
+	{Source: "TINYINT", Target: "smallint"},
+	{Source: "SMALLINT", Target: "smallint"},
+	{Source: "MEDIUMINT", Target: "integer"},
+	{Source: "INT", Target: "integer"},
+	{Source: "INTEGER", Target: "integer"},
+	{Source: "BIGINT", Target: "bigint"},
+	{Source: "FLOAT", Target: "real"},
+	{Source: "DOUBLE", Target: "double precision"},
+	{Source: "DECIMAL", Target: "numeric"},
+	{Source: "NUMERIC", Target: "numeric"},
+	{Source: "DATE", Target: "date"},
+	{Source: "TIME", Target: "time"},
+	{Source: "DATETIME", Target: "timestamptz"},
+	{Source: "TIMESTAMP", Target: "timestamptz"},
+	{Source: "YEAR", Target: "smallint"},
+	{Source: "CHAR", Target: "char"},
+	{Source: "VARCHAR", Target: "varchar"},
+	{Source: "TINYTEXT", Target: "text"},
+	{Source: "TEXT", Target: "text"},
+	{Source: "MEDIUMTEXT", Target: "text"},
+	{Source: "LONGTEXT", Target: "text"},
+	{Source: "BINARY", Target: "bytea"},
+	{Source: "VARBINARY", Target: "bytea"},
+	{Source: "TINYBLOB", Target: "bytea"},
+	{Source: "BLOB", Target: "bytea"},
+	{Source: "MEDIUMBLOB", Target: "bytea"},
+	{Source: "LONGBLOB", Target: "bytea"},
+	{Source: "ENUM", Target: "text"},
+	{Source: "SET", Target: "text[]"},
+	{Source: "JSON", Target: "jsonb"},
+	{Source: "GEOMETRY", Target: "bytea"},
+}
+
+// EffectiveTypeMappings returns the type mappings in match order: the user
+// provided ones first, followed by the built-in ones unless they are skipped.
+// The returned slice is a copy and may be modified by the caller.
+func (t *SchemaTranslation) EffectiveTypeMappings() []TypeMapping {
+	if t == nil {
+		return append([]TypeMapping(nil), DefaultMySQLToPostgresTypeMappings...)
+	}
+	out := make([]TypeMapping, 0, len(t.TypeMappings)+len(DefaultMySQLToPostgresTypeMappings))
+	out = append(out, t.TypeMappings...)
+	if !t.SkipDefaultTypeMappings {
+		out = append(out, DefaultMySQLToPostgresTypeMappings...)
+	}
+	return out
+}
+
+// FoldIdentifier applies the configured identifier case folding to name.
+func (t *SchemaTranslation) FoldIdentifier(name string) string {
+	if t == nil {
+		return strings.ToLower(name)
+	}
+	switch t.IdentifierCase {
+	case IdentifierCasePreserve:
+		return name
+	case IdentifierCaseUpper:
+		return strings.ToUpper(name)
+	default:
+		return strings.ToLower(name)
+	}
+}
+
+// SetTableProgress adds tp to the progress, replacing the entry of the same table if there is one.
+func (p *Progress) SetTableProgress(tp TableProgress) {
+	for i := range p.Tables {
+		if p.Tables[i].Name == tp.Name {
+			p.Tables[i] = tp
+			return
+		}
+	}
+	p.Tables = append(p.Tables, tp)
+}
+
+// CalculateSnapshotPercent returns the share of the total rows copied over all tables,
+// or nil if the total is not known yet.
+func (p *Progress) CalculateSnapshotPercent() *int32 {
+	var copied, total int64
+	for _, t := range p.Tables {
+		copied += t.RowsCopied
+		total += t.RowsTotal
+	}
+	if total == 0 {
+		return nil
+	}
+	pct := int32(min(copied*100/total, 100))
+	return &pct
+}
+
+// PreflightChecks returns the preflight checks to run for the migration, or nil if the preflight is disabled.
+// A DryRun always runs the preflight.
+func (m Migration) PreflightChecks() []PreflightCheck {
+	p := m.Spec.Preflight
+	if !m.Spec.DryRun && p != nil && p.Enabled != nil && !*p.Enabled {
+		return nil
+	}
+	all := []PreflightCheck{
+		PreflightCheckSourceConnection,
+		PreflightCheckTargetConnection,
+		PreflightCheckSourceReplicationConfig,
+		PreflightCheckSourcePrivileges,
+		PreflightCheckVersionCompatibility,
+		PreflightCheckTargetEmpty,
+		PreflightCheckTargetDiskHeadroom,
+	}
+	if p == nil || len(p.SkipChecks) == 0 {
+		return all
+	}
+	checks := make([]PreflightCheck, 0, len(all))
+	for _, c := range all {
+		if !slices.Contains(p.SkipChecks, c) {
+			checks = append(checks, c)
+		}
+	}
+	return checks
+}
+
+// SourceNamespace returns the namespace of the source Database, defaulting to the Branch's namespace.
+func (b Branch) SourceNamespace() string {
+	if b.Spec.Source.Namespace != "" {
+		return b.Spec.Source.Namespace
+	}
+	return b.Namespace
+}
+
+// ExpiryTime returns when the branch expires, or nil if it has no expiry.
+func (b Branch) ExpiryTime() *metav1.Time {
+	e := b.Spec.Expiry
+	switch {
+	case e == nil:
+		return nil
+	case e.ExpiresAt != nil:
+		return e.ExpiresAt.DeepCopy()
+	case e.TTL != nil:
+		t := metav1.NewTime(b.CreationTimestamp.Add(e.TTL.Duration))
+		return &t
+	}
+	return nil
+}
+
+// IsExpired returns true if the branch has an expiry at or before now.
+func (b Branch) IsExpired(now time.Time) bool {
+	t := b.ExpiryTime()
+	return t != nil && !now.Before(t.Time)
+}
+
+// IsExpiringSoon returns true if the branch is not expired yet but expires within its warning period.
+func (b Branch) IsExpiringSoon(now time.Time) bool {
+	t := b.ExpiryTime()
+	if t == nil || b.IsExpired(now) {
+		return false
+	}
+	warn := BranchDefaultExpiryWarningPeriod
+	if b.Spec.Expiry.WarningPeriod != nil {
+		warn = b.Spec.Expiry.WarningPeriod.Duration
+	}
+	return t.Sub(now) <= warn
+}
+
+// SchemaDiffSupported returns true if a schema diff can be computed for the branch's source kind.
+func (b Branch) SchemaDiffSupported() bool {
+	switch b.Spec.Source.DatabaseRef.Kind {
+	case "Postgres", "MySQL", "MariaDB":
+		return true
+	}
+	return false
+}
+
+// MaskingSupported returns true if a masking policy can be applied to the branch's source kind.
+func (b Branch) MaskingSupported() bool {
+	switch b.Spec.Source.DatabaseRef.Kind {
+	case "Postgres", "MySQL", "MariaDB", "MongoDB":
+		return true
+	}
+	return false
+}
+
+// SchemaDiffRequested returns true if spec.schemaDiff has a request that status.schemaDiff does not answer yet.
+func (b Branch) SchemaDiffRequested() bool {
+	if b.Spec.SchemaDiff == nil {
+		return false
+	}
+	return b.Status.SchemaDiff == nil || b.Status.SchemaDiff.RequestID != b.Spec.SchemaDiff.RequestID ||
+		b.Status.SchemaDiff.Phase == BranchSchemaDiffPhaseRunning
+}
+
+// Selects returns true if b is counted against the quota: a Branch in the quota's namespace, or
+// with spec.source set, a Branch of that source Database in any namespace.
+func (q BranchQuota) Selects(b *Branch) bool {
+	src := q.Spec.Source
+	if src == nil {
+		return b.Namespace == q.Namespace
+	}
+	return b.Spec.Source.DatabaseRef.Kind == src.Kind &&
+		b.Spec.Source.DatabaseRef.Name == src.Name &&
+		b.SourceNamespace() == q.Namespace
+}
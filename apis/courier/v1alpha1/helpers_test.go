@@ -0,0 +1,147 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDefaultMySQLToPostgresTypeMappings(t *testing.T) {
+	seen := map[string]bool{}
+	for _, m := range DefaultMySQLToPostgresTypeMappings {
+		if m.Source == "" || m.Target == "" {
+			t.Errorf("mapping %+v has an empty source or target", m)
+		}
+		if len(m.Columns) > 0 {
+			t.Errorf("mapping %s must apply to every column, got columns %v", m.Source, m.Columns)
+		}
+		key := strings.ToUpper(m.Source)
+		if seen[key] {
+			t.Errorf("mapping %s is duplicate", m.Source)
+		}
+		seen[key] = true
+	}
+
+	// TINYINT(1) has to match before the bare TINYINT, and BIT(1) before BIT.
+	for _, pair := range [][2]string{{"TINYINT(1)", "TINYINT"}, {"BIT(1)", "BIT"}} {
+		if defaultTypeMappingIndex(pair[0]) > defaultTypeMappingIndex(pair[1]) {
+			t.Errorf("mapping %s must come before %s", pair[0], pair[1])
+		}
+	}
+}
+
+func defaultTypeMappingIndex(source string) int {
+	for i, m := range DefaultMySQLToPostgresTypeMappings {
+		if m.Source == source {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestSchemaTranslationEffectiveTypeMappings(t *testing.T) {
+	user := []TypeMapping{
+		{Source: "TINYINT(1)", Target: "smallint"},
+		{Source: "DATETIME", Target: "timestamp", Columns: []string{"shop.orders.created_at"}},
+	}
+
+	tests := []struct {
+		name string
+		t    *SchemaTranslation
+		want []TypeMapping
+	}{
+		{
+			name: "nil translation",
+			t:    nil,
+			want: DefaultMySQLToPostgresTypeMappings,
+		},
+		{
+			name: "defaults only",
+			t:    &SchemaTranslation{},
+			want: DefaultMySQLToPostgresTypeMappings,
+		},
+		{
+			name: "user mappings before the defaults",
+			t:    &SchemaTranslation{TypeMappings: user},
+			want: append(append([]TypeMapping{}, user...), DefaultMySQLToPostgresTypeMappings...),
+		},
+		{
+			name: "skip defaults",
+			t:    &SchemaTranslation{TypeMappings: user, SkipDefaultTypeMappings: true},
+			want: user,
+		},
+		{
+			name: "skip defaults without user mappings",
+			t:    &SchemaTranslation{SkipDefaultTypeMappings: true},
+			want: []TypeMapping{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.t.EffectiveTypeMappings()
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EffectiveTypeMappings() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchemaTranslationEffectiveTypeMappingsOverride(t *testing.T) {
+	st := &SchemaTranslation{TypeMappings: []TypeMapping{{Source: "TINYINT(1)", Target: "smallint"}}}
+
+	// The first matching mapping wins, so the user mapping overrides the built-in TINYINT(1) -> boolean.
+	for _, m := range st.EffectiveTypeMappings() {
+		if strings.EqualFold(m.Source, "TINYINT(1)") {
+			if m.Target != "smallint" {
+				t.Errorf("TINYINT(1) maps to %s, want smallint", m.Target)
+			}
+			break
+		}
+	}
+}
+
+func TestSchemaTranslationEffectiveTypeMappingsCopy(t *testing.T) {
+	var st *SchemaTranslation
+	got := st.EffectiveTypeMappings()
+	got[0].Target = "text"
+	if DefaultMySQLToPostgresTypeMappings[0].Target == "text" {
+		t.Error("EffectiveTypeMappings() of a nil translation returns the default table itself")
+	}
+}
+
+func TestSchemaTranslationFoldIdentifier(t *testing.T) {
+	tests := []struct {
+		name string
+		t    *SchemaTranslation
+		want string
+	}{
+		{name: "nil translation", t: nil, want: "orderitems"},
+		{name: "default", t: &SchemaTranslation{}, want: "orderitems"},
+		{name: "lower", t: &SchemaTranslation{IdentifierCase: IdentifierCaseLower}, want: "orderitems"},
+		{name: "upper", t: &SchemaTranslation{IdentifierCase: IdentifierCaseUpper}, want: "ORDERITEMS"},
+		{name: "preserve", t: &SchemaTranslation{IdentifierCase: IdentifierCasePreserve}, want: "OrderItems"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.t.FoldIdentifier("OrderItems"); got != tt.want {
+				t.Errorf("FoldIdentifier() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -36,9 +36,9 @@ const (
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
-// +kubebuilder:printcolumn:name="Stage",type="string",JSONPath=".status.progress.info.Stage"
-// +kubebuilder:printcolumn:name="Lag",type="string",JSONPath=".status.progress.info.Lag"
-// +kubebuilder:printcolumn:name="Progress",type="string",JSONPath=".status.progress.info.Progress"
+// +kubebuilder:printcolumn:name="Stage",type="string",JSONPath=".status.progress.stage"
+// +kubebuilder:printcolumn:name="Lag(s)",type="integer",JSONPath=".status.progress.lag.seconds"
+// +kubebuilder:printcolumn:name="Snapshot(%)",type="integer",JSONPath=".status.progress.snapshotPercent"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
 type MariaDBMigration struct {
 	metav1.TypeMeta `json:",inline"`
@@ -73,6 +73,27 @@ type MariaDBMigrationSpec struct {
 	// JobTemplate specifies runtime configurations for the migration Job
 	// +optional
 	JobTemplate *ofst.PodTemplateSpec `json:"jobTemplate,omitempty"`
+
+	// Cutover configures the managed switch of applications from the source to the target
+	// +optional
+	Cutover *CutoverSpec `json:"cutover,omitempty"`
+
+	// Preflight configures the prerequisite checks run before any data is moved
+	// +optional
+	Preflight *PreflightSpec `json:"preflight,omitempty"`
+
+	// DryRun runs only the preflight checks and reports the findings as conditions; no data is moved
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Paused stops the migrator Job after saving the executed GTID set, or the binlog position, in status.
+	// Unpausing resumes reading the binlog from there without copying the snapshot again.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Verification configures the comparison of the target with the source after the data is copied
+	// +optional
+	Verification *VerificationSpec `json:"verification,omitempty"`
 }
 
 // MariaDBMigrationList contains a list of MariaDBMigration
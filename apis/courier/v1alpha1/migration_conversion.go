@@ -44,50 +44,116 @@ func (m *Migration) Duckify(srcRaw runtime.Object) error {
 		m.ObjectMeta = t.ObjectMeta
 		m.Status = t.Status
 		m.Spec = MigrationSpec{
-			Source:      &Source{PostgresSource: &t.Spec.Source},
-			Target:      &Target{PostgresTarget: &t.Spec.Target},
-			JobDefaults: t.Spec.JobDefaults,
-			JobTemplate: t.Spec.JobTemplate,
+			Source:       &Source{PostgresSource: &t.Spec.Source},
+			Target:       &Target{PostgresTarget: &t.Spec.Target},
+			JobDefaults:  t.Spec.JobDefaults,
+			JobTemplate:  t.Spec.JobTemplate,
+			Cutover:      t.Spec.Cutover,
+			Preflight:    t.Spec.Preflight,
+			DryRun:       t.Spec.DryRun,
+			Paused:       t.Spec.Paused,
+			Verification: t.Spec.Verification,
 		}
 	case *MySQLMigration:
 		m.Kind = ResourceKindMySQLMigration
 		m.ObjectMeta = t.ObjectMeta
 		m.Status = t.Status
 		m.Spec = MigrationSpec{
-			Source:      &Source{MySQLSource: &t.Spec.Source},
-			Target:      &Target{MySQLTarget: &t.Spec.Target},
-			JobDefaults: t.Spec.JobDefaults,
-			JobTemplate: t.Spec.JobTemplate,
+			Source:       &Source{MySQLSource: &t.Spec.Source},
+			Target:       &Target{MySQLTarget: &t.Spec.Target},
+			JobDefaults:  t.Spec.JobDefaults,
+			JobTemplate:  t.Spec.JobTemplate,
+			Cutover:      t.Spec.Cutover,
+			Preflight:    t.Spec.Preflight,
+			DryRun:       t.Spec.DryRun,
+			Paused:       t.Spec.Paused,
+			Verification: t.Spec.Verification,
 		}
 	case *MariaDBMigration:
 		m.Kind = ResourceKindMariaDBMigration
 		m.ObjectMeta = t.ObjectMeta
 		m.Status = t.Status
 		m.Spec = MigrationSpec{
-			Source:      &Source{MariaDBSource: &t.Spec.Source},
-			Target:      &Target{MariaDBTarget: &t.Spec.Target},
-			JobDefaults: t.Spec.JobDefaults,
-			JobTemplate: t.Spec.JobTemplate,
+			Source:       &Source{MariaDBSource: &t.Spec.Source},
+			Target:       &Target{MariaDBTarget: &t.Spec.Target},
+			JobDefaults:  t.Spec.JobDefaults,
+			JobTemplate:  t.Spec.JobTemplate,
+			Cutover:      t.Spec.Cutover,
+			Preflight:    t.Spec.Preflight,
+			DryRun:       t.Spec.DryRun,
+			Paused:       t.Spec.Paused,
+			Verification: t.Spec.Verification,
 		}
 	case *MongoDBMigration:
 		m.Kind = ResourceKindMongoDBMigration
 		m.ObjectMeta = t.ObjectMeta
 		m.Status = t.Status
 		m.Spec = MigrationSpec{
-			Source:      &Source{MongoDBSource: &t.Spec.Source},
-			Target:      &Target{MongoDBTarget: &t.Spec.Target},
-			JobDefaults: t.Spec.JobDefaults,
-			JobTemplate: t.Spec.JobTemplate,
+			Source:       &Source{MongoDBSource: &t.Spec.Source},
+			Target:       &Target{MongoDBTarget: &t.Spec.Target},
+			JobDefaults:  t.Spec.JobDefaults,
+			JobTemplate:  t.Spec.JobTemplate,
+			Cutover:      t.Spec.Cutover,
+			Preflight:    t.Spec.Preflight,
+			DryRun:       t.Spec.DryRun,
+			Paused:       t.Spec.Paused,
+			Verification: t.Spec.Verification,
 		}
 	case *MSSQLServerMigration:
 		m.Kind = ResourceKindMSSQLServerMigration
 		m.ObjectMeta = t.ObjectMeta
 		m.Status = t.Status
 		m.Spec = MigrationSpec{
-			Source:      &Source{MSSQLServerSource: &t.Spec.Source},
-			Target:      &Target{MSSQLServerTarget: &t.Spec.Target},
+			Source:       &Source{MSSQLServerSource: &t.Spec.Source},
+			Target:       &Target{MSSQLServerTarget: &t.Spec.Target},
+			JobDefaults:  t.Spec.JobDefaults,
+			JobTemplate:  t.Spec.JobTemplate,
+			Cutover:      t.Spec.Cutover,
+			Preflight:    t.Spec.Preflight,
+			DryRun:       t.Spec.DryRun,
+			Paused:       t.Spec.Paused,
+			Verification: t.Spec.Verification,
+		}
+	case *RedisMigration:
+		m.Kind = ResourceKindRedisMigration
+		m.ObjectMeta = t.ObjectMeta
+		m.Status = t.Status
+		m.Spec = MigrationSpec{
+			Source:      &Source{RedisSource: &t.Spec.Source},
+			Target:      &Target{RedisTarget: &t.Spec.Target},
+			JobDefaults: t.Spec.JobDefaults,
+			JobTemplate: t.Spec.JobTemplate,
+			Cutover:     t.Spec.Cutover,
+			Preflight:   t.Spec.Preflight,
+			DryRun:      t.Spec.DryRun,
+			Paused:      t.Spec.Paused,
+		}
+	case *ElasticsearchMigration:
+		m.Kind = ResourceKindElasticsearchMigration
+		m.ObjectMeta = t.ObjectMeta
+		m.Status = t.Status
+		m.Spec = MigrationSpec{
+			Source:      &Source{ElasticsearchSource: &t.Spec.Source},
+			Target:      &Target{ElasticsearchTarget: &t.Spec.Target},
+			JobDefaults: t.Spec.JobDefaults,
+			JobTemplate: t.Spec.JobTemplate,
+			Cutover:     t.Spec.Cutover,
+			Preflight:   t.Spec.Preflight,
+			DryRun:      t.Spec.DryRun,
+		}
+	case *MySQLToPostgresMigration:
+		m.Kind = ResourceKindMySQLToPostgresMigration
+		m.ObjectMeta = t.ObjectMeta
+		m.Status = t.Status
+		m.Spec = MigrationSpec{
+			Source:      &Source{MySQLToPostgresSource: &t.Spec.Source},
+			Target:      &Target{PostgresTarget: &t.Spec.Target},
 			JobDefaults: t.Spec.JobDefaults,
 			JobTemplate: t.Spec.JobTemplate,
+			Cutover:     t.Spec.Cutover,
+			Preflight:   t.Spec.Preflight,
+			DryRun:      t.Spec.DryRun,
+			Paused:      t.Spec.Paused,
 		}
 	default:
 		return fmt.Errorf("courier: cannot Duckify %T", srcRaw)
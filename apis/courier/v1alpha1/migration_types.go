@@ -20,6 +20,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
+	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
 	ofst "kmodules.xyz/offshoot-api/api/v1"
 )
 
@@ -55,6 +56,27 @@ type MigrationSpec struct {
 	// JobTemplate specifies runtime configurations for the backup/restore Job
 	// +optional
 	JobTemplate *ofst.PodTemplateSpec `json:"jobTemplate,omitempty"`
+
+	// Cutover configures the managed switch of applications from the source to the target
+	// +optional
+	Cutover *CutoverSpec `json:"cutover,omitempty"`
+
+	// Preflight configures the prerequisite checks run before any data is moved
+	// +optional
+	Preflight *PreflightSpec `json:"preflight,omitempty"`
+
+	// DryRun runs only the preflight checks and reports the findings as conditions; no data is moved
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Paused stops the migrator Job after saving a resume checkpoint in status.
+	// Unpausing resumes from the checkpoint without copying the snapshot again.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// Verification configures the comparison of the target with the source after the data is copied
+	// +optional
+	Verification *VerificationSpec `json:"verification,omitempty"`
 }
 
 // JobDefaults defines default settings for migration jobs
@@ -90,6 +112,18 @@ type MigrationStatus struct {
 	// +optional
 	Progress *Progress `json:"progress,omitempty"`
 
+	// Cutover contains the state of the managed cutover, if one is configured
+	// +optional
+	Cutover *CutoverStatus `json:"cutover,omitempty"`
+
+	// Verification contains the per-table results of the post-migration verification, if one is configured
+	// +optional
+	Verification *VerificationStatus `json:"verification,omitempty"`
+
+	// Checkpoint is the position the migration resumes from after it is paused or restarted
+	// +optional
+	Checkpoint *MigrationCheckpoint `json:"checkpoint,omitempty"`
+
 	// The status of each condition is one of True, False, or Unknown.
 	// +listType=map
 	// +listMapKey=type
@@ -109,17 +143,473 @@ const (
 	MigrationPhaseSucceeded MigrationPhase = "Succeeded"
 	// MigrationPhaseFailed indicates the migration failed
 	MigrationPhaseFailed MigrationPhase = "Failed"
+	// MigrationPhaseCuttingOver indicates the applications are being switched from the source to the target
+	MigrationPhaseCuttingOver MigrationPhase = "CuttingOver"
+	// MigrationPhaseRolledBack indicates a cutover was rolled back and the source is writable again
+	MigrationPhaseRolledBack MigrationPhase = "RolledBack"
+	// MigrationPhasePaused indicates the migrator Job is stopped and the migration can be resumed from its checkpoint
+	MigrationPhasePaused MigrationPhase = "Paused"
+)
+
+// MigrationCheckpoint is the position in the source change log the streaming phase resumes from.
+// Only the fields of the source engine are set.
+type MigrationCheckpoint struct {
+	// PositionMethod identifies how the position is expressed: lsn, gtid, file_pos, oplog or psync
+	// +optional
+	PositionMethod string `json:"positionMethod,omitempty"`
+
+	// SnapshotCompleted is true if the snapshot phase finished before the checkpoint was taken,
+	// so that a resumed migration goes straight to streaming
+	// +optional
+	SnapshotCompleted bool `json:"snapshotCompleted,omitempty"`
+
+	// PostgresLSN is the confirmed flush LSN of the logical replication slot, e.g. 0/16B3748
+	// +optional
+	PostgresLSN string `json:"postgresLSN,omitempty"`
+
+	// GTIDSet is the executed GTID set of a MySQL or MariaDB source
+	// +optional
+	GTIDSet string `json:"gtidSet,omitempty"`
+
+	// BinlogFile is the binlog file of a MySQL or MariaDB source without GTIDs
+	// +optional
+	BinlogFile string `json:"binlogFile,omitempty"`
+
+	// BinlogPos is the position in BinlogFile
+	// +optional
+	BinlogPos int64 `json:"binlogPos,omitempty"`
+
+	// OplogTimestamp is the timestamp of the last applied MongoDB oplog entry, as seconds since epoch
+	// +optional
+	OplogTimestamp int64 `json:"oplogTimestamp,omitempty"`
+
+	// OplogIncrement is the ordinal of the last applied entry within OplogTimestamp
+	// +optional
+	OplogIncrement int64 `json:"oplogIncrement,omitempty"`
+
+	// MSSQLLSN is the last processed CDC LSN of an MSSQL Server source, as a hex string
+	// +optional
+	MSSQLLSN string `json:"mssqlLSN,omitempty"`
+
+	// RedisReplicationID is the replication ID of the Redis source the migrator was replicating from
+	// +optional
+	RedisReplicationID string `json:"redisReplicationID,omitempty"`
+
+	// RedisReplicationOffset is the replication offset of the last command applied to the target.
+	// The migrator continues with PSYNC <RedisReplicationID> <RedisReplicationOffset+1>.
+	// +optional
+	RedisReplicationOffset int64 `json:"redisReplicationOffset,omitempty"`
+
+	// SaveTime is the time the checkpoint was saved
+	// +optional
+	SaveTime *metav1.Time `json:"saveTime,omitempty"`
+}
+
+// CutoverMode decides when the cutover starts
+// +kubebuilder:validation:Enum=Manual;Scheduled;Automatic
+type CutoverMode string
+
+const (
+	// CutoverModeManual starts the cutover once spec.cutover.approved is set
+	CutoverModeManual CutoverMode = "Manual"
+	// CutoverModeScheduled starts the cutover at spec.cutover.scheduledAt
+	CutoverModeScheduled CutoverMode = "Scheduled"
+	// CutoverModeAutomatic starts the cutover as soon as the replication lag is below the threshold
+	CutoverModeAutomatic CutoverMode = "Automatic"
+)
+
+// CutoverSpec defines the managed cutover from the source to the target.
+// The cutover waits for the replication lag to drop below MaxLag, puts the
+// source in read-only mode, drains the remaining changes and finally repoints
+// the application facing AppBinding to the target.
+type CutoverSpec struct {
+	// Mode decides when the cutover starts
+	// +kubebuilder:default=Manual
+	// +optional
+	Mode CutoverMode `json:"mode,omitempty"`
+
+	// Approved starts a Manual cutover. It has no effect for the other modes.
+	// +optional
+	Approved bool `json:"approved,omitempty"`
+
+	// ScheduledAt is the time a Scheduled cutover starts
+	// +optional
+	ScheduledAt *metav1.Time `json:"scheduledAt,omitempty"`
+
+	// MaxLag is the replication lag the streaming phase has to drop below before the source is made read-only
+	// +kubebuilder:default="5s"
+	// +optional
+	MaxLag *metav1.Duration `json:"maxLag,omitempty"`
+
+	// LagTimeout is how long the cutover waits for the lag to drop below MaxLag before it fails
+	// +kubebuilder:default="30m"
+	// +optional
+	LagTimeout *metav1.Duration `json:"lagTimeout,omitempty"`
+
+	// SetSourceReadOnly puts the source in read-only mode before the remaining changes are drained
+	// +kubebuilder:default=true
+	// +optional
+	SetSourceReadOnly *bool `json:"setSourceReadOnly,omitempty"`
+
+	// DrainTimeout is how long the cutover waits for the remaining changes to be applied on the target
+	// +kubebuilder:default="5m"
+	// +optional
+	DrainTimeout *metav1.Duration `json:"drainTimeout,omitempty"`
+
+	// AppBinding refers to the AppBinding the applications connect through.
+	// It is repointed from the source to the target once the changes are drained.
+	// +optional
+	AppBinding *kmapi.ObjectReference `json:"appBinding,omitempty"`
+
+	// RollbackOnFailure makes the source writable again and restores the AppBinding if the cutover fails
+	// +kubebuilder:default=true
+	// +optional
+	RollbackOnFailure *bool `json:"rollbackOnFailure,omitempty"`
+
+	// Rollback requests a rollback of a completed cutover: the AppBinding is pointed back
+	// at the source and the source is made writable again. Changes written to the target
+	// after the cutover are not copied back.
+	// +optional
+	Rollback bool `json:"rollback,omitempty"`
+}
+
+// CutoverPhase is the current step of the cutover
+// +kubebuilder:validation:Enum=Pending;WaitingForLag;SourceReadOnly;Draining;RepointingAppBinding;Completed;RollingBack;RolledBack;Failed
+type CutoverPhase string
+
+const (
+	CutoverPhasePending              CutoverPhase = "Pending"
+	CutoverPhaseWaitingForLag        CutoverPhase = "WaitingForLag"
+	CutoverPhaseSourceReadOnly       CutoverPhase = "SourceReadOnly"
+	CutoverPhaseDraining             CutoverPhase = "Draining"
+	CutoverPhaseRepointingAppBinding CutoverPhase = "RepointingAppBinding"
+	CutoverPhaseCompleted            CutoverPhase = "Completed"
+	CutoverPhaseRollingBack          CutoverPhase = "RollingBack"
+	CutoverPhaseRolledBack           CutoverPhase = "RolledBack"
+	CutoverPhaseFailed               CutoverPhase = "Failed"
+)
+
+// CutoverStatus contains the observed state of the cutover
+type CutoverStatus struct {
+	// Phase is the current step of the cutover
+	// +optional
+	Phase CutoverPhase `json:"phase,omitempty"`
+
+	// StartTime is the time the cutover started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the cutover completed or was rolled back
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// SourceReadOnlyTime is the time the source was put in read-only mode.
+	// Together with CompletionTime it gives the write downtime of the cutover.
+	// +optional
+	SourceReadOnlyTime *metav1.Time `json:"sourceReadOnlyTime,omitempty"`
+
+	// PreviousAppBindingSpec is the client config the AppBinding had before it was repointed.
+	// It is used to restore the AppBinding on rollback.
+	// +optional
+	PreviousAppBindingSpec *appcat.ClientConfig `json:"previousAppBindingSpec,omitempty"`
+
+	// Message is a human readable description of the current step
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// PreflightCheck is a prerequisite verified before the migration starts
+// +kubebuilder:validation:Enum=SourceConnection;TargetConnection;SourceReplicationConfig;SourcePrivileges;VersionCompatibility;TargetEmpty;TargetDiskHeadroom
+type PreflightCheck string
+
+const (
+	// PreflightCheckSourceConnection connects to the source with its ConnectionInfo
+	PreflightCheckSourceConnection PreflightCheck = "SourceConnection"
+	// PreflightCheckTargetConnection connects to the target with its ConnectionInfo
+	PreflightCheckTargetConnection PreflightCheck = "TargetConnection"
+	// PreflightCheckSourceReplicationConfig verifies the source is configured for change capture:
+	// wal_level=logical on Postgres, binlog_format=ROW on MySQL and MariaDB, CDC enabled on MSSQL
+	// and a readable oplog on MongoDB
+	PreflightCheckSourceReplicationConfig PreflightCheck = "SourceReplicationConfig"
+	// PreflightCheckSourcePrivileges verifies the source user has the privileges the migration needs,
+	// e.g. REPLICATION on Postgres or REPLICATION SLAVE and REPLICATION CLIENT on MySQL
+	PreflightCheckSourcePrivileges PreflightCheck = "SourcePrivileges"
+	// PreflightCheckVersionCompatibility verifies the target version can receive data from the source version
+	PreflightCheckVersionCompatibility PreflightCheck = "VersionCompatibility"
+	// PreflightCheckTargetEmpty verifies the target has none of the databases or tables to migrate
+	PreflightCheckTargetEmpty PreflightCheck = "TargetEmpty"
+	// PreflightCheckTargetDiskHeadroom verifies the target has enough free storage for the source data
+	PreflightCheckTargetDiskHeadroom PreflightCheck = "TargetDiskHeadroom"
+)
+
+// PreflightSpec defines the prerequisite checks run before the migrator Job is launched.
+// Every check is recorded as a condition of the same type.
+type PreflightSpec struct {
+	// Enabled controls whether the Preflight Phase should be executed.
+	// +kubebuilder:default=true
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// SkipChecks is the list of checks not to run
+	// +optional
+	SkipChecks []PreflightCheck `json:"skipChecks,omitempty"`
+
+	// WarnOnly records failed checks as conditions but starts the migration anyway
+	// +optional
+	WarnOnly bool `json:"warnOnly,omitempty"`
+
+	// DiskHeadroomPercent is the free storage, as a percentage of the source data size,
+	// the target must have on top of the source data size
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	DiskHeadroomPercent *int32 `json:"diskHeadroomPercent,omitempty"`
+}
+
+// VerificationSpec defines how the target is compared with the source once the data is copied.
+// Row (or document) counts are always compared; checksums are compared when Checksum is set.
+type VerificationSpec struct {
+	// Enabled controls whether the Verification Phase should be executed.
+	// +optional
+	Enabled bool `json:"enabled"`
+
+	// Checksum compares a checksum of every table's rows in addition to the counts
+	// +kubebuilder:default=true
+	// +optional
+	Checksum *bool `json:"checksum,omitempty"`
+
+	// Sampling limits the checksum of large tables to a sample of their rows
+	// +optional
+	Sampling *VerificationSampling `json:"sampling,omitempty"`
+
+	// Tables is the list of tables (or collections) to verify, in schema.table or database.table form.
+	// All migrated tables are verified if empty.
+	// +optional
+	Tables []string `json:"tables,omitempty"`
+
+	// ExcludeTables is the list of tables (or collections) to skip
+	// +optional
+	ExcludeTables []string `json:"excludeTables,omitempty"`
+
+	// FailOnMismatch fails the migration if any table does not match
+	// +kubebuilder:default=true
+	// +optional
+	FailOnMismatch *bool `json:"failOnMismatch,omitempty"`
+
+	// Timeout is how long the verification may run before it fails
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// VerificationSampling defines when and how much of a table is sampled for the checksum
+type VerificationSampling struct {
+	// RowThreshold is the row count above which a table is sampled instead of fully checksummed
+	// +kubebuilder:default=1000000
+	// +optional
+	RowThreshold *int64 `json:"rowThreshold,omitempty"`
+
+	// Percent is the percentage of rows read from a sampled table, chosen by primary key ranges
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=10
+	// +optional
+	Percent *int32 `json:"percent,omitempty"`
+}
+
+// VerificationPhase is the state of the post-migration verification
+// +kubebuilder:validation:Enum=Pending;Running;Passed;Failed
+type VerificationPhase string
+
+const (
+	VerificationPhasePending VerificationPhase = "Pending"
+	VerificationPhaseRunning VerificationPhase = "Running"
+	VerificationPhasePassed  VerificationPhase = "Passed"
+	VerificationPhaseFailed  VerificationPhase = "Failed"
+)
+
+// VerificationResult is the outcome of verifying a single table
+// +kubebuilder:validation:Enum=Match;Mismatch;Error
+type VerificationResult string
+
+const (
+	VerificationResultMatch    VerificationResult = "Match"
+	VerificationResultMismatch VerificationResult = "Mismatch"
+	VerificationResultError    VerificationResult = "Error"
 )
 
+// VerificationStatus contains the observed state of the post-migration verification
+type VerificationStatus struct {
+	// Phase is the state of the verification
+	// +optional
+	Phase VerificationPhase `json:"phase,omitempty"`
+
+	// StartTime is the time the verification started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time the verification finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// MismatchedTables is the number of tables whose result is not Match
+	// +optional
+	MismatchedTables int32 `json:"mismatchedTables,omitempty"`
+
+	// Tables contains the result of every verified table
+	// +optional
+	Tables []TableVerification `json:"tables,omitempty"`
+}
+
+// TableVerification contains the verification result of a single table (or collection)
+type TableVerification struct {
+	// Name is the name of the table, in schema.table or database.table form
+	Name string `json:"name"`
+
+	// Result is the outcome of the verification
+	// +optional
+	Result VerificationResult `json:"result,omitempty"`
+
+	// SourceCount is the number of rows or documents on the source
+	// +optional
+	SourceCount int64 `json:"sourceCount,omitempty"`
+
+	// TargetCount is the number of rows or documents on the target
+	// +optional
+	TargetCount int64 `json:"targetCount,omitempty"`
+
+	// SourceChecksum is the checksum of the compared rows on the source
+	// +optional
+	SourceChecksum string `json:"sourceChecksum,omitempty"`
+
+	// TargetChecksum is the checksum of the compared rows on the target
+	// +optional
+	TargetChecksum string `json:"targetChecksum,omitempty"`
+
+	// SampledRows is the number of rows the checksums cover, if the table was sampled
+	// +optional
+	SampledRows *int64 `json:"sampledRows,omitempty"`
+
+	// Message describes the mismatch or error, if any
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // Progress contains the current progress of migration
 type Progress struct {
 	// DBType indicates the type of database
 	// +optional
 	DBType string `json:"dbType,omitempty"`
 
-	// Info contains the additional information about the current progress
+	// Info contains the additional information about the current progress.
+	// Prefer the typed fields below; Info is kept for engine specific details.
 	// +optional
 	Info map[string]string `json:"info,omitempty"`
+
+	// Stage is the stage the migration is currently in
+	// +optional
+	Stage MigrationStage `json:"stage,omitempty"`
+
+	// SnapshotPercent is the overall completion of the snapshot stage, from 0 to 100
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SnapshotPercent *int32 `json:"snapshotPercent,omitempty"`
+
+	// Lag is the replication lag of the streaming stage
+	// +optional
+	Lag *ReplicationLag `json:"lag,omitempty"`
+
+	// EstimatedTimeRemaining is the estimated time until the current stage completes
+	// +optional
+	EstimatedTimeRemaining *metav1.Duration `json:"estimatedTimeRemaining,omitempty"`
+
+	// Tables contains the per-table (or per-collection) progress
+	// +optional
+	Tables []TableProgress `json:"tables,omitempty"`
+
+	// Indices contains the per-index document counts of an Elasticsearch migration
+	// +optional
+	Indices []IndexProgress `json:"indices,omitempty"`
+
+	// LastUpdateTime is the time the migrator last reported progress
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// MigrationStage is a stage of the migration pipeline
+// +kubebuilder:validation:Enum=Preflight;Schema;Snapshot;Streaming;Verification;Cutover
+type MigrationStage string
+
+const (
+	MigrationStagePreflight    MigrationStage = "Preflight"
+	MigrationStageSchema       MigrationStage = "Schema"
+	MigrationStageSnapshot     MigrationStage = "Snapshot"
+	MigrationStageStreaming    MigrationStage = "Streaming"
+	MigrationStageVerification MigrationStage = "Verification"
+	MigrationStageCutover      MigrationStage = "Cutover"
+)
+
+// ReplicationLag is how far the target is behind the source
+type ReplicationLag struct {
+	// Seconds is the time between the last change applied on the target and the latest change on the source
+	// +optional
+	Seconds int64 `json:"seconds"`
+
+	// Bytes is the amount of change log (WAL, binlog, oplog or CDC) not yet applied on the target
+	// +optional
+	Bytes int64 `json:"bytes"`
+}
+
+// TableProgress contains the progress of a single table or collection
+type TableProgress struct {
+	// Name is the name of the table, in schema.table or database.table form
+	Name string `json:"name"`
+
+	// Stage is the stage the table is currently in
+	// +optional
+	Stage MigrationStage `json:"stage,omitempty"`
+
+	// RowsCopied is the number of rows or documents copied to the target so far
+	// +optional
+	RowsCopied int64 `json:"rowsCopied,omitempty"`
+
+	// RowsTotal is the (estimated) number of rows or documents on the source
+	// +optional
+	RowsTotal int64 `json:"rowsTotal,omitempty"`
+
+	// BytesCopied is the amount of data copied to the target so far
+	// +optional
+	BytesCopied int64 `json:"bytesCopied,omitempty"`
+
+	// Completed is true once the snapshot of the table is finished
+	// +optional
+	Completed bool `json:"completed,omitempty"`
+
+	// LastError is the last error the migrator hit on the table, if any
+	// +optional
+	LastError string `json:"lastError,omitempty"`
+
+	// LastErrorTime is the time LastError happened
+	// +optional
+	LastErrorTime *metav1.Time `json:"lastErrorTime,omitempty"`
+}
+
+// IndexProgress contains the document counts of a single migrated index
+type IndexProgress struct {
+	// Name is the name of the index on the source
+	Name string `json:"name"`
+
+	// TargetName is the name of the index on the target, if it differs from Name
+	// +optional
+	TargetName string `json:"targetName,omitempty"`
+
+	// SourceDocCount is the number of documents in the source index
+	// +optional
+	SourceDocCount int64 `json:"sourceDocCount,omitempty"`
+
+	// TargetDocCount is the number of documents written to the target index so far
+	// +optional
+	TargetDocCount int64 `json:"targetDocCount,omitempty"`
 }
 
 // MigrationList contains a list of Migration
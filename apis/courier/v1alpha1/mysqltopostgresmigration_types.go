@@ -0,0 +1,202 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ofst "kmodules.xyz/offshoot-api/api/v1"
+)
+
+const (
+	ResourceKindMySQLToPostgresMigration     = "MySQLToPostgresMigration"
+	ResourceSingularMySQLToPostgresMigration = "mysqltopostgresmigration"
+	ResourcePluralMySQLToPostgresMigrations  = "mysqltopostgresmigrations"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=mysqltopostgresmigrations,singular=mysqltopostgresmigration,shortName=my2pgmig,categories={kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Engine",type="string",JSONPath=".spec.source.engine"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Stage",type="string",JSONPath=".status.progress.stage"
+// +kubebuilder:printcolumn:name="Lag(s)",type="integer",JSONPath=".status.progress.lag.seconds"
+// +kubebuilder:printcolumn:name="Snapshot(%)",type="integer",JSONPath=".status.progress.snapshotPercent"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type MySQLToPostgresMigration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of MySQLToPostgresMigration
+	// +required
+	Spec MySQLToPostgresMigrationSpec `json:"spec"`
+
+	// status defines the observed state of MySQLToPostgresMigration.
+	// It reuses the shared MigrationStatus so that the Migration duck type can
+	// project it and the operator's status patches replay onto it unchanged.
+	// +optional
+	Status MigrationStatus `json:"status,omitzero"`
+}
+
+// MySQLToPostgresMigrationSpec defines the desired state of MySQLToPostgresMigration
+type MySQLToPostgresMigrationSpec struct {
+	// Source defines the source MySQL or MariaDB database configuration
+	Source MySQLToPostgresSource `json:"source"`
+
+	// Target defines the target KubeDB Postgres configuration
+	Target PostgresTarget `json:"target"`
+
+	// JobDefaults specifies default settings for migration jobs
+	// +optional
+	JobDefaults *JobDefaults `json:"jobDefaults,omitempty"`
+
+	// JobTemplate specifies runtime configurations for the migration Job
+	// +optional
+	JobTemplate *ofst.PodTemplateSpec `json:"jobTemplate,omitempty"`
+
+	// Cutover configures the managed switch of applications from the source to the target
+	// +optional
+	Cutover *CutoverSpec `json:"cutover,omitempty"`
+
+	// Preflight configures the prerequisite checks run before any data is moved
+	// +optional
+	Preflight *PreflightSpec `json:"preflight,omitempty"`
+
+	// DryRun runs only the preflight checks and reports the findings as conditions; no data is moved
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Paused stops the migrator Job after saving the executed GTID set, or the binlog position, of the MySQL source in status.
+	// Unpausing resumes translating changes from there without copying the snapshot again.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// MySQLToPostgresMigrationList contains a list of MySQLToPostgresMigration
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MySQLToPostgresMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []MySQLToPostgresMigration `json:"items"`
+}
+
+// MySQLSourceEngine is the engine of a MySQL wire compatible source database
+// +kubebuilder:validation:Enum=MySQL;MariaDB
+type MySQLSourceEngine string
+
+const (
+	MySQLSourceEngineMySQL   MySQLSourceEngine = "MySQL"
+	MySQLSourceEngineMariaDB MySQLSourceEngine = "MariaDB"
+)
+
+// IdentifierCase is the case folding applied to identifiers when they are created on the target
+// +kubebuilder:validation:Enum=Lower;Upper;Preserve
+type IdentifierCase string
+
+const (
+	// IdentifierCaseLower folds identifiers to lower case, so they can be used unquoted in Postgres
+	IdentifierCaseLower IdentifierCase = "Lower"
+	// IdentifierCaseUpper folds identifiers to upper case; they have to be quoted in Postgres
+	IdentifierCaseUpper IdentifierCase = "Upper"
+	// IdentifierCasePreserve keeps identifiers as they are on the source; mixed case ones have to be quoted in Postgres
+	IdentifierCasePreserve IdentifierCase = "Preserve"
+)
+
+type MySQLToPostgresSource struct {
+	// Engine is the engine of the source database.
+	// +kubebuilder:default=MySQL
+	// +optional
+	Engine MySQLSourceEngine `yaml:"engine" json:"engine,omitempty"`
+
+	// ConnectionInfo refers to the source MySQL or MariaDB database connection information.
+	ConnectionInfo ConnectionInfo `yaml:"connectionInfo" json:"connectionInfo"`
+
+	// Schema selects the databases to migrate. The schema phase translates their
+	// tables, indexes and constraints into Postgres DDL before any data is copied.
+	// +optional
+	Schema *MySQLSchema `yaml:"schema" json:"schema,omitempty"`
+
+	// Snapshot configures the initial data copy.
+	// +optional
+	Snapshot *MySQLSnapshot `yaml:"snapshot" json:"snapshot,omitempty"`
+
+	// Streaming configures the binlog based change replication that runs after the snapshot until cutover.
+	// +optional
+	Streaming *MySQLStreaming `yaml:"streaming" json:"streaming,omitempty"`
+
+	// Translation configures how the source schema is translated to Postgres.
+	// +optional
+	Translation *SchemaTranslation `yaml:"translation" json:"translation,omitempty"`
+}
+
+type SchemaTranslation struct {
+	// TypeMappings maps source column types to Postgres column types.
+	// Entries here take precedence over the built-in mappings; see DefaultMySQLToPostgresTypeMappings.
+	// +optional
+	TypeMappings []TypeMapping `yaml:"typeMappings" json:"typeMappings,omitempty"`
+
+	// SkipDefaultTypeMappings disables the built-in mappings, so that only TypeMappings are used.
+	// Migration fails in the schema phase if a column type has no mapping.
+	// +optional
+	SkipDefaultTypeMappings bool `yaml:"skipDefaultTypeMappings" json:"skipDefaultTypeMappings,omitempty"`
+
+	// IdentifierCase is the case folding applied to database, table, column, index and constraint names.
+	// +kubebuilder:default=Lower
+	// +optional
+	IdentifierCase IdentifierCase `yaml:"identifierCase" json:"identifierCase,omitempty"`
+
+	// TargetSchema is the Postgres schema all tables are created in.
+	// If empty, every source database becomes a Postgres schema of the same name.
+	// +optional
+	TargetSchema string `yaml:"targetSchema" json:"targetSchema,omitempty"`
+
+	// SchemaOnly stops the migration after the schema phase, so the translated DDL can be reviewed.
+	// +optional
+	SchemaOnly bool `yaml:"schemaOnly" json:"schemaOnly,omitempty"`
+}
+
+type TypeMapping struct {
+	// Source is the source column type, e.g. TINYINT(1) or DATETIME.
+	// A type with parameters matches only those parameters; a bare type name matches any parameters.
+	// Matching is case-insensitive.
+	Source string `yaml:"source" json:"source"`
+
+	// Target is the Postgres column type, e.g. boolean or timestamptz.
+	// A Target without parameters keeps the length, precision and scale of the source column if the Postgres
+	// type takes them, e.g. VARCHAR(255) becomes varchar(255) and DECIMAL(10,2) becomes numeric(10,2);
+	// other parameters, such as the display width of INT(11), are dropped.
+	// A Target with parameters, e.g. varchar(64), is used as is.
+	Target string `yaml:"target" json:"target"`
+
+	// Columns restricts the mapping to the listed columns, in table.column or database.table.column form.
+	// The mapping applies to every column of the Source type if empty.
+	// +optional
+	Columns []string `yaml:"columns" json:"columns,omitempty"`
+}
+
+func init() {
+	SchemeBuilder.Register(&MySQLToPostgresMigration{}, &MySQLToPostgresMigrationList{})
+}
@@ -0,0 +1,233 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	ofst "kmodules.xyz/offshoot-api/api/v1"
+)
+
+const (
+	ResourceKindRedisMigration     = "RedisMigration"
+	ResourceSingularRedisMigration = "redismigration"
+	ResourcePluralRedisMigrations  = "redismigrations"
+)
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=redismigrations,singular=redismigration,shortName=rdmig,categories={kubedb,appscode,all}
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Stage",type="string",JSONPath=".status.progress.stage"
+// +kubebuilder:printcolumn:name="Lag(s)",type="integer",JSONPath=".status.progress.lag.seconds"
+// +kubebuilder:printcolumn:name="Snapshot(%)",type="integer",JSONPath=".status.progress.snapshotPercent"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type RedisMigration struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata is a standard object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitzero"`
+
+	// spec defines the desired state of RedisMigration
+	// +required
+	Spec RedisMigrationSpec `json:"spec"`
+
+	// status defines the observed state of RedisMigration.
+	// It reuses the shared MigrationStatus so that the Migration duck type can
+	// project it and the operator's status patches replay onto it unchanged.
+	// +optional
+	Status MigrationStatus `json:"status,omitzero"`
+}
+
+// RedisMigrationSpec defines the desired state of RedisMigration
+type RedisMigrationSpec struct {
+	// Source defines the source Redis deployment configuration
+	Source RedisSource `json:"source"`
+
+	// Target defines the target KubeDB Redis configuration
+	Target RedisTarget `json:"target"`
+
+	// JobDefaults specifies default settings for migration jobs
+	// +optional
+	JobDefaults *JobDefaults `json:"jobDefaults,omitempty"`
+
+	// JobTemplate specifies runtime configurations for the migration Job
+	// +optional
+	JobTemplate *ofst.PodTemplateSpec `json:"jobTemplate,omitempty"`
+
+	// Cutover configures the managed switch of applications from the source to the target
+	// +optional
+	Cutover *CutoverSpec `json:"cutover,omitempty"`
+
+	// Preflight configures the prerequisite checks run before any data is moved
+	// +optional
+	Preflight *PreflightSpec `json:"preflight,omitempty"`
+
+	// DryRun runs only the preflight checks and reports the findings as conditions; no data is moved
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
+
+	// Paused stops the migrator Job after saving the replication ID and offset of the source in status.
+	// Unpausing resumes with a partial resynchronization (PSYNC) from that offset; a full
+	// resynchronization is done if the source backlog no longer holds it.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+}
+
+// RedisMigrationList contains a list of RedisMigration
+
+// +kubebuilder:object:root=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RedisMigrationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitzero"`
+	Items           []RedisMigration `json:"items"`
+}
+
+// RedisSourceMode is the topology of the source Redis deployment
+// +kubebuilder:validation:Enum=Standalone;Sentinel;Cluster
+type RedisSourceMode string
+
+const (
+	// RedisSourceModeStandalone reads from a single Redis primary
+	RedisSourceModeStandalone RedisSourceMode = "Standalone"
+	// RedisSourceModeSentinel discovers the current primary through Redis Sentinel
+	RedisSourceModeSentinel RedisSourceMode = "Sentinel"
+	// RedisSourceModeCluster reads every primary shard of a Redis Cluster
+	RedisSourceModeCluster RedisSourceMode = "Cluster"
+)
+
+type RedisSource struct {
+	// ConnectionInfo refers to the source Redis connection information.
+	// For Sentinel and Cluster sources it points at any reachable node; the
+	// remaining topology is discovered from there.
+	ConnectionInfo ConnectionInfo `yaml:"connectionInfo" json:"connectionInfo"`
+
+	// Mode is the topology of the source Redis deployment.
+	// +kubebuilder:default=Standalone
+	// +optional
+	Mode RedisSourceMode `yaml:"mode" json:"mode,omitempty"`
+
+	// Sentinel holds the Sentinel specific settings. Used only when Mode is Sentinel.
+	// +optional
+	Sentinel *RedisSentinelSource `yaml:"sentinel" json:"sentinel,omitempty"`
+
+	// Cluster holds the Redis Cluster specific settings. Used only when Mode is Cluster.
+	// +optional
+	Cluster *RedisClusterSource `yaml:"cluster" json:"cluster,omitempty"`
+
+	// Snapshot configures the full keyspace copy.
+	// +optional
+	Snapshot *RedisSnapshot `yaml:"snapshot" json:"snapshot,omitempty"`
+
+	// Streaming configures the change replication that runs after the snapshot until cutover.
+	// +optional
+	Streaming *RedisStreaming `yaml:"streaming" json:"streaming,omitempty"`
+
+	// DBMapping remaps logical database indexes from the source to the target.
+	// Databases not listed keep their index. Ignored for Cluster sources, which only have db 0.
+	// +optional
+	DBMapping []RedisDBMapping `yaml:"dbMapping" json:"dbMapping,omitempty"`
+
+	// KeyFilter selects the keys to migrate by glob pattern.
+	// +optional
+	KeyFilter *RedisKeyFilter `yaml:"keyFilter" json:"keyFilter,omitempty"`
+}
+
+type RedisTarget struct {
+	// ConnectionInfo refers to the target Redis connection information.
+	ConnectionInfo ConnectionInfo `yaml:"connectionInfo" json:"connectionInfo"`
+}
+
+type RedisSentinelSource struct {
+	// MasterName is the name of the monitored primary set, as configured in Sentinel.
+	MasterName string `yaml:"masterName" json:"masterName"`
+
+	// Addresses is the list of Sentinel endpoints in host:port form.
+	// +optional
+	Addresses []string `yaml:"addresses" json:"addresses,omitempty"`
+
+	// AppBinding refers to the AppBinding of the Sentinel, if Sentinel uses its own credentials.
+	// +optional
+	AppBinding *kmapi.ObjectReference `yaml:"appBinding,omitempty" json:"appBinding,omitempty"`
+}
+
+type RedisClusterSource struct {
+	// SeedAddresses is the list of cluster nodes in host:port form used to discover the slot layout.
+	// If empty, the node in ConnectionInfo is used.
+	// +optional
+	SeedAddresses []string `yaml:"seedAddresses" json:"seedAddresses,omitempty"`
+
+	// ReadFromReplicas reads the snapshot from replica nodes to keep load off the primaries.
+	// +optional
+	ReadFromReplicas bool `yaml:"readFromReplicas" json:"readFromReplicas,omitempty"`
+}
+
+type RedisSnapshot struct {
+	// Enabled controls whether the Snapshot Phase should be executed.
+	// +optional
+	Enabled  bool                   `yaml:"enabled" json:"enabled"`
+	Pipeline *RedisSnapshotPipeline `yaml:"pipeline" json:"pipeline,omitempty"`
+
+	// PreserveTTL copies the remaining time-to-live of every key along with its value.
+	// +kubebuilder:default=true
+	// +optional
+	PreserveTTL *bool `yaml:"preserveTTL" json:"preserveTTL,omitempty"`
+}
+
+type RedisStreaming struct {
+	// Enabled controls whether the Streaming Phase should be executed.
+	// The source is read as a replica (PSYNC) and every write command is replayed on the target.
+	// +optional
+	Enabled bool `yaml:"enabled" json:"enabled"`
+}
+
+type RedisDBMapping struct {
+	// Source is the logical database index on the source.
+	// +kubebuilder:validation:Minimum=0
+	Source int32 `yaml:"source" json:"source"`
+	// Target is the logical database index on the target.
+	// +kubebuilder:validation:Minimum=0
+	Target int32 `yaml:"target" json:"target"`
+}
+
+type RedisKeyFilter struct {
+	// Include is the list of glob patterns (as in SCAN MATCH) a key must match to be migrated.
+	// All keys are included if empty.
+	// +optional
+	Include []string `yaml:"include" json:"include,omitempty"`
+	// Exclude is the list of glob patterns for keys to skip. Exclude wins over Include.
+	// +optional
+	Exclude []string `yaml:"exclude" json:"exclude,omitempty"`
+}
+
+type RedisSnapshotPipeline struct {
+	Workers        *int `yaml:"workers" json:"workers"`
+	Buffer         *int `yaml:"buffer" json:"buffer"`
+	ScanCount      *int `yaml:"scanCount" json:"scan_count"`
+	WriteBatchSize *int `yaml:"writeBatchSize" json:"write_batch_size"`
+}
+
+func init() {
+	SchemeBuilder.Register(&RedisMigration{}, &RedisMigrationList{})
+}
@@ -43,6 +43,12 @@ type Source struct {
 	*MongoDBSource `json:",inline,omitempty"`
 	// MSSQLServer refers to the source MSSQL Server database configuration
 	*MSSQLServerSource `json:",inline,omitempty"`
+	// Redis refers to the source Redis configuration
+	*RedisSource `json:",inline,omitempty"`
+	// Elasticsearch refers to the source Elasticsearch or OpenSearch configuration
+	*ElasticsearchSource `json:",inline,omitempty"`
+	// MySQLToPostgres refers to the source MySQL or MariaDB configuration of a migration into Postgres
+	*MySQLToPostgresSource `json:",inline,omitempty"`
 }
 
 // Target defines the target database configuration
@@ -62,6 +68,10 @@ type Target struct {
 	*MariaDBTarget `json:",inline,omitempty"`
 	// MSSQLServer refers to the target MSSQL Server database configuration
 	*MSSQLServerTarget `json:",inline,omitempty"`
+	// Redis refers to the target Redis configuration
+	*RedisTarget `json:",inline,omitempty"`
+	// Elasticsearch refers to the target Elasticsearch configuration
+	*ElasticsearchTarget `json:",inline,omitempty"`
 }
 
 type ConnectionInfo struct {
@@ -23,8 +23,10 @@ package v1alpha1
 
 import (
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	apiv1 "kmodules.xyz/client-go/api/v1"
+	v1alpha1 "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
 	offshootapiapiv1 "kmodules.xyz/offshoot-api/api/v1"
 )
 
@@ -57,186 +59,205 @@ func (in *Branch) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchHistoryLimit) DeepCopyInto(out *BranchHistoryLimit) {
+func (in *BranchAppliedMaskingRule) DeepCopyInto(out *BranchAppliedMaskingRule) {
 	*out = *in
-	if in.Success != nil {
-		in, out := &in.Success, &out.Success
-		*out = new(int32)
-		**out = **in
-	}
-	if in.Failed != nil {
-		in, out := &in.Failed, &out.Failed
-		*out = new(int32)
-		**out = **in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchHistoryLimit.
-func (in *BranchHistoryLimit) DeepCopy() *BranchHistoryLimit {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchAppliedMaskingRule.
+func (in *BranchAppliedMaskingRule) DeepCopy() *BranchAppliedMaskingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchHistoryLimit)
+	out := new(BranchAppliedMaskingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchList) DeepCopyInto(out *BranchList) {
+func (in *BranchArchiverSource) DeepCopyInto(out *BranchArchiverSource) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Branch, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RecoveryTimestamp != nil {
+		in, out := &in.RecoveryTimestamp, &out.RecoveryTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.EncryptionSecret != nil {
+		in, out := &in.EncryptionSecret, &out.EncryptionSecret
+		*out = new(apiv1.ObjectReference)
+		**out = **in
+	}
+	if in.ManifestRepository != nil {
+		in, out := &in.ManifestRepository, &out.ManifestRepository
+		*out = new(apiv1.ObjectReference)
+		**out = **in
+	}
+	if in.FullDBRepository != nil {
+		in, out := &in.FullDBRepository, &out.FullDBRepository
+		*out = new(apiv1.ObjectReference)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchList.
-func (in *BranchList) DeepCopy() *BranchList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchArchiverSource.
+func (in *BranchArchiverSource) DeepCopy() *BranchArchiverSource {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchList)
+	out := new(BranchArchiverSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BranchList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchRun) DeepCopyInto(out *BranchRun) {
+func (in *BranchColumnMask) DeepCopyInto(out *BranchColumnMask) {
 	*out = *in
-	in.At.DeepCopyInto(&out.At)
+	if in.Redact != nil {
+		in, out := &in.Redact, &out.Redact
+		*out = new(BranchRedactOptions)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchRun.
-func (in *BranchRun) DeepCopy() *BranchRun {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchColumnMask.
+func (in *BranchColumnMask) DeepCopy() *BranchColumnMask {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchRun)
+	out := new(BranchColumnMask)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchSchedule) DeepCopyInto(out *BranchSchedule) {
+func (in *BranchExpiry) DeepCopyInto(out *BranchExpiry) {
 	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.WarningPeriod != nil {
+		in, out := &in.WarningPeriod, &out.WarningPeriod
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSchedule.
-func (in *BranchSchedule) DeepCopy() *BranchSchedule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchExpiry.
+func (in *BranchExpiry) DeepCopy() *BranchExpiry {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchSchedule)
+	out := new(BranchExpiry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchSnapshotRef) DeepCopyInto(out *BranchSnapshotRef) {
+func (in *BranchHistoryLimit) DeepCopyInto(out *BranchHistoryLimit) {
 	*out = *in
+	if in.Success != nil {
+		in, out := &in.Success, &out.Success
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Failed != nil {
+		in, out := &in.Failed, &out.Failed
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSnapshotRef.
-func (in *BranchSnapshotRef) DeepCopy() *BranchSnapshotRef {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchHistoryLimit.
+func (in *BranchHistoryLimit) DeepCopy() *BranchHistoryLimit {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchSnapshotRef)
+	out := new(BranchHistoryLimit)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchSource) DeepCopyInto(out *BranchSource) {
+func (in *BranchList) DeepCopyInto(out *BranchList) {
 	*out = *in
-	in.DatabaseRef.DeepCopyInto(&out.DatabaseRef)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Branch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSource.
-func (in *BranchSource) DeepCopy() *BranchSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchList.
+func (in *BranchList) DeepCopy() *BranchList {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchSource)
+	out := new(BranchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchSpec) DeepCopyInto(out *BranchSpec) {
+func (in *BranchMaskingPolicy) DeepCopyInto(out *BranchMaskingPolicy) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Target.DeepCopyInto(&out.Target)
-	if in.Schedule != nil {
-		in, out := &in.Schedule, &out.Schedule
-		*out = new(BranchSchedule)
-		**out = **in
-	}
-	if in.HistoryLimit != nil {
-		in, out := &in.HistoryLimit, &out.HistoryLimit
-		*out = new(BranchHistoryLimit)
+	if in.SaltSecretRef != nil {
+		in, out := &in.SaltSecretRef, &out.SaltSecretRef
+		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]BranchMaskingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSpec.
-func (in *BranchSpec) DeepCopy() *BranchSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchMaskingPolicy.
+func (in *BranchMaskingPolicy) DeepCopy() *BranchMaskingPolicy {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchSpec)
+	out := new(BranchMaskingPolicy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
+func (in *BranchMaskingRule) DeepCopyInto(out *BranchMaskingRule) {
 	*out = *in
-	if in.TargetRef != nil {
-		in, out := &in.TargetRef, &out.TargetRef
-		*out = new(v1.ObjectReference)
-		**out = **in
-	}
-	if in.Snapshot != nil {
-		in, out := &in.Snapshot, &out.Snapshot
-		*out = new(BranchSnapshotRef)
-		**out = **in
-	}
-	if in.LastRefreshAt != nil {
-		in, out := &in.LastRefreshAt, &out.LastRefreshAt
-		*out = (*in).DeepCopy()
-	}
-	out.Freshness = in.Freshness
-	if in.History != nil {
-		in, out := &in.History, &out.History
-		*out = make([]BranchRun, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]apiv1.Condition, len(*in))
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]BranchColumnMask, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -244,40 +265,45 @@ func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchStatus.
-func (in *BranchStatus) DeepCopy() *BranchStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchMaskingRule.
+func (in *BranchMaskingRule) DeepCopy() *BranchMaskingRule {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchStatus)
+	out := new(BranchMaskingRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchTarget) DeepCopyInto(out *BranchTarget) {
+func (in *BranchMaskingStatus) DeepCopyInto(out *BranchMaskingStatus) {
 	*out = *in
-	in.Resources.DeepCopyInto(&out.Resources)
-	if in.IssuerRef != nil {
-		in, out := &in.IssuerRef, &out.IssuerRef
-		*out = new(v1.TypedLocalObjectReference)
-		(*in).DeepCopyInto(*out)
+	if in.AppliedAt != nil {
+		in, out := &in.AppliedAt, &out.AppliedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]BranchAppliedMaskingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchTarget.
-func (in *BranchTarget) DeepCopy() *BranchTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchMaskingStatus.
+func (in *BranchMaskingStatus) DeepCopy() *BranchMaskingStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchTarget)
+	out := new(BranchMaskingStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchWork) DeepCopyInto(out *BranchWork) {
+func (in *BranchQuota) DeepCopyInto(out *BranchQuota) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -286,18 +312,18 @@ func (in *BranchWork) DeepCopyInto(out *BranchWork) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWork.
-func (in *BranchWork) DeepCopy() *BranchWork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchQuota.
+func (in *BranchQuota) DeepCopy() *BranchQuota {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchWork)
+	out := new(BranchQuota)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BranchWork) DeepCopyObject() runtime.Object {
+func (in *BranchQuota) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -305,13 +331,13 @@ func (in *BranchWork) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchWorkList) DeepCopyInto(out *BranchWorkList) {
+func (in *BranchQuotaList) DeepCopyInto(out *BranchQuotaList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]BranchWork, len(*in))
+		*out = make([]BranchQuota, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -319,18 +345,18 @@ func (in *BranchWorkList) DeepCopyInto(out *BranchWorkList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkList.
-func (in *BranchWorkList) DeepCopy() *BranchWorkList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchQuotaList.
+func (in *BranchQuotaList) DeepCopy() *BranchQuotaList {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchWorkList)
+	out := new(BranchQuotaList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *BranchWorkList) DeepCopyObject() runtime.Object {
+func (in *BranchQuotaList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -338,9 +364,40 @@ func (in *BranchWorkList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchWorkManifestCondition) DeepCopyInto(out *BranchWorkManifestCondition) {
+func (in *BranchQuotaSpec) DeepCopyInto(out *BranchQuotaSpec) {
 	*out = *in
-	out.ResourceMeta = in.ResourceMeta
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxBranches != nil {
+		in, out := &in.MaxBranches, &out.MaxBranches
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxClonedStorage != nil {
+		in, out := &in.MaxClonedStorage, &out.MaxClonedStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchQuotaSpec.
+func (in *BranchQuotaSpec) DeepCopy() *BranchQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchQuotaStatus) DeepCopyInto(out *BranchQuotaStatus) {
+	*out = *in
+	in.Used.DeepCopyInto(&out.Used)
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]apiv1.Condition, len(*in))
@@ -351,251 +408,373 @@ func (in *BranchWorkManifestCondition) DeepCopyInto(out *BranchWorkManifestCondi
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkManifestCondition.
-func (in *BranchWorkManifestCondition) DeepCopy() *BranchWorkManifestCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchQuotaStatus.
+func (in *BranchQuotaStatus) DeepCopy() *BranchQuotaStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchWorkManifestCondition)
+	out := new(BranchQuotaStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchWorkResourceMeta) DeepCopyInto(out *BranchWorkResourceMeta) {
+func (in *BranchQuotaUsage) DeepCopyInto(out *BranchQuotaUsage) {
 	*out = *in
+	out.ClonedStorage = in.ClonedStorage.DeepCopy()
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkResourceMeta.
-func (in *BranchWorkResourceMeta) DeepCopy() *BranchWorkResourceMeta {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchQuotaUsage.
+func (in *BranchQuotaUsage) DeepCopy() *BranchQuotaUsage {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchWorkResourceMeta)
+	out := new(BranchQuotaUsage)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchWorkSpec) DeepCopyInto(out *BranchWorkSpec) {
+func (in *BranchRecoveryPoint) DeepCopyInto(out *BranchRecoveryPoint) {
 	*out = *in
-	if in.Manifests != nil {
-		in, out := &in.Manifests, &out.Manifests
-		*out = make([]runtime.RawExtension, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.RequestedTimestamp != nil {
+		in, out := &in.RequestedTimestamp, &out.RequestedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.RecoveredTimestamp != nil {
+		in, out := &in.RecoveredTimestamp, &out.RecoveredTimestamp
+		*out = (*in).DeepCopy()
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkSpec.
-func (in *BranchWorkSpec) DeepCopy() *BranchWorkSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchRecoveryPoint.
+func (in *BranchRecoveryPoint) DeepCopy() *BranchRecoveryPoint {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchWorkSpec)
+	out := new(BranchRecoveryPoint)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchWorkStatus) DeepCopyInto(out *BranchWorkStatus) {
+func (in *BranchRedactOptions) DeepCopyInto(out *BranchRedactOptions) {
 	*out = *in
-	if in.Branch != nil {
-		in, out := &in.Branch, &out.Branch
-		*out = new(BranchStatus)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Manifests != nil {
-		in, out := &in.Manifests, &out.Manifests
-		*out = make([]BranchWorkManifestCondition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]apiv1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkStatus.
-func (in *BranchWorkStatus) DeepCopy() *BranchWorkStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchRedactOptions.
+func (in *BranchRedactOptions) DeepCopy() *BranchRedactOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchWorkStatus)
+	out := new(BranchRedactOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ConnectionInfo) DeepCopyInto(out *ConnectionInfo) {
+func (in *BranchRun) DeepCopyInto(out *BranchRun) {
 	*out = *in
-	if in.AppBinding != nil {
-		in, out := &in.AppBinding, &out.AppBinding
-		*out = new(apiv1.ObjectReference)
-		**out = **in
-	}
-	if in.MaxConnections != nil {
-		in, out := &in.MaxConnections, &out.MaxConnections
-		*out = new(int32)
-		**out = **in
-	}
-	if in.TLS != nil {
-		in, out := &in.TLS, &out.TLS
-		*out = new(TLSConfig)
-		**out = **in
+	in.At.DeepCopyInto(&out.At)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchRun.
+func (in *BranchRun) DeepCopy() *BranchRun {
+	if in == nil {
+		return nil
 	}
+	out := new(BranchRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchSchedule) DeepCopyInto(out *BranchSchedule) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionInfo.
-func (in *ConnectionInfo) DeepCopy() *ConnectionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSchedule.
+func (in *BranchSchedule) DeepCopy() *BranchSchedule {
 	if in == nil {
 		return nil
 	}
-	out := new(ConnectionInfo)
+	out := new(BranchSchedule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBCourierCLI) DeepCopyInto(out *DBCourierCLI) {
+func (in *BranchSchemaChange) DeepCopyInto(out *BranchSchemaChange) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCourierCLI.
-func (in *DBCourierCLI) DeepCopy() *DBCourierCLI {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSchemaChange.
+func (in *BranchSchemaChange) DeepCopy() *BranchSchemaChange {
 	if in == nil {
 		return nil
 	}
-	out := new(DBCourierCLI)
+	out := new(BranchSchemaChange)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBCourierImages) DeepCopyInto(out *DBCourierImages) {
+func (in *BranchSchemaDiffRequest) DeepCopyInto(out *BranchSchemaDiffRequest) {
 	*out = *in
-	out.CLI = in.CLI
-	out.StatusReporter = in.StatusReporter
+	if in.Databases != nil {
+		in, out := &in.Databases, &out.Databases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeObjectTypes != nil {
+		in, out := &in.ExcludeObjectTypes, &out.ExcludeObjectTypes
+		*out = make([]BranchSchemaObjectType, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCourierImages.
-func (in *DBCourierImages) DeepCopy() *DBCourierImages {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSchemaDiffRequest.
+func (in *BranchSchemaDiffRequest) DeepCopy() *BranchSchemaDiffRequest {
 	if in == nil {
 		return nil
 	}
-	out := new(DBCourierImages)
+	out := new(BranchSchemaDiffRequest)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DBCourierStatusReporter) DeepCopyInto(out *DBCourierStatusReporter) {
+func (in *BranchSchemaDiffStatus) DeepCopyInto(out *BranchSchemaDiffStatus) {
 	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	out.Summary = in.Summary
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]BranchSchemaChange, len(*in))
+		copy(*out, *in)
+	}
+	if in.ForwardDDLRef != nil {
+		in, out := &in.ForwardDDLRef, &out.ForwardDDLRef
+		*out = new(v1.ConfigMapKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCourierStatusReporter.
-func (in *DBCourierStatusReporter) DeepCopy() *DBCourierStatusReporter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSchemaDiffStatus.
+func (in *BranchSchemaDiffStatus) DeepCopy() *BranchSchemaDiffStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DBCourierStatusReporter)
+	out := new(BranchSchemaDiffStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JobDefaults) DeepCopyInto(out *JobDefaults) {
+func (in *BranchSchemaDiffSummary) DeepCopyInto(out *BranchSchemaDiffSummary) {
 	*out = *in
-	if in.BackoffLimit != nil {
-		in, out := &in.BackoffLimit, &out.BackoffLimit
-		*out = new(int32)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSchemaDiffSummary.
+func (in *BranchSchemaDiffSummary) DeepCopy() *BranchSchemaDiffSummary {
+	if in == nil {
+		return nil
 	}
-	if in.TTLSecondsAfterFinished != nil {
-		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
-		*out = new(int32)
-		**out = **in
+	out := new(BranchSchemaDiffSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchSnapshotRef) DeepCopyInto(out *BranchSnapshotRef) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSnapshotRef.
+func (in *BranchSnapshotRef) DeepCopy() *BranchSnapshotRef {
+	if in == nil {
+		return nil
 	}
-	if in.ActiveDeadlineSeconds != nil {
-		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
-		*out = new(int64)
-		**out = **in
+	out := new(BranchSnapshotRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchSource) DeepCopyInto(out *BranchSource) {
+	*out = *in
+	in.DatabaseRef.DeepCopyInto(&out.DatabaseRef)
+	if in.Archiver != nil {
+		in, out := &in.Archiver, &out.Archiver
+		*out = new(BranchArchiverSource)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobDefaults.
-func (in *JobDefaults) DeepCopy() *JobDefaults {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSource.
+func (in *BranchSource) DeepCopy() *BranchSource {
 	if in == nil {
 		return nil
 	}
-	out := new(JobDefaults)
+	out := new(BranchSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LogicalReplication) DeepCopyInto(out *LogicalReplication) {
+func (in *BranchSpec) DeepCopyInto(out *BranchSpec) {
 	*out = *in
-	if in.Publication != nil {
-		in, out := &in.Publication, &out.Publication
-		*out = new(Publication)
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.Masking != nil {
+		in, out := &in.Masking, &out.Masking
+		*out = new(BranchMaskingPolicy)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Subscription != nil {
-		in, out := &in.Subscription, &out.Subscription
-		*out = new(Subscription)
+	if in.Schedule != nil {
+		in, out := &in.Schedule, &out.Schedule
+		*out = new(BranchSchedule)
 		**out = **in
 	}
+	if in.HistoryLimit != nil {
+		in, out := &in.HistoryLimit, &out.HistoryLimit
+		*out = new(BranchHistoryLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Expiry != nil {
+		in, out := &in.Expiry, &out.Expiry
+		*out = new(BranchExpiry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaDiff != nil {
+		in, out := &in.SchemaDiff, &out.SchemaDiff
+		*out = new(BranchSchemaDiffRequest)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalReplication.
-func (in *LogicalReplication) DeepCopy() *LogicalReplication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSpec.
+func (in *BranchSpec) DeepCopy() *BranchSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LogicalReplication)
+	out := new(BranchSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerConnectionInfo) DeepCopyInto(out *MSSQLServerConnectionInfo) {
+func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
 	*out = *in
-	if in.AppBinding != nil {
-		in, out := &in.AppBinding, &out.AppBinding
-		*out = new(apiv1.ObjectReference)
+	if in.TargetRef != nil {
+		in, out := &in.TargetRef, &out.TargetRef
+		*out = new(v1.ObjectReference)
+		**out = **in
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(BranchSnapshotRef)
 		**out = **in
 	}
+	if in.RecoveryPoint != nil {
+		in, out := &in.RecoveryPoint, &out.RecoveryPoint
+		*out = new(BranchRecoveryPoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastRefreshAt != nil {
+		in, out := &in.LastRefreshAt, &out.LastRefreshAt
+		*out = (*in).DeepCopy()
+	}
+	out.Freshness = in.Freshness
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ClonedStorage != nil {
+		in, out := &in.ClonedStorage, &out.ClonedStorage
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]BranchRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Masking != nil {
+		in, out := &in.Masking, &out.Masking
+		*out = new(BranchMaskingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SchemaDiff != nil {
+		in, out := &in.SchemaDiff, &out.SchemaDiff
+		*out = new(BranchSchemaDiffStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]apiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerConnectionInfo.
-func (in *MSSQLServerConnectionInfo) DeepCopy() *MSSQLServerConnectionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchStatus.
+func (in *BranchStatus) DeepCopy() *BranchStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerConnectionInfo)
+	out := new(BranchStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerMigration) DeepCopyInto(out *MSSQLServerMigration) {
+func (in *BranchTarget) DeepCopyInto(out *BranchTarget) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(v1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchTarget.
+func (in *BranchTarget) DeepCopy() *BranchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchWork) DeepCopyInto(out *BranchWork) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -604,18 +783,18 @@ func (in *MSSQLServerMigration) DeepCopyInto(out *MSSQLServerMigration) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerMigration.
-func (in *MSSQLServerMigration) DeepCopy() *MSSQLServerMigration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWork.
+func (in *BranchWork) DeepCopy() *BranchWork {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerMigration)
+	out := new(BranchWork)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MSSQLServerMigration) DeepCopyObject() runtime.Object {
+func (in *BranchWork) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -623,13 +802,13 @@ func (in *MSSQLServerMigration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerMigrationList) DeepCopyInto(out *MSSQLServerMigrationList) {
+func (in *BranchWorkList) DeepCopyInto(out *BranchWorkList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MSSQLServerMigration, len(*in))
+		*out = make([]BranchWork, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -637,18 +816,18 @@ func (in *MSSQLServerMigrationList) DeepCopyInto(out *MSSQLServerMigrationList)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerMigrationList.
-func (in *MSSQLServerMigrationList) DeepCopy() *MSSQLServerMigrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkList.
+func (in *BranchWorkList) DeepCopy() *BranchWorkList {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerMigrationList)
+	out := new(BranchWorkList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MSSQLServerMigrationList) DeepCopyObject() runtime.Object {
+func (in *BranchWorkList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -656,216 +835,339 @@ func (in *MSSQLServerMigrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerMigrationSpec) DeepCopyInto(out *MSSQLServerMigrationSpec) {
+func (in *BranchWorkManifestCondition) DeepCopyInto(out *BranchWorkManifestCondition) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Target.DeepCopyInto(&out.Target)
-	if in.JobDefaults != nil {
-		in, out := &in.JobDefaults, &out.JobDefaults
-		*out = new(JobDefaults)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.JobTemplate != nil {
-		in, out := &in.JobTemplate, &out.JobTemplate
-		*out = new(offshootapiapiv1.PodTemplateSpec)
-		(*in).DeepCopyInto(*out)
+	out.ResourceMeta = in.ResourceMeta
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]apiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerMigrationSpec.
-func (in *MSSQLServerMigrationSpec) DeepCopy() *MSSQLServerMigrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkManifestCondition.
+func (in *BranchWorkManifestCondition) DeepCopy() *BranchWorkManifestCondition {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerMigrationSpec)
+	out := new(BranchWorkManifestCondition)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerSchema) DeepCopyInto(out *MSSQLServerSchema) {
+func (in *BranchWorkResourceMeta) DeepCopyInto(out *BranchWorkResourceMeta) {
 	*out = *in
-	if in.Database != nil {
-		in, out := &in.Database, &out.Database
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Schema != nil {
-		in, out := &in.Schema, &out.Schema
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkResourceMeta.
+func (in *BranchWorkResourceMeta) DeepCopy() *BranchWorkResourceMeta {
+	if in == nil {
+		return nil
 	}
-	if in.ExcludeSchema != nil {
-		in, out := &in.ExcludeSchema, &out.ExcludeSchema
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(BranchWorkResourceMeta)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchWorkSpec) DeepCopyInto(out *BranchWorkSpec) {
+	*out = *in
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]runtime.RawExtension, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.Table != nil {
-		in, out := &in.Table, &out.Table
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkSpec.
+func (in *BranchWorkSpec) DeepCopy() *BranchWorkSpec {
+	if in == nil {
+		return nil
 	}
-	if in.ExcludeTable != nil {
-		in, out := &in.ExcludeTable, &out.ExcludeTable
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(BranchWorkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchWorkStatus) DeepCopyInto(out *BranchWorkStatus) {
+	*out = *in
+	if in.Branch != nil {
+		in, out := &in.Branch, &out.Branch
+		*out = new(BranchStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Manifests != nil {
+		in, out := &in.Manifests, &out.Manifests
+		*out = make([]BranchWorkManifestCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]apiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSchema.
-func (in *MSSQLServerSchema) DeepCopy() *MSSQLServerSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchWorkStatus.
+func (in *BranchWorkStatus) DeepCopy() *BranchWorkStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerSchema)
+	out := new(BranchWorkStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerSnapshot) DeepCopyInto(out *MSSQLServerSnapshot) {
+func (in *ConnectionInfo) DeepCopyInto(out *ConnectionInfo) {
 	*out = *in
-	if in.Pipeline != nil {
-		in, out := &in.Pipeline, &out.Pipeline
-		*out = new(MSSQLServerSnapshotPipeline)
-		(*in).DeepCopyInto(*out)
+	if in.AppBinding != nil {
+		in, out := &in.AppBinding, &out.AppBinding
+		*out = new(apiv1.ObjectReference)
+		**out = **in
+	}
+	if in.MaxConnections != nil {
+		in, out := &in.MaxConnections, &out.MaxConnections
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSConfig)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSnapshot.
-func (in *MSSQLServerSnapshot) DeepCopy() *MSSQLServerSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConnectionInfo.
+func (in *ConnectionInfo) DeepCopy() *ConnectionInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerSnapshot)
+	out := new(ConnectionInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerSnapshotPipeline) DeepCopyInto(out *MSSQLServerSnapshotPipeline) {
+func (in *CutoverSpec) DeepCopyInto(out *CutoverSpec) {
 	*out = *in
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = new(int)
+	if in.ScheduledAt != nil {
+		in, out := &in.ScheduledAt, &out.ScheduledAt
+		*out = (*in).DeepCopy()
+	}
+	if in.MaxLag != nil {
+		in, out := &in.MaxLag, &out.MaxLag
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.Sinkers != nil {
-		in, out := &in.Sinkers, &out.Sinkers
-		*out = new(int)
+	if in.LagTimeout != nil {
+		in, out := &in.LagTimeout, &out.LagTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.Buffer != nil {
-		in, out := &in.Buffer, &out.Buffer
-		*out = new(int)
+	if in.SetSourceReadOnly != nil {
+		in, out := &in.SetSourceReadOnly, &out.SetSourceReadOnly
+		*out = new(bool)
 		**out = **in
 	}
-	if in.ReadBatchSize != nil {
-		in, out := &in.ReadBatchSize, &out.ReadBatchSize
-		*out = new(int)
+	if in.DrainTimeout != nil {
+		in, out := &in.DrainTimeout, &out.DrainTimeout
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.WriteBatchSize != nil {
-		in, out := &in.WriteBatchSize, &out.WriteBatchSize
-		*out = new(int)
+	if in.AppBinding != nil {
+		in, out := &in.AppBinding, &out.AppBinding
+		*out = new(apiv1.ObjectReference)
+		**out = **in
+	}
+	if in.RollbackOnFailure != nil {
+		in, out := &in.RollbackOnFailure, &out.RollbackOnFailure
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSnapshotPipeline.
-func (in *MSSQLServerSnapshotPipeline) DeepCopy() *MSSQLServerSnapshotPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CutoverSpec.
+func (in *CutoverSpec) DeepCopy() *CutoverSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerSnapshotPipeline)
+	out := new(CutoverSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerSource) DeepCopyInto(out *MSSQLServerSource) {
+func (in *CutoverStatus) DeepCopyInto(out *CutoverStatus) {
 	*out = *in
-	if in.ConnectionInfo != nil {
-		in, out := &in.ConnectionInfo, &out.ConnectionInfo
-		*out = new(MSSQLServerConnectionInfo)
-		(*in).DeepCopyInto(*out)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Schema != nil {
-		in, out := &in.Schema, &out.Schema
-		*out = new(MSSQLServerSchema)
-		(*in).DeepCopyInto(*out)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Snapshot != nil {
-		in, out := &in.Snapshot, &out.Snapshot
-		*out = new(MSSQLServerSnapshot)
-		(*in).DeepCopyInto(*out)
+	if in.SourceReadOnlyTime != nil {
+		in, out := &in.SourceReadOnlyTime, &out.SourceReadOnlyTime
+		*out = (*in).DeepCopy()
 	}
-	if in.Streaming != nil {
-		in, out := &in.Streaming, &out.Streaming
-		*out = new(MSSQLServerStreaming)
+	if in.PreviousAppBindingSpec != nil {
+		in, out := &in.PreviousAppBindingSpec, &out.PreviousAppBindingSpec
+		*out = new(v1alpha1.ClientConfig)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSource.
-func (in *MSSQLServerSource) DeepCopy() *MSSQLServerSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CutoverStatus.
+func (in *CutoverStatus) DeepCopy() *CutoverStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerSource)
+	out := new(CutoverStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerStreaming) DeepCopyInto(out *MSSQLServerStreaming) {
+func (in *DBCourierCLI) DeepCopyInto(out *DBCourierCLI) {
 	*out = *in
-	if in.BatchSize != nil {
-		in, out := &in.BatchSize, &out.BatchSize
-		*out = new(int)
-		**out = **in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCourierCLI.
+func (in *DBCourierCLI) DeepCopy() *DBCourierCLI {
+	if in == nil {
+		return nil
 	}
+	out := new(DBCourierCLI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DBCourierImages) DeepCopyInto(out *DBCourierImages) {
+	*out = *in
+	out.CLI = in.CLI
+	out.StatusReporter = in.StatusReporter
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerStreaming.
-func (in *MSSQLServerStreaming) DeepCopy() *MSSQLServerStreaming {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCourierImages.
+func (in *DBCourierImages) DeepCopy() *DBCourierImages {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerStreaming)
+	out := new(DBCourierImages)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MSSQLServerTarget) DeepCopyInto(out *MSSQLServerTarget) {
+func (in *DBCourierStatusReporter) DeepCopyInto(out *DBCourierStatusReporter) {
 	*out = *in
-	if in.ConnectionInfo != nil {
-		in, out := &in.ConnectionInfo, &out.ConnectionInfo
-		*out = new(MSSQLServerConnectionInfo)
-		(*in).DeepCopyInto(*out)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DBCourierStatusReporter.
+func (in *DBCourierStatusReporter) DeepCopy() *DBCourierStatusReporter {
+	if in == nil {
+		return nil
+	}
+	out := new(DBCourierStatusReporter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchAliasSwap) DeepCopyInto(out *ElasticsearchAliasSwap) {
+	*out = *in
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerTarget.
-func (in *MSSQLServerTarget) DeepCopy() *MSSQLServerTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchAliasSwap.
+func (in *ElasticsearchAliasSwap) DeepCopy() *ElasticsearchAliasSwap {
 	if in == nil {
 		return nil
 	}
-	out := new(MSSQLServerTarget)
+	out := new(ElasticsearchAliasSwap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MariaDBMigration) DeepCopyInto(out *MariaDBMigration) {
+func (in *ElasticsearchAliasSwapSpec) DeepCopyInto(out *ElasticsearchAliasSwapSpec) {
+	*out = *in
+	if in.Aliases != nil {
+		in, out := &in.Aliases, &out.Aliases
+		*out = make([]ElasticsearchAliasSwap, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchAliasSwapSpec.
+func (in *ElasticsearchAliasSwapSpec) DeepCopy() *ElasticsearchAliasSwapSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchAliasSwapSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchIndexFilter) DeepCopyInto(out *ElasticsearchIndexFilter) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchIndexFilter.
+func (in *ElasticsearchIndexFilter) DeepCopy() *ElasticsearchIndexFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchIndexFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchMigration) DeepCopyInto(out *ElasticsearchMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -874,18 +1176,18 @@ func (in *MariaDBMigration) DeepCopyInto(out *MariaDBMigration) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBMigration.
-func (in *MariaDBMigration) DeepCopy() *MariaDBMigration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchMigration.
+func (in *ElasticsearchMigration) DeepCopy() *ElasticsearchMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(MariaDBMigration)
+	out := new(ElasticsearchMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MariaDBMigration) DeepCopyObject() runtime.Object {
+func (in *ElasticsearchMigration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -893,13 +1195,13 @@ func (in *MariaDBMigration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MariaDBMigrationList) DeepCopyInto(out *MariaDBMigrationList) {
+func (in *ElasticsearchMigrationList) DeepCopyInto(out *ElasticsearchMigrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MariaDBMigration, len(*in))
+		*out = make([]ElasticsearchMigration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -907,18 +1209,18 @@ func (in *MariaDBMigrationList) DeepCopyInto(out *MariaDBMigrationList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBMigrationList.
-func (in *MariaDBMigrationList) DeepCopy() *MariaDBMigrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchMigrationList.
+func (in *ElasticsearchMigrationList) DeepCopy() *ElasticsearchMigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(MariaDBMigrationList)
+	out := new(ElasticsearchMigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MariaDBMigrationList) DeepCopyObject() runtime.Object {
+func (in *ElasticsearchMigrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -926,7 +1228,7 @@ func (in *MariaDBMigrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MariaDBMigrationSpec) DeepCopyInto(out *MariaDBMigrationSpec) {
+func (in *ElasticsearchMigrationSpec) DeepCopyInto(out *ElasticsearchMigrationSpec) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	in.Target.DeepCopyInto(&out.Target)
@@ -940,221 +1242,238 @@ func (in *MariaDBMigrationSpec) DeepCopyInto(out *MariaDBMigrationSpec) {
 		*out = new(offshootapiapiv1.PodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBMigrationSpec.
-func (in *MariaDBMigrationSpec) DeepCopy() *MariaDBMigrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchMigrationSpec.
+func (in *ElasticsearchMigrationSpec) DeepCopy() *ElasticsearchMigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MariaDBMigrationSpec)
+	out := new(ElasticsearchMigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MariaDBSource) DeepCopyInto(out *MariaDBSource) {
+func (in *ElasticsearchReindex) DeepCopyInto(out *ElasticsearchReindex) {
 	*out = *in
-	if in.ConnectionInfo != nil {
-		in, out := &in.ConnectionInfo, &out.ConnectionInfo
-		*out = new(ConnectionInfo)
-		(*in).DeepCopyInto(*out)
+	if in.Slices != nil {
+		in, out := &in.Slices, &out.Slices
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Schema != nil {
-		in, out := &in.Schema, &out.Schema
-		*out = new(MySQLSchema)
-		(*in).DeepCopyInto(*out)
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Snapshot != nil {
-		in, out := &in.Snapshot, &out.Snapshot
-		*out = new(MySQLSnapshot)
-		(*in).DeepCopyInto(*out)
+	if in.RequestsPerSecond != nil {
+		in, out := &in.RequestsPerSecond, &out.RequestsPerSecond
+		*out = new(int32)
+		**out = **in
 	}
-	if in.Streaming != nil {
-		in, out := &in.Streaming, &out.Streaming
-		*out = new(MySQLStreaming)
+	if in.CopySettings != nil {
+		in, out := &in.CopySettings, &out.CopySettings
+		*out = new(bool)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBSource.
-func (in *MariaDBSource) DeepCopy() *MariaDBSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchReindex.
+func (in *ElasticsearchReindex) DeepCopy() *ElasticsearchReindex {
 	if in == nil {
 		return nil
 	}
-	out := new(MariaDBSource)
+	out := new(ElasticsearchReindex)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MariaDBTarget) DeepCopyInto(out *MariaDBTarget) {
+func (in *ElasticsearchSnapshotSource) DeepCopyInto(out *ElasticsearchSnapshotSource) {
 	*out = *in
-	if in.ConnectionInfo != nil {
-		in, out := &in.ConnectionInfo, &out.ConnectionInfo
-		*out = new(ConnectionInfo)
-		(*in).DeepCopyInto(*out)
+	if in.RepositorySettings != nil {
+		in, out := &in.RepositorySettings, &out.RepositorySettings
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBTarget.
-func (in *MariaDBTarget) DeepCopy() *MariaDBTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchSnapshotSource.
+func (in *ElasticsearchSnapshotSource) DeepCopy() *ElasticsearchSnapshotSource {
 	if in == nil {
 		return nil
 	}
-	out := new(MariaDBTarget)
+	out := new(ElasticsearchSnapshotSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Migration) DeepCopyInto(out *Migration) {
+func (in *ElasticsearchSource) DeepCopyInto(out *ElasticsearchSource) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(ElasticsearchSnapshotSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Reindex != nil {
+		in, out := &in.Reindex, &out.Reindex
+		*out = new(ElasticsearchReindex)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = new(ElasticsearchIndexFilter)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Migration.
-func (in *Migration) DeepCopy() *Migration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchSource.
+func (in *ElasticsearchSource) DeepCopy() *ElasticsearchSource {
 	if in == nil {
 		return nil
 	}
-	out := new(Migration)
+	out := new(ElasticsearchSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Migration) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTarget) DeepCopyInto(out *ElasticsearchTarget) {
+	*out = *in
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.AliasSwap != nil {
+		in, out := &in.AliasSwap, &out.AliasSwap
+		*out = new(ElasticsearchAliasSwapSpec)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTarget.
+func (in *ElasticsearchTarget) DeepCopy() *ElasticsearchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTarget)
+	in.DeepCopyInto(out)
+	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MigrationConfig) DeepCopyInto(out *MigrationConfig) {
+func (in *IndexProgress) DeepCopyInto(out *IndexProgress) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Target.DeepCopyInto(&out.Target)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationConfig.
-func (in *MigrationConfig) DeepCopy() *MigrationConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IndexProgress.
+func (in *IndexProgress) DeepCopy() *IndexProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(MigrationConfig)
+	out := new(IndexProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MigrationList) DeepCopyInto(out *MigrationList) {
+func (in *JobDefaults) DeepCopyInto(out *JobDefaults) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Migration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.BackoffLimit != nil {
+		in, out := &in.BackoffLimit, &out.BackoffLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationList.
-func (in *MigrationList) DeepCopy() *MigrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobDefaults.
+func (in *JobDefaults) DeepCopy() *JobDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(MigrationList)
+	out := new(JobDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MigrationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MigrationSpec) DeepCopyInto(out *MigrationSpec) {
+func (in *LogicalReplication) DeepCopyInto(out *LogicalReplication) {
 	*out = *in
-	if in.Source != nil {
-		in, out := &in.Source, &out.Source
-		*out = new(Source)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = new(Target)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.JobDefaults != nil {
-		in, out := &in.JobDefaults, &out.JobDefaults
-		*out = new(JobDefaults)
+	if in.Publication != nil {
+		in, out := &in.Publication, &out.Publication
+		*out = new(Publication)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.JobTemplate != nil {
-		in, out := &in.JobTemplate, &out.JobTemplate
-		*out = new(offshootapiapiv1.PodTemplateSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(Subscription)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSpec.
-func (in *MigrationSpec) DeepCopy() *MigrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogicalReplication.
+func (in *LogicalReplication) DeepCopy() *LogicalReplication {
 	if in == nil {
 		return nil
 	}
-	out := new(MigrationSpec)
+	out := new(LogicalReplication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MigrationStatus) DeepCopyInto(out *MigrationStatus) {
+func (in *MSSQLServerConnectionInfo) DeepCopyInto(out *MSSQLServerConnectionInfo) {
 	*out = *in
-	if in.Progress != nil {
-		in, out := &in.Progress, &out.Progress
-		*out = new(Progress)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = make([]apiv1.Condition, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.AppBinding != nil {
+		in, out := &in.AppBinding, &out.AppBinding
+		*out = new(apiv1.ObjectReference)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationStatus.
-func (in *MigrationStatus) DeepCopy() *MigrationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerConnectionInfo.
+func (in *MSSQLServerConnectionInfo) DeepCopy() *MSSQLServerConnectionInfo {
 	if in == nil {
 		return nil
 	}
-	out := new(MigrationStatus)
+	out := new(MSSQLServerConnectionInfo)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBMigration) DeepCopyInto(out *MongoDBMigration) {
+func (in *MSSQLServerMigration) DeepCopyInto(out *MSSQLServerMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1163,18 +1482,18 @@ func (in *MongoDBMigration) DeepCopyInto(out *MongoDBMigration) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBMigration.
-func (in *MongoDBMigration) DeepCopy() *MongoDBMigration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerMigration.
+func (in *MSSQLServerMigration) DeepCopy() *MSSQLServerMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBMigration)
+	out := new(MSSQLServerMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MongoDBMigration) DeepCopyObject() runtime.Object {
+func (in *MSSQLServerMigration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1182,13 +1501,13 @@ func (in *MongoDBMigration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBMigrationList) DeepCopyInto(out *MongoDBMigrationList) {
+func (in *MSSQLServerMigrationList) DeepCopyInto(out *MSSQLServerMigrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MongoDBMigration, len(*in))
+		*out = make([]MSSQLServerMigration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1196,18 +1515,18 @@ func (in *MongoDBMigrationList) DeepCopyInto(out *MongoDBMigrationList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBMigrationList.
-func (in *MongoDBMigrationList) DeepCopy() *MongoDBMigrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerMigrationList.
+func (in *MSSQLServerMigrationList) DeepCopy() *MSSQLServerMigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBMigrationList)
+	out := new(MSSQLServerMigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MongoDBMigrationList) DeepCopyObject() runtime.Object {
+func (in *MSSQLServerMigrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1215,7 +1534,7 @@ func (in *MongoDBMigrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBMigrationSpec) DeepCopyInto(out *MongoDBMigrationSpec) {
+func (in *MSSQLServerMigrationSpec) DeepCopyInto(out *MSSQLServerMigrationSpec) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	in.Target.DeepCopyInto(&out.Target)
@@ -1229,139 +1548,217 @@ func (in *MongoDBMigrationSpec) DeepCopyInto(out *MongoDBMigrationSpec) {
 		*out = new(offshootapiapiv1.PodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBMigrationSpec.
-func (in *MongoDBMigrationSpec) DeepCopy() *MongoDBMigrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerMigrationSpec.
+func (in *MSSQLServerMigrationSpec) DeepCopy() *MSSQLServerMigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBMigrationSpec)
+	out := new(MSSQLServerMigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBSource) DeepCopyInto(out *MongoDBSource) {
+func (in *MSSQLServerSchema) DeepCopyInto(out *MSSQLServerSchema) {
 	*out = *in
-	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
-	if in.Mongoshake != nil {
-		in, out := &in.Mongoshake, &out.Mongoshake
-		*out = new(Mongoshake)
-		(*in).DeepCopyInto(*out)
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSchema != nil {
+		in, out := &in.ExcludeSchema, &out.ExcludeSchema
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Table != nil {
+		in, out := &in.Table, &out.Table
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeTable != nil {
+		in, out := &in.ExcludeTable, &out.ExcludeTable
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBSource.
-func (in *MongoDBSource) DeepCopy() *MongoDBSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSchema.
+func (in *MSSQLServerSchema) DeepCopy() *MSSQLServerSchema {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBSource)
+	out := new(MSSQLServerSchema)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MongoDBTarget) DeepCopyInto(out *MongoDBTarget) {
+func (in *MSSQLServerSnapshot) DeepCopyInto(out *MSSQLServerSnapshot) {
 	*out = *in
-	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(MSSQLServerSnapshotPipeline)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBTarget.
-func (in *MongoDBTarget) DeepCopy() *MongoDBTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSnapshot.
+func (in *MSSQLServerSnapshot) DeepCopy() *MSSQLServerSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(MongoDBTarget)
+	out := new(MSSQLServerSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Mongoshake) DeepCopyInto(out *Mongoshake) {
+func (in *MSSQLServerSnapshotPipeline) DeepCopyInto(out *MSSQLServerSnapshotPipeline) {
 	*out = *in
-	if in.FilterOpTypes != nil {
-		in, out := &in.FilterOpTypes, &out.FilterOpTypes
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.FilterNamespaceBlack != nil {
-		in, out := &in.FilterNamespaceBlack, &out.FilterNamespaceBlack
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int)
+		**out = **in
 	}
-	if in.FilterNamespaceWhite != nil {
-		in, out := &in.FilterNamespaceWhite, &out.FilterNamespaceWhite
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Sinkers != nil {
+		in, out := &in.Sinkers, &out.Sinkers
+		*out = new(int)
+		**out = **in
 	}
-	if in.FilterPassSpecialDb != nil {
-		in, out := &in.FilterPassSpecialDb, &out.FilterPassSpecialDb
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(int)
+		**out = **in
 	}
-	if in.FilterDDLEnable != nil {
-		in, out := &in.FilterDDLEnable, &out.FilterDDLEnable
-		*out = new(bool)
+	if in.ReadBatchSize != nil {
+		in, out := &in.ReadBatchSize, &out.ReadBatchSize
+		*out = new(int)
 		**out = **in
 	}
-	if in.FilterOplogGids != nil {
-		in, out := &in.FilterOplogGids, &out.FilterOplogGids
-		*out = new(bool)
+	if in.WriteBatchSize != nil {
+		in, out := &in.WriteBatchSize, &out.WriteBatchSize
+		*out = new(int)
 		**out = **in
 	}
-	if in.TransformNamespace != nil {
-		in, out := &in.TransformNamespace, &out.TransformNamespace
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSnapshotPipeline.
+func (in *MSSQLServerSnapshotPipeline) DeepCopy() *MSSQLServerSnapshotPipeline {
+	if in == nil {
+		return nil
 	}
-	if in.ExtraConfiguration != nil {
-		in, out := &in.ExtraConfiguration, &out.ExtraConfiguration
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out := new(MSSQLServerSnapshotPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MSSQLServerSource) DeepCopyInto(out *MSSQLServerSource) {
+	*out = *in
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(MSSQLServerConnectionInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(MSSQLServerSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(MSSQLServerSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(MSSQLServerStreaming)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mongoshake.
-func (in *Mongoshake) DeepCopy() *Mongoshake {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerSource.
+func (in *MSSQLServerSource) DeepCopy() *MSSQLServerSource {
 	if in == nil {
 		return nil
 	}
-	out := new(Mongoshake)
+	out := new(MSSQLServerSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLConnectionInfo) DeepCopyInto(out *MySQLConnectionInfo) {
+func (in *MSSQLServerStreaming) DeepCopyInto(out *MSSQLServerStreaming) {
 	*out = *in
-	if in.AppBinding != nil {
-		in, out := &in.AppBinding, &out.AppBinding
-		*out = new(apiv1.ObjectReference)
+	if in.BatchSize != nil {
+		in, out := &in.BatchSize, &out.BatchSize
+		*out = new(int)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLConnectionInfo.
-func (in *MySQLConnectionInfo) DeepCopy() *MySQLConnectionInfo {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerStreaming.
+func (in *MSSQLServerStreaming) DeepCopy() *MSSQLServerStreaming {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLConnectionInfo)
+	out := new(MSSQLServerStreaming)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLMigration) DeepCopyInto(out *MySQLMigration) {
+func (in *MSSQLServerTarget) DeepCopyInto(out *MSSQLServerTarget) {
+	*out = *in
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(MSSQLServerConnectionInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MSSQLServerTarget.
+func (in *MSSQLServerTarget) DeepCopy() *MSSQLServerTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MSSQLServerTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MariaDBMigration) DeepCopyInto(out *MariaDBMigration) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -1370,18 +1767,18 @@ func (in *MySQLMigration) DeepCopyInto(out *MySQLMigration) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLMigration.
-func (in *MySQLMigration) DeepCopy() *MySQLMigration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBMigration.
+func (in *MariaDBMigration) DeepCopy() *MariaDBMigration {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLMigration)
+	out := new(MariaDBMigration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLMigration) DeepCopyObject() runtime.Object {
+func (in *MariaDBMigration) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1389,13 +1786,13 @@ func (in *MySQLMigration) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLMigrationList) DeepCopyInto(out *MySQLMigrationList) {
+func (in *MariaDBMigrationList) DeepCopyInto(out *MariaDBMigrationList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MySQLMigration, len(*in))
+		*out = make([]MariaDBMigration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1403,18 +1800,18 @@ func (in *MySQLMigrationList) DeepCopyInto(out *MySQLMigrationList) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLMigrationList.
-func (in *MySQLMigrationList) DeepCopy() *MySQLMigrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBMigrationList.
+func (in *MariaDBMigrationList) DeepCopy() *MariaDBMigrationList {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLMigrationList)
+	out := new(MariaDBMigrationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MySQLMigrationList) DeepCopyObject() runtime.Object {
+func (in *MariaDBMigrationList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -1422,7 +1819,7 @@ func (in *MySQLMigrationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLMigrationSpec) DeepCopyInto(out *MySQLMigrationSpec) {
+func (in *MariaDBMigrationSpec) DeepCopyInto(out *MariaDBMigrationSpec) {
 	*out = *in
 	in.Source.DeepCopyInto(&out.Source)
 	in.Target.DeepCopyInto(&out.Target)
@@ -1436,394 +1833,1567 @@ func (in *MySQLMigrationSpec) DeepCopyInto(out *MySQLMigrationSpec) {
 		*out = new(offshootapiapiv1.PodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLMigrationSpec.
-func (in *MySQLMigrationSpec) DeepCopy() *MySQLMigrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBMigrationSpec.
+func (in *MariaDBMigrationSpec) DeepCopy() *MariaDBMigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLMigrationSpec)
+	out := new(MariaDBMigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSchema) DeepCopyInto(out *MySQLSchema) {
+func (in *MariaDBSource) DeepCopyInto(out *MariaDBSource) {
 	*out = *in
-	if in.Database != nil {
-		in, out := &in.Database, &out.Database
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(ConnectionInfo)
+		(*in).DeepCopyInto(*out)
 	}
-	if in.ExcludeDatabase != nil {
-		in, out := &in.ExcludeDatabase, &out.ExcludeDatabase
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(MySQLSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(MySQLSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(MySQLStreaming)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSchema.
-func (in *MySQLSchema) DeepCopy() *MySQLSchema {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBSource.
+func (in *MariaDBSource) DeepCopy() *MariaDBSource {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSchema)
+	out := new(MariaDBSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSnapshot) DeepCopyInto(out *MySQLSnapshot) {
+func (in *MariaDBTarget) DeepCopyInto(out *MariaDBTarget) {
 	*out = *in
-	if in.Pipeline != nil {
-		in, out := &in.Pipeline, &out.Pipeline
-		*out = new(MySQLSnapshotPipeline)
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(ConnectionInfo)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSnapshot.
-func (in *MySQLSnapshot) DeepCopy() *MySQLSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MariaDBTarget.
+func (in *MariaDBTarget) DeepCopy() *MariaDBTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSnapshot)
+	out := new(MariaDBTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSnapshotPipeline) DeepCopyInto(out *MySQLSnapshotPipeline) {
+func (in *Migration) DeepCopyInto(out *Migration) {
 	*out = *in
-	if in.Workers != nil {
-		in, out := &in.Workers, &out.Workers
-		*out = new(int)
-		**out = **in
-	}
-	if in.Sinkers != nil {
-		in, out := &in.Sinkers, &out.Sinkers
-		*out = new(int)
-		**out = **in
-	}
-	if in.Buffer != nil {
-		in, out := &in.Buffer, &out.Buffer
-		*out = new(int)
-		**out = **in
-	}
-	if in.ReadBatchSize != nil {
-		in, out := &in.ReadBatchSize, &out.ReadBatchSize
-		*out = new(int)
-		**out = **in
-	}
-	if in.WriteBatchSize != nil {
-		in, out := &in.WriteBatchSize, &out.WriteBatchSize
-		*out = new(int)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSnapshotPipeline.
-func (in *MySQLSnapshotPipeline) DeepCopy() *MySQLSnapshotPipeline {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Migration.
+func (in *Migration) DeepCopy() *Migration {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSnapshotPipeline)
+	out := new(Migration)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLSource) DeepCopyInto(out *MySQLSource) {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Migration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationCheckpoint) DeepCopyInto(out *MigrationCheckpoint) {
+	*out = *in
+	if in.SaveTime != nil {
+		in, out := &in.SaveTime, &out.SaveTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationCheckpoint.
+func (in *MigrationCheckpoint) DeepCopy() *MigrationCheckpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationCheckpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationConfig) DeepCopyInto(out *MigrationConfig) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationConfig.
+func (in *MigrationConfig) DeepCopy() *MigrationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationList) DeepCopyInto(out *MigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Migration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationList.
+func (in *MigrationList) DeepCopy() *MigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationSpec) DeepCopyInto(out *MigrationSpec) {
+	*out = *in
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(Source)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(Target)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(JobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(offshootapiapiv1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationSpec.
+func (in *MigrationSpec) DeepCopy() *MigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MigrationStatus) DeepCopyInto(out *MigrationStatus) {
+	*out = *in
+	if in.Progress != nil {
+		in, out := &in.Progress, &out.Progress
+		*out = new(Progress)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Checkpoint != nil {
+		in, out := &in.Checkpoint, &out.Checkpoint
+		*out = new(MigrationCheckpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]apiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MigrationStatus.
+func (in *MigrationStatus) DeepCopy() *MigrationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MigrationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBMigration) DeepCopyInto(out *MongoDBMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBMigration.
+func (in *MongoDBMigration) DeepCopy() *MongoDBMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBMigrationList) DeepCopyInto(out *MongoDBMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MongoDBMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBMigrationList.
+func (in *MongoDBMigrationList) DeepCopy() *MongoDBMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBMigrationSpec) DeepCopyInto(out *MongoDBMigrationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(JobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(offshootapiapiv1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBMigrationSpec.
+func (in *MongoDBMigrationSpec) DeepCopy() *MongoDBMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBSource) DeepCopyInto(out *MongoDBSource) {
+	*out = *in
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.Mongoshake != nil {
+		in, out := &in.Mongoshake, &out.Mongoshake
+		*out = new(Mongoshake)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBSource.
+func (in *MongoDBSource) DeepCopy() *MongoDBSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBTarget) DeepCopyInto(out *MongoDBTarget) {
+	*out = *in
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBTarget.
+func (in *MongoDBTarget) DeepCopy() *MongoDBTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Mongoshake) DeepCopyInto(out *Mongoshake) {
+	*out = *in
+	if in.FilterOpTypes != nil {
+		in, out := &in.FilterOpTypes, &out.FilterOpTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterNamespaceBlack != nil {
+		in, out := &in.FilterNamespaceBlack, &out.FilterNamespaceBlack
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterNamespaceWhite != nil {
+		in, out := &in.FilterNamespaceWhite, &out.FilterNamespaceWhite
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterPassSpecialDb != nil {
+		in, out := &in.FilterPassSpecialDb, &out.FilterPassSpecialDb
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FilterDDLEnable != nil {
+		in, out := &in.FilterDDLEnable, &out.FilterDDLEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FilterOplogGids != nil {
+		in, out := &in.FilterOplogGids, &out.FilterOplogGids
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TransformNamespace != nil {
+		in, out := &in.TransformNamespace, &out.TransformNamespace
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraConfiguration != nil {
+		in, out := &in.ExtraConfiguration, &out.ExtraConfiguration
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Mongoshake.
+func (in *Mongoshake) DeepCopy() *Mongoshake {
+	if in == nil {
+		return nil
+	}
+	out := new(Mongoshake)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLConnectionInfo) DeepCopyInto(out *MySQLConnectionInfo) {
+	*out = *in
+	if in.AppBinding != nil {
+		in, out := &in.AppBinding, &out.AppBinding
+		*out = new(apiv1.ObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLConnectionInfo.
+func (in *MySQLConnectionInfo) DeepCopy() *MySQLConnectionInfo {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLConnectionInfo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLMigration) DeepCopyInto(out *MySQLMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLMigration.
+func (in *MySQLMigration) DeepCopy() *MySQLMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLMigrationList) DeepCopyInto(out *MySQLMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLMigrationList.
+func (in *MySQLMigrationList) DeepCopy() *MySQLMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLMigrationSpec) DeepCopyInto(out *MySQLMigrationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(JobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(offshootapiapiv1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLMigrationSpec.
+func (in *MySQLMigrationSpec) DeepCopy() *MySQLMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSchema) DeepCopyInto(out *MySQLSchema) {
+	*out = *in
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeDatabase != nil {
+		in, out := &in.ExcludeDatabase, &out.ExcludeDatabase
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSchema.
+func (in *MySQLSchema) DeepCopy() *MySQLSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSnapshot) DeepCopyInto(out *MySQLSnapshot) {
+	*out = *in
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(MySQLSnapshotPipeline)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSnapshot.
+func (in *MySQLSnapshot) DeepCopy() *MySQLSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSnapshotPipeline) DeepCopyInto(out *MySQLSnapshotPipeline) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int)
+		**out = **in
+	}
+	if in.Sinkers != nil {
+		in, out := &in.Sinkers, &out.Sinkers
+		*out = new(int)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(int)
+		**out = **in
+	}
+	if in.ReadBatchSize != nil {
+		in, out := &in.ReadBatchSize, &out.ReadBatchSize
+		*out = new(int)
+		**out = **in
+	}
+	if in.WriteBatchSize != nil {
+		in, out := &in.WriteBatchSize, &out.WriteBatchSize
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSnapshotPipeline.
+func (in *MySQLSnapshotPipeline) DeepCopy() *MySQLSnapshotPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSnapshotPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLSource) DeepCopyInto(out *MySQLSource) {
+	*out = *in
+	if in.ConnectionInfo != nil {
+		in, out := &in.ConnectionInfo, &out.ConnectionInfo
+		*out = new(ConnectionInfo)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(MySQLSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(MySQLSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(MySQLStreaming)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSource.
+func (in *MySQLSource) DeepCopy() *MySQLSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLStreaming) DeepCopyInto(out *MySQLStreaming) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLStreaming.
+func (in *MySQLStreaming) DeepCopy() *MySQLStreaming {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLStreaming)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLTarget) DeepCopyInto(out *MySQLTarget) {
 	*out = *in
 	if in.ConnectionInfo != nil {
 		in, out := &in.ConnectionInfo, &out.ConnectionInfo
 		*out = new(ConnectionInfo)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Schema != nil {
-		in, out := &in.Schema, &out.Schema
-		*out = new(MySQLSchema)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLTarget.
+func (in *MySQLTarget) DeepCopy() *MySQLTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLToPostgresMigration) DeepCopyInto(out *MySQLToPostgresMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLToPostgresMigration.
+func (in *MySQLToPostgresMigration) DeepCopy() *MySQLToPostgresMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLToPostgresMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLToPostgresMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLToPostgresMigrationList) DeepCopyInto(out *MySQLToPostgresMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MySQLToPostgresMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLToPostgresMigrationList.
+func (in *MySQLToPostgresMigrationList) DeepCopy() *MySQLToPostgresMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLToPostgresMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MySQLToPostgresMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLToPostgresMigrationSpec) DeepCopyInto(out *MySQLToPostgresMigrationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(JobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(offshootapiapiv1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLToPostgresMigrationSpec.
+func (in *MySQLToPostgresMigrationSpec) DeepCopy() *MySQLToPostgresMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLToPostgresMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MySQLToPostgresSource) DeepCopyInto(out *MySQLToPostgresSource) {
+	*out = *in
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = new(MySQLSchema)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(MySQLSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(MySQLStreaming)
+		**out = **in
+	}
+	if in.Translation != nil {
+		in, out := &in.Translation, &out.Translation
+		*out = new(SchemaTranslation)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLToPostgresSource.
+func (in *MySQLToPostgresSource) DeepCopy() *MySQLToPostgresSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MySQLToPostgresSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PgDump) DeepCopyInto(out *PgDump) {
+	*out = *in
+	if in.Schema != nil {
+		in, out := &in.Schema, &out.Schema
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeSchema != nil {
+		in, out := &in.ExcludeSchema, &out.ExcludeSchema
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Table != nil {
+		in, out := &in.Table, &out.Table
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeTable != nil {
+		in, out := &in.ExcludeTable, &out.ExcludeTable
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExtraOptions != nil {
+		in, out := &in.ExtraOptions, &out.ExtraOptions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PgDump.
+func (in *PgDump) DeepCopy() *PgDump {
+	if in == nil {
+		return nil
+	}
+	out := new(PgDump)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresMigration) DeepCopyInto(out *PostgresMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresMigration.
+func (in *PostgresMigration) DeepCopy() *PostgresMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresMigrationList) DeepCopyInto(out *PostgresMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PostgresMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresMigrationList.
+func (in *PostgresMigrationList) DeepCopy() *PostgresMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PostgresMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PostgresMigrationSpec) DeepCopyInto(out *PostgresMigrationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(JobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(offshootapiapiv1.PodTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Snapshot != nil {
-		in, out := &in.Snapshot, &out.Snapshot
-		*out = new(MySQLSnapshot)
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Streaming != nil {
-		in, out := &in.Streaming, &out.Streaming
-		*out = new(MySQLStreaming)
-		**out = **in
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Verification != nil {
+		in, out := &in.Verification, &out.Verification
+		*out = new(VerificationSpec)
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLSource.
-func (in *MySQLSource) DeepCopy() *MySQLSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresMigrationSpec.
+func (in *PostgresMigrationSpec) DeepCopy() *PostgresMigrationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLSource)
+	out := new(PostgresMigrationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLStreaming) DeepCopyInto(out *MySQLStreaming) {
+func (in *PostgresSource) DeepCopyInto(out *PostgresSource) {
 	*out = *in
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.PgDump != nil {
+		in, out := &in.PgDump, &out.PgDump
+		*out = new(PgDump)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LogicalReplication != nil {
+		in, out := &in.LogicalReplication, &out.LogicalReplication
+		*out = new(LogicalReplication)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLStreaming.
-func (in *MySQLStreaming) DeepCopy() *MySQLStreaming {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresSource.
+func (in *PostgresSource) DeepCopy() *PostgresSource {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLStreaming)
+	out := new(PostgresSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MySQLTarget) DeepCopyInto(out *MySQLTarget) {
+func (in *PostgresTarget) DeepCopyInto(out *PostgresTarget) {
 	*out = *in
-	if in.ConnectionInfo != nil {
-		in, out := &in.ConnectionInfo, &out.ConnectionInfo
-		*out = new(ConnectionInfo)
-		(*in).DeepCopyInto(*out)
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresTarget.
+func (in *PostgresTarget) DeepCopy() *PostgresTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(PostgresTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreflightSpec) DeepCopyInto(out *PreflightSpec) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SkipChecks != nil {
+		in, out := &in.SkipChecks, &out.SkipChecks
+		*out = make([]PreflightCheck, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskHeadroomPercent != nil {
+		in, out := &in.DiskHeadroomPercent, &out.DiskHeadroomPercent
+		*out = new(int32)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MySQLTarget.
-func (in *MySQLTarget) DeepCopy() *MySQLTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreflightSpec.
+func (in *PreflightSpec) DeepCopy() *PreflightSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MySQLTarget)
+	out := new(PreflightSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PgDump) DeepCopyInto(out *PgDump) {
+func (in *Progress) DeepCopyInto(out *Progress) {
 	*out = *in
-	if in.Schema != nil {
-		in, out := &in.Schema, &out.Schema
+	if in.Info != nil {
+		in, out := &in.Info, &out.Info
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SnapshotPercent != nil {
+		in, out := &in.SnapshotPercent, &out.SnapshotPercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(ReplicationLag)
+		**out = **in
+	}
+	if in.EstimatedTimeRemaining != nil {
+		in, out := &in.EstimatedTimeRemaining, &out.EstimatedTimeRemaining
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]TableProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Indices != nil {
+		in, out := &in.Indices, &out.Indices
+		*out = make([]IndexProgress, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Progress.
+func (in *Progress) DeepCopy() *Progress {
+	if in == nil {
+		return nil
+	}
+	out := new(Progress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Publication) DeepCopyInto(out *Publication) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ExcludeSchema != nil {
-		in, out := &in.ExcludeSchema, &out.ExcludeSchema
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Publication.
+func (in *Publication) DeepCopy() *Publication {
+	if in == nil {
+		return nil
+	}
+	out := new(Publication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisClusterSource) DeepCopyInto(out *RedisClusterSource) {
+	*out = *in
+	if in.SeedAddresses != nil {
+		in, out := &in.SeedAddresses, &out.SeedAddresses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Table != nil {
-		in, out := &in.Table, &out.Table
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisClusterSource.
+func (in *RedisClusterSource) DeepCopy() *RedisClusterSource {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisClusterSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisDBMapping) DeepCopyInto(out *RedisDBMapping) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisDBMapping.
+func (in *RedisDBMapping) DeepCopy() *RedisDBMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisDBMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisKeyFilter) DeepCopyInto(out *RedisKeyFilter) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ExcludeTable != nil {
-		in, out := &in.ExcludeTable, &out.ExcludeTable
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.ExtraOptions != nil {
-		in, out := &in.ExtraOptions, &out.ExtraOptions
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisKeyFilter.
+func (in *RedisKeyFilter) DeepCopy() *RedisKeyFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisKeyFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisMigration) DeepCopyInto(out *RedisMigration) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisMigration.
+func (in *RedisMigration) DeepCopy() *RedisMigration {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisMigration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisMigration) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisMigrationList) DeepCopyInto(out *RedisMigrationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RedisMigration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisMigrationList.
+func (in *RedisMigrationList) DeepCopy() *RedisMigrationList {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisMigrationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RedisMigrationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisMigrationSpec) DeepCopyInto(out *RedisMigrationSpec) {
+	*out = *in
+	in.Source.DeepCopyInto(&out.Source)
+	in.Target.DeepCopyInto(&out.Target)
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(JobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobTemplate != nil {
+		in, out := &in.JobTemplate, &out.JobTemplate
+		*out = new(offshootapiapiv1.PodTemplateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cutover != nil {
+		in, out := &in.Cutover, &out.Cutover
+		*out = new(CutoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Preflight != nil {
+		in, out := &in.Preflight, &out.Preflight
+		*out = new(PreflightSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisMigrationSpec.
+func (in *RedisMigrationSpec) DeepCopy() *RedisMigrationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisMigrationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisSentinelSource) DeepCopyInto(out *RedisSentinelSource) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.AppBinding != nil {
+		in, out := &in.AppBinding, &out.AppBinding
+		*out = new(apiv1.ObjectReference)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PgDump.
-func (in *PgDump) DeepCopy() *PgDump {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSentinelSource.
+func (in *RedisSentinelSource) DeepCopy() *RedisSentinelSource {
 	if in == nil {
 		return nil
 	}
-	out := new(PgDump)
+	out := new(RedisSentinelSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresMigration) DeepCopyInto(out *PostgresMigration) {
+func (in *RedisSnapshot) DeepCopyInto(out *RedisSnapshot) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(RedisSnapshotPipeline)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreserveTTL != nil {
+		in, out := &in.PreserveTTL, &out.PreserveTTL
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresMigration.
-func (in *PostgresMigration) DeepCopy() *PostgresMigration {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSnapshot.
+func (in *RedisSnapshot) DeepCopy() *RedisSnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresMigration)
+	out := new(RedisSnapshot)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresMigration) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresMigrationList) DeepCopyInto(out *PostgresMigrationList) {
+func (in *RedisSnapshotPipeline) DeepCopyInto(out *RedisSnapshotPipeline) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]PostgresMigration, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(int)
+		**out = **in
+	}
+	if in.ScanCount != nil {
+		in, out := &in.ScanCount, &out.ScanCount
+		*out = new(int)
+		**out = **in
+	}
+	if in.WriteBatchSize != nil {
+		in, out := &in.WriteBatchSize, &out.WriteBatchSize
+		*out = new(int)
+		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresMigrationList.
-func (in *PostgresMigrationList) DeepCopy() *PostgresMigrationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSnapshotPipeline.
+func (in *RedisSnapshotPipeline) DeepCopy() *RedisSnapshotPipeline {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresMigrationList)
+	out := new(RedisSnapshotPipeline)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *PostgresMigrationList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresMigrationSpec) DeepCopyInto(out *PostgresMigrationSpec) {
+func (in *RedisSource) DeepCopyInto(out *RedisSource) {
 	*out = *in
-	in.Source.DeepCopyInto(&out.Source)
-	in.Target.DeepCopyInto(&out.Target)
-	if in.JobDefaults != nil {
-		in, out := &in.JobDefaults, &out.JobDefaults
-		*out = new(JobDefaults)
+	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
+	if in.Sentinel != nil {
+		in, out := &in.Sentinel, &out.Sentinel
+		*out = new(RedisSentinelSource)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.JobTemplate != nil {
-		in, out := &in.JobTemplate, &out.JobTemplate
-		*out = new(offshootapiapiv1.PodTemplateSpec)
+	if in.Cluster != nil {
+		in, out := &in.Cluster, &out.Cluster
+		*out = new(RedisClusterSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(RedisSnapshot)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Streaming != nil {
+		in, out := &in.Streaming, &out.Streaming
+		*out = new(RedisStreaming)
+		**out = **in
+	}
+	if in.DBMapping != nil {
+		in, out := &in.DBMapping, &out.DBMapping
+		*out = make([]RedisDBMapping, len(*in))
+		copy(*out, *in)
+	}
+	if in.KeyFilter != nil {
+		in, out := &in.KeyFilter, &out.KeyFilter
+		*out = new(RedisKeyFilter)
 		(*in).DeepCopyInto(*out)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresMigrationSpec.
-func (in *PostgresMigrationSpec) DeepCopy() *PostgresMigrationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisSource.
+func (in *RedisSource) DeepCopy() *RedisSource {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresMigrationSpec)
+	out := new(RedisSource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresSource) DeepCopyInto(out *PostgresSource) {
+func (in *RedisStreaming) DeepCopyInto(out *RedisStreaming) {
 	*out = *in
-	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
-	if in.PgDump != nil {
-		in, out := &in.PgDump, &out.PgDump
-		*out = new(PgDump)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.LogicalReplication != nil {
-		in, out := &in.LogicalReplication, &out.LogicalReplication
-		*out = new(LogicalReplication)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresSource.
-func (in *PostgresSource) DeepCopy() *PostgresSource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisStreaming.
+func (in *RedisStreaming) DeepCopy() *RedisStreaming {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresSource)
+	out := new(RedisStreaming)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PostgresTarget) DeepCopyInto(out *PostgresTarget) {
+func (in *RedisTarget) DeepCopyInto(out *RedisTarget) {
 	*out = *in
 	in.ConnectionInfo.DeepCopyInto(&out.ConnectionInfo)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PostgresTarget.
-func (in *PostgresTarget) DeepCopy() *PostgresTarget {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisTarget.
+func (in *RedisTarget) DeepCopy() *RedisTarget {
 	if in == nil {
 		return nil
 	}
-	out := new(PostgresTarget)
+	out := new(RedisTarget)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Progress) DeepCopyInto(out *Progress) {
+func (in *ReplicationLag) DeepCopyInto(out *ReplicationLag) {
 	*out = *in
-	if in.Info != nil {
-		in, out := &in.Info, &out.Info
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Progress.
-func (in *Progress) DeepCopy() *Progress {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReplicationLag.
+func (in *ReplicationLag) DeepCopy() *ReplicationLag {
 	if in == nil {
 		return nil
 	}
-	out := new(Progress)
+	out := new(ReplicationLag)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Publication) DeepCopyInto(out *Publication) {
+func (in *SchemaTranslation) DeepCopyInto(out *SchemaTranslation) {
 	*out = *in
-	if in.Args != nil {
-		in, out := &in.Args, &out.Args
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.TypeMappings != nil {
+		in, out := &in.TypeMappings, &out.TypeMappings
+		*out = make([]TypeMapping, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Publication.
-func (in *Publication) DeepCopy() *Publication {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaTranslation.
+func (in *SchemaTranslation) DeepCopy() *SchemaTranslation {
 	if in == nil {
 		return nil
 	}
-	out := new(Publication)
+	out := new(SchemaTranslation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1856,6 +3426,21 @@ func (in *Source) DeepCopyInto(out *Source) {
 		*out = new(MSSQLServerSource)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedisSource != nil {
+		in, out := &in.RedisSource, &out.RedisSource
+		*out = new(RedisSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticsearchSource != nil {
+		in, out := &in.ElasticsearchSource, &out.ElasticsearchSource
+		*out = new(ElasticsearchSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MySQLToPostgresSource != nil {
+		in, out := &in.MySQLToPostgresSource, &out.MySQLToPostgresSource
+		*out = new(MySQLToPostgresSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1901,6 +3486,47 @@ func (in *TLSConfig) DeepCopy() *TLSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableProgress) DeepCopyInto(out *TableProgress) {
+	*out = *in
+	if in.LastErrorTime != nil {
+		in, out := &in.LastErrorTime, &out.LastErrorTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableProgress.
+func (in *TableProgress) DeepCopy() *TableProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(TableProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TableVerification) DeepCopyInto(out *TableVerification) {
+	*out = *in
+	if in.SampledRows != nil {
+		in, out := &in.SampledRows, &out.SampledRows
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TableVerification.
+func (in *TableVerification) DeepCopy() *TableVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(TableVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Target) DeepCopyInto(out *Target) {
 	*out = *in
@@ -1929,6 +3555,16 @@ func (in *Target) DeepCopyInto(out *Target) {
 		*out = new(MSSQLServerTarget)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RedisTarget != nil {
+		in, out := &in.RedisTarget, &out.RedisTarget
+		*out = new(RedisTarget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ElasticsearchTarget != nil {
+		in, out := &in.ElasticsearchTarget, &out.ElasticsearchTarget
+		*out = new(ElasticsearchTarget)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1941,3 +3577,127 @@ func (in *Target) DeepCopy() *Target {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TypeMapping) DeepCopyInto(out *TypeMapping) {
+	*out = *in
+	if in.Columns != nil {
+		in, out := &in.Columns, &out.Columns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TypeMapping.
+func (in *TypeMapping) DeepCopy() *TypeMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(TypeMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationSampling) DeepCopyInto(out *VerificationSampling) {
+	*out = *in
+	if in.RowThreshold != nil {
+		in, out := &in.RowThreshold, &out.RowThreshold
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationSampling.
+func (in *VerificationSampling) DeepCopy() *VerificationSampling {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationSampling)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationSpec) DeepCopyInto(out *VerificationSpec) {
+	*out = *in
+	if in.Checksum != nil {
+		in, out := &in.Checksum, &out.Checksum
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Sampling != nil {
+		in, out := &in.Sampling, &out.Sampling
+		*out = new(VerificationSampling)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeTables != nil {
+		in, out := &in.ExcludeTables, &out.ExcludeTables
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailOnMismatch != nil {
+		in, out := &in.FailOnMismatch, &out.FailOnMismatch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationSpec.
+func (in *VerificationSpec) DeepCopy() *VerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationStatus) DeepCopyInto(out *VerificationStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Tables != nil {
+		in, out := &in.Tables, &out.Tables
+		*out = make([]TableVerification, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationStatus.
+func (in *VerificationStatus) DeepCopy() *VerificationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
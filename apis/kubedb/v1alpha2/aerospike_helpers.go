@@ -172,6 +172,9 @@ func (a *Aerospike) SetDefaults(arVersion *catalog.AerospikeVersion) {
 	if a.Spec.DeletionPolicy == "" {
 		a.Spec.DeletionPolicy = DeletionPolicyDelete
 	}
+	if a.Spec.StorageType == "" {
+		a.Spec.StorageType = StorageTypeDurable
+	}
 
 	if !a.Spec.DisableAuth {
 		if a.Spec.AuthSecret == nil {
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha2
 
 import (
+	core "k8s.io/api/core/v1"
 	meta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
 	mona "kmodules.xyz/monitoring-agent-api/api/v1"
@@ -71,6 +72,12 @@ type AerospikeSpec struct {
 
 	Cluster *AerospikeClusterSpec `json:"cluster,omitempty"`
 
+	// StorageType can be durable (default) or ephemeral
+	StorageType StorageType `json:"storageType,omitempty"`
+
+	// Storage to specify how storage shall be used
+	Storage *core.PersistentVolumeClaimSpec `json:"storage,omitempty"`
+
 	// Aerospike secret containing username and password for aerospike pcp user
 	// +optional
 	AuthSecret *SecretReference `json:"authSecret,omitempty"`
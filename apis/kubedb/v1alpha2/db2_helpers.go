@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
+	kmapi "kmodules.xyz/client-go/api/v1"
 	"kmodules.xyz/client-go/apiextensions"
 	metautil "kmodules.xyz/client-go/meta"
 	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
@@ -172,6 +173,36 @@ func (d *DB2) GetAuthSecretName() string {
 	return metautil.NameWithSuffix(d.OffshootName(), "auth")
 }
 
+func (d *DB2) ConfigSecretName() string {
+	uid := string(d.UID)
+	return metautil.NameWithSuffix(d.OffshootName(), uid[len(uid)-6:])
+}
+
+// CertificateName returns the default certificate name and/or certificate secret name for a certificate alias
+func (d *DB2) CertificateName(alias DB2CertificateAlias) string {
+	return metautil.NameWithSuffix(d.Name, fmt.Sprintf("%s-cert", string(alias)))
+}
+
+// GetCertSecretName returns the secret name for a certificate alias if any provide,
+// otherwise returns default certificate secret name for the given alias.
+func (d *DB2) GetCertSecretName(alias DB2CertificateAlias) string {
+	if d.Spec.TLS != nil {
+		name, ok := kmapi.GetCertificateSecretName(d.Spec.TLS.Certificates, string(alias))
+		if ok {
+			return name
+		}
+	}
+	return d.CertificateName(alias)
+}
+
+func (d *DB2) SetTLSDefaults() {
+	if d.Spec.TLS == nil || d.Spec.TLS.IssuerRef == nil {
+		return
+	}
+	d.Spec.TLS.Certificates = kmapi.SetMissingSecretNameForCertificate(d.Spec.TLS.Certificates, string(DB2ServerCert), d.CertificateName(DB2ServerCert))
+	d.Spec.TLS.Certificates = kmapi.SetMissingSecretNameForCertificate(d.Spec.TLS.Certificates, string(DB2ClientCert), d.CertificateName(DB2ClientCert))
+}
+
 func (d *DB2) GetPersistentSecrets() []string {
 	var secrets []string
 	if !IsVirtualAuthSecretReferred(d.Spec.AuthSecret) && d.Spec.AuthSecret != nil && d.Spec.AuthSecret.Name != "" {
@@ -199,6 +230,8 @@ func (d *DB2) SetDefaults(kc client.Client) {
 		d.Spec.Replicas = ptr.To(int32(1))
 	}
 	d.initializePodTemplates()
+	d.SetTLSDefaults()
+	d.Spec.Monitor.SetDefaults()
 	db2Version := &catalogv1alpha1.DB2Version{}
 	err := kc.Get(context.Background(), types.NamespacedName{Name: d.Spec.Version}, db2Version)
 	if err != nil {
@@ -20,6 +20,7 @@ import (
 	core "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
+	mona "kmodules.xyz/monitoring-agent-api/api/v1"
 	ofstv2 "kmodules.xyz/offshoot-api/api/v2"
 )
 
@@ -69,6 +70,16 @@ type DB2Spec struct {
 	// +optional
 	AuthSecret *SecretReference `json:"authSecret,omitempty"`
 
+	// Configuration is an optional field to provide custom configuration file for database (i.e. db2.conf).
+	// If specified, this file will be used as configuration file otherwise default configuration file will be used.
+	// You can provide custom configurations using Secret or ApplyConfig.
+	// +optional
+	Configuration *ConfigurationSpec `json:"configuration,omitempty"`
+
+	// TLS contains tls configurations
+	// +optional
+	TLS *kmapi.TLSConfig `json:"tls,omitempty"`
+
 	// PodTemplate is an optional configuration for pods used to expose database
 	// +optional
 	PodTemplate *ofstv2.PodTemplateSpec `json:"podTemplate,omitempty"`
@@ -77,6 +88,10 @@ type DB2Spec struct {
 	// +optional
 	ServiceTemplates []NamedServiceTemplateSpec `json:"serviceTemplates,omitempty"`
 
+	// Monitor is used monitor database instance
+	// +optional
+	Monitor *mona.AgentSpec `json:"monitor,omitempty"`
+
 	// DeletionPolicy controls the delete operation for database
 	// +optional
 	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
@@ -105,6 +120,14 @@ type DB2Status struct {
 	Conditions []kmapi.Condition `json:"conditions,omitempty"`
 }
 
+// +kubebuilder:validation:Enum=server;client
+type DB2CertificateAlias string
+
+const (
+	DB2ServerCert DB2CertificateAlias = "server"
+	DB2ClientCert DB2CertificateAlias = "client"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // DB2List contains a list of DB2
@@ -113,3 +136,21 @@ type DB2List struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []DB2 `json:"items"`
 }
+
+var _ Accessor = &DB2{}
+
+func (d *DB2) GetObjectMeta() metav1.ObjectMeta {
+	return d.ObjectMeta
+}
+
+func (d *DB2) GetConditions() []kmapi.Condition {
+	return d.Status.Conditions
+}
+
+func (d *DB2) SetCondition(cond kmapi.Condition) {
+	d.Status.Conditions = setCondition(d.Status.Conditions, cond)
+}
+
+func (d *DB2) RemoveCondition(typ string) {
+	d.Status.Conditions = removeCondition(d.Status.Conditions, typ)
+}
@@ -128,6 +128,11 @@ func (in *AerospikeSpec) DeepCopyInto(out *AerospikeSpec) {
 		*out = new(AerospikeClusterSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(corev1.PersistentVolumeClaimSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AuthSecret != nil {
 		in, out := &in.AuthSecret, &out.AuthSecret
 		*out = new(SecretReference)
@@ -1159,6 +1164,16 @@ func (in *DB2Spec) DeepCopyInto(out *DB2Spec) {
 		*out = new(SecretReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(ConfigurationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(apiv1.TLSConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PodTemplate != nil {
 		in, out := &in.PodTemplate, &out.PodTemplate
 		*out = new(v2.PodTemplateSpec)
@@ -1171,6 +1186,11 @@ func (in *DB2Spec) DeepCopyInto(out *DB2Spec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Monitor != nil {
+		in, out := &in.Monitor, &out.Monitor
+		*out = new(v1.AgentSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.HealthChecker.DeepCopyInto(&out.HealthChecker)
 	if in.Init != nil {
 		in, out := &in.Init, &out.Init
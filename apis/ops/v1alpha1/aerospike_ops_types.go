@@ -19,6 +19,7 @@ package v1alpha1
 
 import (
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -47,19 +48,64 @@ type AerospikeOpsRequest struct {
 }
 
 type AerospikeOpsRequestSpec struct {
+	// Specifies the Aerospike reference
 	DatabaseRef core.LocalObjectReference `json:"databaseRef"`
-	Type        AerospikeOpsRequestType   `json:"type"`
+	// Specifies the ops request type: UpdateVersion, HorizontalScaling, VerticalScaling etc.
+	Type AerospikeOpsRequestType `json:"type"`
+	// Specifies information necessary for upgrading Aerospike
+	UpdateVersion *AerospikeUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
+	// Specifies information necessary for horizontal scaling
+	HorizontalScaling *AerospikeHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
 	VerticalScaling *AerospikeVerticalScalingSpec `json:"verticalScaling,omitempty"`
+	// Specifies information necessary for volume expansion
+	VolumeExpansion *AerospikeVolumeExpansionSpec `json:"volumeExpansion,omitempty"`
+	// Specifies information necessary for custom configuration of Aerospike
+	Configuration *ReconfigurationSpec `json:"configuration,omitempty"`
+	// Specifies information necessary for configuring TLS
+	TLS *TLSSpec `json:"tls,omitempty"`
+	// Specifies information necessary for configuring authSecret of the database
+	Authentication *AuthSpec `json:"authentication,omitempty"`
+	// Specifies information necessary for migrating storage
+	Migration *StorageMigrationSpec `json:"migration,omitempty"`
 	// Specifies information necessary for restarting database
-	Restart *RestartSpec     `json:"restart,omitempty"`
+	Restart *RestartSpec `json:"restart,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// ApplyOption is to control the execution of OpsRequest depending on the database state.
 	// +kubebuilder:default="IfReady"
 	Apply ApplyOption `json:"apply,omitempty"`
 	// +kubebuilder:default=1
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 }
 
+// AerospikeUpdateVersionSpec contains the update version information of an Aerospike cluster
+type AerospikeUpdateVersionSpec struct {
+	// Specifies the target version name from catalog
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
+}
+
+// AerospikeHorizontalScalingSpec contains the horizontal scaling information of an Aerospike cluster.
+// Nodes are added or removed one at a time. After each step, the operator waits until the partition
+// migrations finish, so that every partition keeps spec.cluster.replicationFactor copies.
+type AerospikeHorizontalScalingSpec struct {
+	// Number of nodes of the cluster. It can not be less than the replication factor.
+	Replicas *int32 `json:"replicas,omitempty"`
+	// MigrationTimeout bounds the wait for the partition migrations after each node is added or removed.
+	// The timeout of the ops request is used when empty.
+	// +optional
+	MigrationTimeout *metav1.Duration `json:"migrationTimeout,omitempty"`
+}
+
 // AerospikeVerticalScalingSpec is the spec for Aerospike vertical scaling.
 type AerospikeVerticalScalingSpec struct {
 	Aerospike *PodResources       `json:"aerospike,omitempty"`
@@ -70,8 +116,15 @@ type AerospikeVerticalScalingSpec struct {
 	Mode VerticalScalingMode `json:"mode,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=VerticalScaling;Restart
-// ENUM(VerticalScaling, Restart)
+// AerospikeVolumeExpansionSpec is the spec for Aerospike volume expansion
+type AerospikeVolumeExpansionSpec struct {
+	Mode VolumeExpansionMode `json:"mode"`
+	// volume specification for Aerospike nodes
+	Aerospike *resource.Quantity `json:"aerospike,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=VerticalScaling;Restart;UpdateVersion;HorizontalScaling;VolumeExpansion;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration;Rollback
+// ENUM(VerticalScaling, Restart, UpdateVersion, HorizontalScaling, VolumeExpansion, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration, Rollback)
 type AerospikeOpsRequestType string
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
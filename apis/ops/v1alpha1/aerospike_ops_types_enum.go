@@ -15,6 +15,22 @@ const (
 	AerospikeOpsRequestTypeVerticalScaling AerospikeOpsRequestType = "VerticalScaling"
 	// AerospikeOpsRequestTypeRestart is a AerospikeOpsRequestType of type Restart.
 	AerospikeOpsRequestTypeRestart AerospikeOpsRequestType = "Restart"
+	// AerospikeOpsRequestTypeUpdateVersion is a AerospikeOpsRequestType of type UpdateVersion.
+	AerospikeOpsRequestTypeUpdateVersion AerospikeOpsRequestType = "UpdateVersion"
+	// AerospikeOpsRequestTypeHorizontalScaling is a AerospikeOpsRequestType of type HorizontalScaling.
+	AerospikeOpsRequestTypeHorizontalScaling AerospikeOpsRequestType = "HorizontalScaling"
+	// AerospikeOpsRequestTypeVolumeExpansion is a AerospikeOpsRequestType of type VolumeExpansion.
+	AerospikeOpsRequestTypeVolumeExpansion AerospikeOpsRequestType = "VolumeExpansion"
+	// AerospikeOpsRequestTypeReconfigure is a AerospikeOpsRequestType of type Reconfigure.
+	AerospikeOpsRequestTypeReconfigure AerospikeOpsRequestType = "Reconfigure"
+	// AerospikeOpsRequestTypeReconfigureTLS is a AerospikeOpsRequestType of type ReconfigureTLS.
+	AerospikeOpsRequestTypeReconfigureTLS AerospikeOpsRequestType = "ReconfigureTLS"
+	// AerospikeOpsRequestTypeRotateAuth is a AerospikeOpsRequestType of type RotateAuth.
+	AerospikeOpsRequestTypeRotateAuth AerospikeOpsRequestType = "RotateAuth"
+	// AerospikeOpsRequestTypeStorageMigration is a AerospikeOpsRequestType of type StorageMigration.
+	AerospikeOpsRequestTypeStorageMigration AerospikeOpsRequestType = "StorageMigration"
+	// AerospikeOpsRequestTypeRollback is a AerospikeOpsRequestType of type Rollback.
+	AerospikeOpsRequestTypeRollback AerospikeOpsRequestType = "Rollback"
 )
 
 var ErrInvalidAerospikeOpsRequestType = fmt.Errorf("not a valid AerospikeOpsRequestType, try [%s]", strings.Join(_AerospikeOpsRequestTypeNames, ", "))
@@ -22,6 +38,14 @@ var ErrInvalidAerospikeOpsRequestType = fmt.Errorf("not a valid AerospikeOpsRequ
 var _AerospikeOpsRequestTypeNames = []string{
 	string(AerospikeOpsRequestTypeVerticalScaling),
 	string(AerospikeOpsRequestTypeRestart),
+	string(AerospikeOpsRequestTypeUpdateVersion),
+	string(AerospikeOpsRequestTypeHorizontalScaling),
+	string(AerospikeOpsRequestTypeVolumeExpansion),
+	string(AerospikeOpsRequestTypeReconfigure),
+	string(AerospikeOpsRequestTypeReconfigureTLS),
+	string(AerospikeOpsRequestTypeRotateAuth),
+	string(AerospikeOpsRequestTypeStorageMigration),
+	string(AerospikeOpsRequestTypeRollback),
 }
 
 // AerospikeOpsRequestTypeNames returns a list of possible string values of AerospikeOpsRequestType.
@@ -36,6 +60,14 @@ func AerospikeOpsRequestTypeValues() []AerospikeOpsRequestType {
 	return []AerospikeOpsRequestType{
 		AerospikeOpsRequestTypeVerticalScaling,
 		AerospikeOpsRequestTypeRestart,
+		AerospikeOpsRequestTypeUpdateVersion,
+		AerospikeOpsRequestTypeHorizontalScaling,
+		AerospikeOpsRequestTypeVolumeExpansion,
+		AerospikeOpsRequestTypeReconfigure,
+		AerospikeOpsRequestTypeReconfigureTLS,
+		AerospikeOpsRequestTypeRotateAuth,
+		AerospikeOpsRequestTypeStorageMigration,
+		AerospikeOpsRequestTypeRollback,
 	}
 }
 
@@ -52,8 +84,16 @@ func (x AerospikeOpsRequestType) IsValid() bool {
 }
 
 var _AerospikeOpsRequestTypeValue = map[string]AerospikeOpsRequestType{
-	"VerticalScaling": AerospikeOpsRequestTypeVerticalScaling,
-	"Restart":         AerospikeOpsRequestTypeRestart,
+	"VerticalScaling":   AerospikeOpsRequestTypeVerticalScaling,
+	"Restart":           AerospikeOpsRequestTypeRestart,
+	"UpdateVersion":     AerospikeOpsRequestTypeUpdateVersion,
+	"HorizontalScaling": AerospikeOpsRequestTypeHorizontalScaling,
+	"VolumeExpansion":   AerospikeOpsRequestTypeVolumeExpansion,
+	"Reconfigure":       AerospikeOpsRequestTypeReconfigure,
+	"ReconfigureTLS":    AerospikeOpsRequestTypeReconfigureTLS,
+	"RotateAuth":        AerospikeOpsRequestTypeRotateAuth,
+	"StorageMigration":  AerospikeOpsRequestTypeStorageMigration,
+	"Rollback":          AerospikeOpsRequestTypeRollback,
 }
 
 // ParseAerospikeOpsRequestType attempts to convert a string to a AerospikeOpsRequestType.
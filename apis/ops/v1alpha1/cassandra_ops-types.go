@@ -63,6 +63,8 @@ type CassandraOpsRequestSpec struct {
 	HorizontalScaling *CassandraHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for upgrading cassandra
 	UpdateVersion *CassandraUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
 	// Specifies information necessary for vertical scaling
 	VerticalScaling *CassandraVerticalScalingSpec `json:"verticalScaling,omitempty"`
 	// Specifies information necessary for volume expansion
@@ -71,6 +73,10 @@ type CassandraOpsRequestSpec struct {
 	Restart *RestartSpec `json:"restart,omitempty"`
 	// Specifies information necessary for migrating storage
 	Migration *StorageMigrationSpec `json:"migration,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// Specifies information necessary for configuring TLS
@@ -87,8 +93,8 @@ type CassandraOpsRequestSpec struct {
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=UpdateVersion;VerticalScaling;Restart;VolumeExpansion;HorizontalScaling;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration
-// ENUM(UpdateVersion, VerticalScaling, Restart, VolumeExpansion, HorizontalScaling, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration)
+// +kubebuilder:validation:Enum=UpdateVersion;VerticalScaling;Restart;VolumeExpansion;HorizontalScaling;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration;Rollback
+// ENUM(UpdateVersion, VerticalScaling, Restart, VolumeExpansion, HorizontalScaling, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration, Rollback)
 type CassandraOpsRequestType string
 
 // CassandraHorizontalScalingSpec contains the horizontal scaling information of a Cassandra cluster
@@ -101,6 +107,9 @@ type CassandraHorizontalScalingSpec struct {
 type CassandraUpdateVersionSpec struct {
 	// Specifies the target version name from catalog
 	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
 }
 
 // CassandraVerticalScalingSpec contains the vertical scaling information of a Cassandra cluster
@@ -29,6 +29,8 @@ const (
 	CassandraOpsRequestTypeRotateAuth CassandraOpsRequestType = "RotateAuth"
 	// CassandraOpsRequestTypeStorageMigration is a CassandraOpsRequestType of type StorageMigration.
 	CassandraOpsRequestTypeStorageMigration CassandraOpsRequestType = "StorageMigration"
+	// CassandraOpsRequestTypeRollback is a CassandraOpsRequestType of type Rollback.
+	CassandraOpsRequestTypeRollback CassandraOpsRequestType = "Rollback"
 )
 
 var ErrInvalidCassandraOpsRequestType = fmt.Errorf("not a valid CassandraOpsRequestType, try [%s]", strings.Join(_CassandraOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _CassandraOpsRequestTypeNames = []string{
 	string(CassandraOpsRequestTypeReconfigureTLS),
 	string(CassandraOpsRequestTypeRotateAuth),
 	string(CassandraOpsRequestTypeStorageMigration),
+	string(CassandraOpsRequestTypeRollback),
 }
 
 // CassandraOpsRequestTypeNames returns a list of possible string values of CassandraOpsRequestType.
@@ -64,6 +67,7 @@ func CassandraOpsRequestTypeValues() []CassandraOpsRequestType {
 		CassandraOpsRequestTypeReconfigureTLS,
 		CassandraOpsRequestTypeRotateAuth,
 		CassandraOpsRequestTypeStorageMigration,
+		CassandraOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _CassandraOpsRequestTypeValue = map[string]CassandraOpsRequestType{
 	"ReconfigureTLS":    CassandraOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        CassandraOpsRequestTypeRotateAuth,
 	"StorageMigration":  CassandraOpsRequestTypeStorageMigration,
+	"Rollback":          CassandraOpsRequestTypeRollback,
 }
 
 // ParseCassandraOpsRequestType attempts to convert a string to a CassandraOpsRequestType.
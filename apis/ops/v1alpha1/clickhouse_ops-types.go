@@ -65,6 +65,8 @@ type ClickHouseOpsRequestSpec struct {
 	HorizontalScaling *ClickHouseHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for upgrading clickhouse
 	UpdateVersion *ClickHouseUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
 	// Specifies information necessary for vertical scaling
 	VerticalScaling *ClickHouseVerticalScalingSpec `json:"verticalScaling,omitempty"`
 	// Specifies information necessary for volume expansion
@@ -73,6 +75,10 @@ type ClickHouseOpsRequestSpec struct {
 	Configuration *ReconfigurationSpec `json:"configuration,omitempty"`
 	// Specifies information necessary for migrating storageClass or data
 	Migration *ClickHouseMigrationSpec `json:"migration,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// Specifies information necessary for configuring TLS
@@ -84,14 +90,17 @@ type ClickHouseOpsRequestSpec struct {
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Restart;VerticalScaling;HorizontalScaling;UpdateVersion;VolumeExpansion;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration
-// ENUM(Restart, VerticalScaling, HorizontalScaling, UpdateVersion, VolumeExpansion, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration)
+// +kubebuilder:validation:Enum=Restart;VerticalScaling;HorizontalScaling;UpdateVersion;VolumeExpansion;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration;Rollback
+// ENUM(Restart, VerticalScaling, HorizontalScaling, UpdateVersion, VolumeExpansion, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration, Rollback)
 type ClickHouseOpsRequestType string
 
 // ClickHouseUpdateVersionSpec contains the update version information of a clickhouse cluster
 type ClickHouseUpdateVersionSpec struct {
 	// Specifies the target version name from catalog
 	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
 }
 
 // ClickHouseVolumeExpansionSpec is the spec for ClickHouse volume expansion
@@ -29,6 +29,8 @@ const (
 	ClickHouseOpsRequestTypeRotateAuth ClickHouseOpsRequestType = "RotateAuth"
 	// ClickHouseOpsRequestTypeStorageMigration is a ClickHouseOpsRequestType of type StorageMigration.
 	ClickHouseOpsRequestTypeStorageMigration ClickHouseOpsRequestType = "StorageMigration"
+	// ClickHouseOpsRequestTypeRollback is a ClickHouseOpsRequestType of type Rollback.
+	ClickHouseOpsRequestTypeRollback ClickHouseOpsRequestType = "Rollback"
 )
 
 var ErrInvalidClickHouseOpsRequestType = fmt.Errorf("not a valid ClickHouseOpsRequestType, try [%s]", strings.Join(_ClickHouseOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _ClickHouseOpsRequestTypeNames = []string{
 	string(ClickHouseOpsRequestTypeReconfigureTLS),
 	string(ClickHouseOpsRequestTypeRotateAuth),
 	string(ClickHouseOpsRequestTypeStorageMigration),
+	string(ClickHouseOpsRequestTypeRollback),
 }
 
 // ClickHouseOpsRequestTypeNames returns a list of possible string values of ClickHouseOpsRequestType.
@@ -64,6 +67,7 @@ func ClickHouseOpsRequestTypeValues() []ClickHouseOpsRequestType {
 		ClickHouseOpsRequestTypeReconfigureTLS,
 		ClickHouseOpsRequestTypeRotateAuth,
 		ClickHouseOpsRequestTypeStorageMigration,
+		ClickHouseOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _ClickHouseOpsRequestTypeValue = map[string]ClickHouseOpsRequestType{
 	"ReconfigureTLS":    ClickHouseOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        ClickHouseOpsRequestTypeRotateAuth,
 	"StorageMigration":  ClickHouseOpsRequestTypeStorageMigration,
+	"Rollback":          ClickHouseOpsRequestTypeRollback,
 }
 
 // ParseClickHouseOpsRequestType attempts to convert a string to a ClickHouseOpsRequestType.
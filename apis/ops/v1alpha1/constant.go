@@ -21,6 +21,13 @@ const (
 
 	LabelOpsRequestKind = GenericKey + "/kind"
 	LabelOpsRequestName = GenericKey + "/name"
+
+	LabelOpsPlanName = GenericKey + "/plan"
+	LabelOpsPlanStep = GenericKey + "/plan-step"
+
+	// LabelOpsRequestSource records the creator of an ops request. The ops request controller sets it from the
+	// owner references of a pending ops request, unless the creator has set it already.
+	LabelOpsRequestSource = GenericKey + "/source"
 )
 
 const (
@@ -182,6 +189,67 @@ const (
 	OrphanPetSetPods       = "OrphanPetSetPods"
 )
 
+// OpsPlan
+const (
+	OpsPlanStepStarted    = "StepStarted"
+	OpsPlanStepSucceeded  = "StepSucceeded"
+	OpsPlanStepFailed     = "StepFailed"
+	OpsPlanRollingBack    = "RollingBack"
+	OpsPlanRolledBack     = "RolledBack"
+	OpsPlanRollbackFailed = "RollbackFailed"
+	OpsPlanStepsSucceeded = "StepsSucceeded"
+)
+
+// Rollback
+const (
+	PreUpdateStateCaptured = "PreUpdateStateCaptured"
+	CaptureVolumeSnapshots = "CaptureVolumeSnapshots"
+	RollbackStarted        = "RollbackStarted"
+	RestorePetSetTemplates = "RestorePetSetTemplates"
+	RestoreVolumeSnapshots = "RestoreVolumeSnapshots"
+	RollbackSucceeded      = "RollbackSucceeded"
+	RollbackFailed         = "RollbackFailed"
+)
+
+// Switchover
+const (
+	SwitchoverTargetSelected = "SwitchoverTargetSelected"
+	ReplicationLagChecked    = "ReplicationLagChecked"
+	SwitchoverPrimary        = "SwitchoverPrimary"
+	SwitchoverSucceeded      = "SwitchoverSucceeded"
+)
+
+// OpsApprovalPolicy
+const (
+	ApprovalRequired   = "ApprovalRequired"
+	ApprovalRecorded   = "ApprovalRecorded"
+	ApprovalsSatisfied = "ApprovalsSatisfied"
+	DeniedByApprover   = "DeniedByApprover"
+)
+
+// MaintenanceWindow
+const (
+	WaitingForMaintenanceWindow  = "WaitingForMaintenanceWindow"
+	HeldByMaintenanceWindow      = "HeldByMaintenanceWindow"
+	CancelledByMaintenanceWindow = "CancelledByMaintenanceWindow"
+	MaintenanceWindowOpen        = "MaintenanceWindowOpen"
+)
+
+// OpsConcurrencyPolicy
+const (
+	QueuedByConcurrencyPolicy = "QueuedByConcurrencyPolicy"
+)
+
+// Canary rollout
+const (
+	CanaryApplied    = "CanaryApplied"
+	CanaryBaking     = "CanaryBaking"
+	CanaryPassed     = "CanaryPassed"
+	CanaryFailed     = "CanaryFailed"
+	PausedByCanary   = "PausedByCanary"
+	RevertedByCanary = "RevertedByCanary"
+)
+
 // Stash
 const (
 	PauseBackupConfiguration  = "PauseBackupConfiguration"
@@ -465,6 +533,15 @@ const (
 	RemovePeers     = "RemovePeers"
 )
 
+// HanaDB/Oracle Constants
+const (
+	SwitchoverToUpdatedStandby = "SwitchoverToUpdatedStandby"
+	RegisterSecondaries        = "RegisterSecondaries"
+	UnregisterSecondaries      = "UnregisterSecondaries"
+	AddDataGuardStandbys       = "AddDataGuardStandbys"
+	RemoveDataGuardStandbys    = "RemoveDataGuardStandbys"
+)
+
 // Neo4j Constanst
 const (
 	UpdateServerPVCs = "UpdateServerPVCs"
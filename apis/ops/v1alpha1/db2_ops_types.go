@@ -19,6 +19,7 @@ package v1alpha1
 
 import (
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -47,19 +48,61 @@ type DB2OpsRequest struct {
 }
 
 type DB2OpsRequestSpec struct {
+	// Specifies the DB2 reference
 	DatabaseRef core.LocalObjectReference `json:"databaseRef"`
-	Type        DB2OpsRequestType         `json:"type"`
+	// Specifies the ops request type: UpdateVersion, HorizontalScaling, VerticalScaling etc.
+	Type DB2OpsRequestType `json:"type"`
+	// Specifies information necessary for upgrading DB2
+	UpdateVersion *DB2UpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
+	// Specifies information necessary for horizontal scaling
+	HorizontalScaling *DB2HorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
 	VerticalScaling *DB2VerticalScalingSpec `json:"verticalScaling,omitempty"`
+	// Specifies information necessary for volume expansion
+	VolumeExpansion *DB2VolumeExpansionSpec `json:"volumeExpansion,omitempty"`
+	// Specifies information necessary for custom configuration of DB2
+	Configuration *ReconfigurationSpec `json:"configuration,omitempty"`
+	// Specifies information necessary for configuring TLS
+	TLS *TLSSpec `json:"tls,omitempty"`
+	// Specifies information necessary for configuring authSecret of the database
+	Authentication *AuthSpec `json:"authentication,omitempty"`
+	// Specifies information necessary for migrating storage
+	Migration *StorageMigrationSpec `json:"migration,omitempty"`
 	// Specifies information necessary for restarting database
-	Restart *RestartSpec     `json:"restart,omitempty"`
+	Restart *RestartSpec `json:"restart,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
+	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// ApplyOption is to control the execution of OpsRequest depending on the database state.
 	// +kubebuilder:default="IfReady"
 	Apply ApplyOption `json:"apply,omitempty"`
 	// +kubebuilder:default=1
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 }
 
+// DB2UpdateVersionSpec contains the update version information of a DB2 database
+type DB2UpdateVersionSpec struct {
+	// Specifies the target version name from catalog
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
+}
+
+// DB2HorizontalScalingSpec contains the horizontal scaling information of a DB2 database.
+// The replicas form an HADR group of one primary and up to three standbys.
+type DB2HorizontalScalingSpec struct {
+	// Number of nodes of the HADR group, including the primary. It can not be more than 4.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
 // DB2VerticalScalingSpec is the spec for DB2 vertical scaling.
 type DB2VerticalScalingSpec struct {
 	DB2      *PodResources       `json:"db2,omitempty"`
@@ -70,8 +113,15 @@ type DB2VerticalScalingSpec struct {
 	Mode VerticalScalingMode `json:"mode,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=VerticalScaling;Restart
-// ENUM(VerticalScaling, Restart)
+// DB2VolumeExpansionSpec is the spec for DB2 volume expansion
+type DB2VolumeExpansionSpec struct {
+	Mode VolumeExpansionMode `json:"mode"`
+	// volume specification for DB2 nodes
+	DB2 *resource.Quantity `json:"db2,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=VerticalScaling;Restart;UpdateVersion;HorizontalScaling;VolumeExpansion;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration;Rollback
+// ENUM(VerticalScaling, Restart, UpdateVersion, HorizontalScaling, VolumeExpansion, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration, Rollback)
 type DB2OpsRequestType string
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
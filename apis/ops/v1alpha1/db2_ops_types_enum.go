@@ -15,6 +15,22 @@ const (
 	DB2OpsRequestTypeVerticalScaling DB2OpsRequestType = "VerticalScaling"
 	// DB2OpsRequestTypeRestart is a DB2OpsRequestType of type Restart.
 	DB2OpsRequestTypeRestart DB2OpsRequestType = "Restart"
+	// DB2OpsRequestTypeUpdateVersion is a DB2OpsRequestType of type UpdateVersion.
+	DB2OpsRequestTypeUpdateVersion DB2OpsRequestType = "UpdateVersion"
+	// DB2OpsRequestTypeHorizontalScaling is a DB2OpsRequestType of type HorizontalScaling.
+	DB2OpsRequestTypeHorizontalScaling DB2OpsRequestType = "HorizontalScaling"
+	// DB2OpsRequestTypeVolumeExpansion is a DB2OpsRequestType of type VolumeExpansion.
+	DB2OpsRequestTypeVolumeExpansion DB2OpsRequestType = "VolumeExpansion"
+	// DB2OpsRequestTypeReconfigure is a DB2OpsRequestType of type Reconfigure.
+	DB2OpsRequestTypeReconfigure DB2OpsRequestType = "Reconfigure"
+	// DB2OpsRequestTypeReconfigureTLS is a DB2OpsRequestType of type ReconfigureTLS.
+	DB2OpsRequestTypeReconfigureTLS DB2OpsRequestType = "ReconfigureTLS"
+	// DB2OpsRequestTypeRotateAuth is a DB2OpsRequestType of type RotateAuth.
+	DB2OpsRequestTypeRotateAuth DB2OpsRequestType = "RotateAuth"
+	// DB2OpsRequestTypeStorageMigration is a DB2OpsRequestType of type StorageMigration.
+	DB2OpsRequestTypeStorageMigration DB2OpsRequestType = "StorageMigration"
+	// DB2OpsRequestTypeRollback is a DB2OpsRequestType of type Rollback.
+	DB2OpsRequestTypeRollback DB2OpsRequestType = "Rollback"
 )
 
 var ErrInvalidDB2OpsRequestType = fmt.Errorf("not a valid DB2OpsRequestType, try [%s]", strings.Join(_DB2OpsRequestTypeNames, ", "))
@@ -22,6 +38,14 @@ var ErrInvalidDB2OpsRequestType = fmt.Errorf("not a valid DB2OpsRequestType, try
 var _DB2OpsRequestTypeNames = []string{
 	string(DB2OpsRequestTypeVerticalScaling),
 	string(DB2OpsRequestTypeRestart),
+	string(DB2OpsRequestTypeUpdateVersion),
+	string(DB2OpsRequestTypeHorizontalScaling),
+	string(DB2OpsRequestTypeVolumeExpansion),
+	string(DB2OpsRequestTypeReconfigure),
+	string(DB2OpsRequestTypeReconfigureTLS),
+	string(DB2OpsRequestTypeRotateAuth),
+	string(DB2OpsRequestTypeStorageMigration),
+	string(DB2OpsRequestTypeRollback),
 }
 
 // DB2OpsRequestTypeNames returns a list of possible string values of DB2OpsRequestType.
@@ -36,6 +60,14 @@ func DB2OpsRequestTypeValues() []DB2OpsRequestType {
 	return []DB2OpsRequestType{
 		DB2OpsRequestTypeVerticalScaling,
 		DB2OpsRequestTypeRestart,
+		DB2OpsRequestTypeUpdateVersion,
+		DB2OpsRequestTypeHorizontalScaling,
+		DB2OpsRequestTypeVolumeExpansion,
+		DB2OpsRequestTypeReconfigure,
+		DB2OpsRequestTypeReconfigureTLS,
+		DB2OpsRequestTypeRotateAuth,
+		DB2OpsRequestTypeStorageMigration,
+		DB2OpsRequestTypeRollback,
 	}
 }
 
@@ -52,8 +84,16 @@ func (x DB2OpsRequestType) IsValid() bool {
 }
 
 var _DB2OpsRequestTypeValue = map[string]DB2OpsRequestType{
-	"VerticalScaling": DB2OpsRequestTypeVerticalScaling,
-	"Restart":         DB2OpsRequestTypeRestart,
+	"VerticalScaling":   DB2OpsRequestTypeVerticalScaling,
+	"Restart":           DB2OpsRequestTypeRestart,
+	"UpdateVersion":     DB2OpsRequestTypeUpdateVersion,
+	"HorizontalScaling": DB2OpsRequestTypeHorizontalScaling,
+	"VolumeExpansion":   DB2OpsRequestTypeVolumeExpansion,
+	"Reconfigure":       DB2OpsRequestTypeReconfigure,
+	"ReconfigureTLS":    DB2OpsRequestTypeReconfigureTLS,
+	"RotateAuth":        DB2OpsRequestTypeRotateAuth,
+	"StorageMigration":  DB2OpsRequestTypeStorageMigration,
+	"Rollback":          DB2OpsRequestTypeRollback,
 }
 
 // ParseDB2OpsRequestType attempts to convert a string to a DB2OpsRequestType.
@@ -62,6 +62,8 @@ type DocumentDBOpsRequestSpec struct {
 	Type DocumentDBOpsRequestType `json:"type"`
 	// Specifies information necessary for upgrading DocumentDB
 	UpdateVersion *DocumentDBUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
 	// Specifies information necessary for horizontal scaling
 	HorizontalScaling *DocumentDBHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
@@ -84,6 +86,10 @@ type DocumentDBOpsRequestSpec struct {
 	SetRaftKeyPair *DocumentDBSetRaftKeyPair `json:"setRaftKeyPair,omitempty"`
 	// Specifies information necessary for migrating storageClass or data
 	Migration *DocumentDBMigrationSpec `json:"migration,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// ApplyOption is to control the execution of OpsRequest depending on the database state.
@@ -93,13 +99,16 @@ type DocumentDBOpsRequestSpec struct {
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=UpdateVersion;HorizontalScaling;VerticalScaling;VolumeExpansion;Restart;Reconfigure;ReconfigureTLS;RotateAuth;ReconnectStandby;ForceFailOver;SetRaftKeyPair;StorageMigration
-// ENUM(UpdateVersion, HorizontalScaling, VerticalScaling, VolumeExpansion, Restart, Reconfigure, ReconfigureTLS, RotateAuth, ReconnectStandby, ForceFailOver, SetRaftKeyPair, StorageMigration)
+// +kubebuilder:validation:Enum=UpdateVersion;HorizontalScaling;VerticalScaling;VolumeExpansion;Restart;Reconfigure;ReconfigureTLS;RotateAuth;ReconnectStandby;ForceFailOver;SetRaftKeyPair;StorageMigration;Rollback
+// ENUM(UpdateVersion, HorizontalScaling, VerticalScaling, VolumeExpansion, Restart, Reconfigure, ReconfigureTLS, RotateAuth, ReconnectStandby, ForceFailOver, SetRaftKeyPair, StorageMigration, Rollback)
 type DocumentDBOpsRequestType string
 
 type DocumentDBUpdateVersionSpec struct {
 	// Specifies the target version name from catalog
 	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Synchronous;Asynchronous
@@ -35,6 +35,8 @@ const (
 	DocumentDBOpsRequestTypeSetRaftKeyPair DocumentDBOpsRequestType = "SetRaftKeyPair"
 	// DocumentDBOpsRequestTypeStorageMigration is a DocumentDBOpsRequestType of type StorageMigration.
 	DocumentDBOpsRequestTypeStorageMigration DocumentDBOpsRequestType = "StorageMigration"
+	// DocumentDBOpsRequestTypeRollback is a DocumentDBOpsRequestType of type Rollback.
+	DocumentDBOpsRequestTypeRollback DocumentDBOpsRequestType = "Rollback"
 )
 
 var ErrInvalidDocumentDBOpsRequestType = fmt.Errorf("not a valid DocumentDBOpsRequestType, try [%s]", strings.Join(_DocumentDBOpsRequestTypeNames, ", "))
@@ -52,6 +54,7 @@ var _DocumentDBOpsRequestTypeNames = []string{
 	string(DocumentDBOpsRequestTypeForceFailOver),
 	string(DocumentDBOpsRequestTypeSetRaftKeyPair),
 	string(DocumentDBOpsRequestTypeStorageMigration),
+	string(DocumentDBOpsRequestTypeRollback),
 }
 
 // DocumentDBOpsRequestTypeNames returns a list of possible string values of DocumentDBOpsRequestType.
@@ -76,6 +79,7 @@ func DocumentDBOpsRequestTypeValues() []DocumentDBOpsRequestType {
 		DocumentDBOpsRequestTypeForceFailOver,
 		DocumentDBOpsRequestTypeSetRaftKeyPair,
 		DocumentDBOpsRequestTypeStorageMigration,
+		DocumentDBOpsRequestTypeRollback,
 	}
 }
 
@@ -104,6 +108,7 @@ var _DocumentDBOpsRequestTypeValue = map[string]DocumentDBOpsRequestType{
 	"ForceFailOver":     DocumentDBOpsRequestTypeForceFailOver,
 	"SetRaftKeyPair":    DocumentDBOpsRequestTypeSetRaftKeyPair,
 	"StorageMigration":  DocumentDBOpsRequestTypeStorageMigration,
+	"Rollback":          DocumentDBOpsRequestTypeRollback,
 }
 
 // ParseDocumentDBOpsRequestType attempts to convert a string to a DocumentDBOpsRequestType.
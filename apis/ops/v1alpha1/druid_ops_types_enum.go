@@ -29,6 +29,8 @@ const (
 	DruidOpsRequestTypeRotateAuth DruidOpsRequestType = "RotateAuth"
 	// DruidOpsRequestTypeStorageMigration is a DruidOpsRequestType of type StorageMigration.
 	DruidOpsRequestTypeStorageMigration DruidOpsRequestType = "StorageMigration"
+	// DruidOpsRequestTypeRollback is a DruidOpsRequestType of type Rollback.
+	DruidOpsRequestTypeRollback DruidOpsRequestType = "Rollback"
 )
 
 var ErrInvalidDruidOpsRequestType = fmt.Errorf("not a valid DruidOpsRequestType, try [%s]", strings.Join(_DruidOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _DruidOpsRequestTypeNames = []string{
 	string(DruidOpsRequestTypeReconfigureTLS),
 	string(DruidOpsRequestTypeRotateAuth),
 	string(DruidOpsRequestTypeStorageMigration),
+	string(DruidOpsRequestTypeRollback),
 }
 
 // DruidOpsRequestTypeNames returns a list of possible string values of DruidOpsRequestType.
@@ -64,6 +67,7 @@ func DruidOpsRequestTypeValues() []DruidOpsRequestType {
 		DruidOpsRequestTypeReconfigureTLS,
 		DruidOpsRequestTypeRotateAuth,
 		DruidOpsRequestTypeStorageMigration,
+		DruidOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _DruidOpsRequestTypeValue = map[string]DruidOpsRequestType{
 	"ReconfigureTLS":    DruidOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        DruidOpsRequestTypeRotateAuth,
 	"StorageMigration":  DruidOpsRequestTypeStorageMigration,
+	"Rollback":          DruidOpsRequestTypeRollback,
 }
 
 // ParseDruidOpsRequestType attempts to convert a string to a DruidOpsRequestType.
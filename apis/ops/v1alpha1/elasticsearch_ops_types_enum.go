@@ -29,6 +29,8 @@ const (
 	ElasticsearchOpsRequestTypeRotateAuth ElasticsearchOpsRequestType = "RotateAuth"
 	// ElasticsearchOpsRequestTypeStorageMigration is a ElasticsearchOpsRequestType of type StorageMigration.
 	ElasticsearchOpsRequestTypeStorageMigration ElasticsearchOpsRequestType = "StorageMigration"
+	// ElasticsearchOpsRequestTypeRollback is a ElasticsearchOpsRequestType of type Rollback.
+	ElasticsearchOpsRequestTypeRollback ElasticsearchOpsRequestType = "Rollback"
 )
 
 var ErrInvalidElasticsearchOpsRequestType = fmt.Errorf("not a valid ElasticsearchOpsRequestType, try [%s]", strings.Join(_ElasticsearchOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _ElasticsearchOpsRequestTypeNames = []string{
 	string(ElasticsearchOpsRequestTypeReconfigureTLS),
 	string(ElasticsearchOpsRequestTypeRotateAuth),
 	string(ElasticsearchOpsRequestTypeStorageMigration),
+	string(ElasticsearchOpsRequestTypeRollback),
 }
 
 // ElasticsearchOpsRequestTypeNames returns a list of possible string values of ElasticsearchOpsRequestType.
@@ -64,6 +67,7 @@ func ElasticsearchOpsRequestTypeValues() []ElasticsearchOpsRequestType {
 		ElasticsearchOpsRequestTypeReconfigureTLS,
 		ElasticsearchOpsRequestTypeRotateAuth,
 		ElasticsearchOpsRequestTypeStorageMigration,
+		ElasticsearchOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _ElasticsearchOpsRequestTypeValue = map[string]ElasticsearchOpsRequestType{
 	"ReconfigureTLS":    ElasticsearchOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        ElasticsearchOpsRequestTypeRotateAuth,
 	"StorageMigration":  ElasticsearchOpsRequestTypeStorageMigration,
+	"Rollback":          ElasticsearchOpsRequestTypeRollback,
 }
 
 // ParseElasticsearchOpsRequestType attempts to convert a string to a ElasticsearchOpsRequestType.
@@ -54,6 +54,12 @@ type HanaDBTLSSpec struct {
 type HanaDBOpsRequestSpec struct {
 	DatabaseRef core.LocalObjectReference `json:"databaseRef"`
 	Type        HanaDBOpsRequestType      `json:"type"`
+	// Specifies information necessary for upgrading HanaDB
+	UpdateVersion *HanaDBUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
+	// Specifies information necessary for horizontal scaling
+	HorizontalScaling *HanaDBHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
 	VerticalScaling *HanaDBVerticalScalingSpec `json:"verticalScaling,omitempty"`
 	// Specifies information necessary for volume expansion
@@ -64,6 +70,10 @@ type HanaDBOpsRequestSpec struct {
 	TLS *HanaDBTLSSpec `json:"tls,omitempty"`
 	// Specifies information necessary for configuring authSecret of the database
 	Authentication *AuthSpec `json:"authentication,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 	// Specifies information necessary for restarting database
 	Restart   *RestartSpec          `json:"restart,omitempty"`
 	Migration *StorageMigrationSpec `json:"migration,omitempty"`
@@ -74,6 +84,24 @@ type HanaDBOpsRequestSpec struct {
 	MaxRetries int32 `json:"maxRetries,omitempty"`
 }
 
+// HanaDBUpdateVersionSpec contains the update version information of a HanaDB database.
+// In SystemReplication mode the secondaries are updated first. The primary role is then
+// switched over to an updated secondary before the old primary is updated.
+type HanaDBUpdateVersionSpec struct {
+	// Specifies the target version name from catalog
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
+}
+
+// HanaDBHorizontalScalingSpec contains the horizontal scaling information of a HanaDB database.
+// Secondaries are registered to or unregistered from the system replication of the primary.
+type HanaDBHorizontalScalingSpec struct {
+	// Number of nodes of the system replication, including the primary.
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
 // HanaDBVerticalScalingSpec is the spec for HanaDB vertical scaling.
 type HanaDBVerticalScalingSpec struct {
 	HanaDB      *PodResources       `json:"hanadb,omitempty"`
@@ -91,8 +119,8 @@ type HanaDBVolumeExpansionSpec struct {
 	Mode   VolumeExpansionMode `json:"mode"`
 }
 
-// +kubebuilder:validation:Enum=VerticalScaling;VolumeExpansion;Restart;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration
-// ENUM(VerticalScaling, VolumeExpansion, Restart, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration)
+// +kubebuilder:validation:Enum=VerticalScaling;VolumeExpansion;Restart;Reconfigure;ReconfigureTLS;RotateAuth;StorageMigration;UpdateVersion;HorizontalScaling;Rollback
+// ENUM(VerticalScaling, VolumeExpansion, Restart, Reconfigure, ReconfigureTLS, RotateAuth, StorageMigration, UpdateVersion, HorizontalScaling, Rollback)
 type HanaDBOpsRequestType string
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
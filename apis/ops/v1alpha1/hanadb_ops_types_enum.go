@@ -25,6 +25,12 @@ const (
 	HanaDBOpsRequestTypeRotateAuth HanaDBOpsRequestType = "RotateAuth"
 	// HanaDBOpsRequestTypeStorageMigration is a HanaDBOpsRequestType of type StorageMigration.
 	HanaDBOpsRequestTypeStorageMigration HanaDBOpsRequestType = "StorageMigration"
+	// HanaDBOpsRequestTypeUpdateVersion is a HanaDBOpsRequestType of type UpdateVersion.
+	HanaDBOpsRequestTypeUpdateVersion HanaDBOpsRequestType = "UpdateVersion"
+	// HanaDBOpsRequestTypeHorizontalScaling is a HanaDBOpsRequestType of type HorizontalScaling.
+	HanaDBOpsRequestTypeHorizontalScaling HanaDBOpsRequestType = "HorizontalScaling"
+	// HanaDBOpsRequestTypeRollback is a HanaDBOpsRequestType of type Rollback.
+	HanaDBOpsRequestTypeRollback HanaDBOpsRequestType = "Rollback"
 )
 
 var ErrInvalidHanaDBOpsRequestType = fmt.Errorf("not a valid HanaDBOpsRequestType, try [%s]", strings.Join(_HanaDBOpsRequestTypeNames, ", "))
@@ -37,6 +43,9 @@ var _HanaDBOpsRequestTypeNames = []string{
 	string(HanaDBOpsRequestTypeReconfigureTLS),
 	string(HanaDBOpsRequestTypeRotateAuth),
 	string(HanaDBOpsRequestTypeStorageMigration),
+	string(HanaDBOpsRequestTypeUpdateVersion),
+	string(HanaDBOpsRequestTypeHorizontalScaling),
+	string(HanaDBOpsRequestTypeRollback),
 }
 
 // HanaDBOpsRequestTypeNames returns a list of possible string values of HanaDBOpsRequestType.
@@ -56,6 +65,9 @@ func HanaDBOpsRequestTypeValues() []HanaDBOpsRequestType {
 		HanaDBOpsRequestTypeReconfigureTLS,
 		HanaDBOpsRequestTypeRotateAuth,
 		HanaDBOpsRequestTypeStorageMigration,
+		HanaDBOpsRequestTypeUpdateVersion,
+		HanaDBOpsRequestTypeHorizontalScaling,
+		HanaDBOpsRequestTypeRollback,
 	}
 }
 
@@ -72,13 +84,16 @@ func (x HanaDBOpsRequestType) IsValid() bool {
 }
 
 var _HanaDBOpsRequestTypeValue = map[string]HanaDBOpsRequestType{
-	"VerticalScaling":  HanaDBOpsRequestTypeVerticalScaling,
-	"VolumeExpansion":  HanaDBOpsRequestTypeVolumeExpansion,
-	"Restart":          HanaDBOpsRequestTypeRestart,
-	"Reconfigure":      HanaDBOpsRequestTypeReconfigure,
-	"ReconfigureTLS":   HanaDBOpsRequestTypeReconfigureTLS,
-	"RotateAuth":       HanaDBOpsRequestTypeRotateAuth,
-	"StorageMigration": HanaDBOpsRequestTypeStorageMigration,
+	"VerticalScaling":   HanaDBOpsRequestTypeVerticalScaling,
+	"VolumeExpansion":   HanaDBOpsRequestTypeVolumeExpansion,
+	"Restart":           HanaDBOpsRequestTypeRestart,
+	"Reconfigure":       HanaDBOpsRequestTypeReconfigure,
+	"ReconfigureTLS":    HanaDBOpsRequestTypeReconfigureTLS,
+	"RotateAuth":        HanaDBOpsRequestTypeRotateAuth,
+	"StorageMigration":  HanaDBOpsRequestTypeStorageMigration,
+	"UpdateVersion":     HanaDBOpsRequestTypeUpdateVersion,
+	"HorizontalScaling": HanaDBOpsRequestTypeHorizontalScaling,
+	"Rollback":          HanaDBOpsRequestTypeRollback,
 }
 
 // ParseHanaDBOpsRequestType attempts to convert a string to a HanaDBOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	HazelcastOpsRequestTypeRotateAuth HazelcastOpsRequestType = "RotateAuth"
 	// HazelcastOpsRequestTypeStorageMigration is a HazelcastOpsRequestType of type StorageMigration.
 	HazelcastOpsRequestTypeStorageMigration HazelcastOpsRequestType = "StorageMigration"
+	// HazelcastOpsRequestTypeRollback is a HazelcastOpsRequestType of type Rollback.
+	HazelcastOpsRequestTypeRollback HazelcastOpsRequestType = "Rollback"
 )
 
 var ErrInvalidHazelcastOpsRequestType = fmt.Errorf("not a valid HazelcastOpsRequestType, try [%s]", strings.Join(_HazelcastOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _HazelcastOpsRequestTypeNames = []string{
 	string(HazelcastOpsRequestTypeReconfigureTLS),
 	string(HazelcastOpsRequestTypeRotateAuth),
 	string(HazelcastOpsRequestTypeStorageMigration),
+	string(HazelcastOpsRequestTypeRollback),
 }
 
 // HazelcastOpsRequestTypeNames returns a list of possible string values of HazelcastOpsRequestType.
@@ -64,6 +67,7 @@ func HazelcastOpsRequestTypeValues() []HazelcastOpsRequestType {
 		HazelcastOpsRequestTypeReconfigureTLS,
 		HazelcastOpsRequestTypeRotateAuth,
 		HazelcastOpsRequestTypeStorageMigration,
+		HazelcastOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _HazelcastOpsRequestTypeValue = map[string]HazelcastOpsRequestType{
 	"ReconfigureTLS":    HazelcastOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        HazelcastOpsRequestTypeRotateAuth,
 	"StorageMigration":  HazelcastOpsRequestTypeStorageMigration,
+	"Rollback":          HazelcastOpsRequestTypeRollback,
 }
 
 // ParseHazelcastOpsRequestType attempts to convert a string to a HazelcastOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	IgniteOpsRequestTypeRotateAuth IgniteOpsRequestType = "RotateAuth"
 	// IgniteOpsRequestTypeStorageMigration is a IgniteOpsRequestType of type StorageMigration.
 	IgniteOpsRequestTypeStorageMigration IgniteOpsRequestType = "StorageMigration"
+	// IgniteOpsRequestTypeRollback is a IgniteOpsRequestType of type Rollback.
+	IgniteOpsRequestTypeRollback IgniteOpsRequestType = "Rollback"
 )
 
 var ErrInvalidIgniteOpsRequestType = fmt.Errorf("not a valid IgniteOpsRequestType, try [%s]", strings.Join(_IgniteOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _IgniteOpsRequestTypeNames = []string{
 	string(IgniteOpsRequestTypeReconfigureTLS),
 	string(IgniteOpsRequestTypeRotateAuth),
 	string(IgniteOpsRequestTypeStorageMigration),
+	string(IgniteOpsRequestTypeRollback),
 }
 
 // IgniteOpsRequestTypeNames returns a list of possible string values of IgniteOpsRequestType.
@@ -64,6 +67,7 @@ func IgniteOpsRequestTypeValues() []IgniteOpsRequestType {
 		IgniteOpsRequestTypeReconfigureTLS,
 		IgniteOpsRequestTypeRotateAuth,
 		IgniteOpsRequestTypeStorageMigration,
+		IgniteOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _IgniteOpsRequestTypeValue = map[string]IgniteOpsRequestType{
 	"ReconfigureTLS":    IgniteOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        IgniteOpsRequestTypeRotateAuth,
 	"StorageMigration":  IgniteOpsRequestTypeStorageMigration,
+	"Rollback":          IgniteOpsRequestTypeRollback,
 }
 
 // ParseIgniteOpsRequestType attempts to convert a string to a IgniteOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	KafkaOpsRequestTypeRotateAuth KafkaOpsRequestType = "RotateAuth"
 	// KafkaOpsRequestTypeStorageMigration is a KafkaOpsRequestType of type StorageMigration.
 	KafkaOpsRequestTypeStorageMigration KafkaOpsRequestType = "StorageMigration"
+	// KafkaOpsRequestTypeRollback is a KafkaOpsRequestType of type Rollback.
+	KafkaOpsRequestTypeRollback KafkaOpsRequestType = "Rollback"
 )
 
 var ErrInvalidKafkaOpsRequestType = fmt.Errorf("not a valid KafkaOpsRequestType, try [%s]", strings.Join(_KafkaOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _KafkaOpsRequestTypeNames = []string{
 	string(KafkaOpsRequestTypeReconfigureTLS),
 	string(KafkaOpsRequestTypeRotateAuth),
 	string(KafkaOpsRequestTypeStorageMigration),
+	string(KafkaOpsRequestTypeRollback),
 }
 
 // KafkaOpsRequestTypeNames returns a list of possible string values of KafkaOpsRequestType.
@@ -64,6 +67,7 @@ func KafkaOpsRequestTypeValues() []KafkaOpsRequestType {
 		KafkaOpsRequestTypeReconfigureTLS,
 		KafkaOpsRequestTypeRotateAuth,
 		KafkaOpsRequestTypeStorageMigration,
+		KafkaOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _KafkaOpsRequestTypeValue = map[string]KafkaOpsRequestType{
 	"ReconfigureTLS":    KafkaOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        KafkaOpsRequestTypeRotateAuth,
 	"StorageMigration":  KafkaOpsRequestTypeStorageMigration,
+	"Rollback":          KafkaOpsRequestTypeRollback,
 }
 
 // ParseKafkaOpsRequestType attempts to convert a string to a KafkaOpsRequestType.
@@ -0,0 +1,290 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"kubedb.dev/apimachinery/apis"
+	"kubedb.dev/apimachinery/apis/autoscaling"
+	"kubedb.dev/apimachinery/apis/ops"
+	"kubedb.dev/apimachinery/crds"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+// DefaultMaintenanceWindowOpsTypes are the restart inducing ops request types a MaintenanceWindow
+// holds when spec.opsTypes is empty.
+var DefaultMaintenanceWindowOpsTypes = []string{
+	"UpdateVersion",
+	"VerticalScaling",
+	"VolumeExpansion",
+	"Restart",
+	"Reconfigure",
+	"ReconfigureTLS",
+	"RotateAuth",
+	"StorageMigration",
+}
+
+func (w MaintenanceWindow) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralMaintenanceWindow))
+}
+
+var _ apis.ResourceInfo = &MaintenanceWindow{}
+
+func (w MaintenanceWindow) ResourceFQN() string {
+	return fmt.Sprintf("%s.%s", ResourcePluralMaintenanceWindow, ops.GroupName)
+}
+
+func (w MaintenanceWindow) ResourceShortCode() string {
+	return ResourceCodeMaintenanceWindow
+}
+
+func (w MaintenanceWindow) ResourceKind() string {
+	return ResourceKindMaintenanceWindow
+}
+
+func (w MaintenanceWindow) ResourceSingular() string {
+	return ResourceSingularMaintenanceWindow
+}
+
+func (w MaintenanceWindow) ResourcePlural() string {
+	return ResourcePluralMaintenanceWindow
+}
+
+func (w MaintenanceWindow) ValidateSpecs() error {
+	if len(w.Spec.Windows) == 0 && len(w.Spec.Dates) == 0 {
+		return fmt.Errorf("at least one of spec.windows and spec.dates must be set")
+	}
+	if _, err := w.location(); err != nil {
+		return fmt.Errorf("spec.timeZone is invalid: %v", err)
+	}
+	for i, tw := range w.Spec.Windows {
+		if _, err := time.Parse("15:04", tw.Start); err != nil {
+			return fmt.Errorf("spec.windows[%d].start %q is not in HH:MM format", i, tw.Start)
+		}
+		if tw.Duration.Duration <= 0 || tw.Duration.Duration > 7*24*time.Hour {
+			return fmt.Errorf("spec.windows[%d].duration must be positive and at most 168h", i)
+		}
+	}
+	for i, dw := range w.Spec.Dates {
+		if !dw.End.After(dw.Start.Time) {
+			return fmt.Errorf("spec.dates[%d].end must be after start", i)
+		}
+	}
+	return w.Spec.DatabaseSelector.Validate()
+}
+
+// Validate checks the label selectors of the database selector.
+func (sel *OpsDatabaseSelector) Validate() error {
+	if sel == nil {
+		return nil
+	}
+	if _, err := metav1.LabelSelectorAsSelector(sel.NamespaceSelector); err != nil {
+		return fmt.Errorf("spec.databaseSelector.namespaceSelector is invalid: %v", err)
+	}
+	if _, err := metav1.LabelSelectorAsSelector(sel.Selector); err != nil {
+		return fmt.Errorf("spec.databaseSelector.selector is invalid: %v", err)
+	}
+	return nil
+}
+
+// IsClusterWide returns true if the window applies to every database without a more specific window.
+func (w MaintenanceWindow) IsClusterWide() bool {
+	return w.Spec.DatabaseSelector == nil
+}
+
+// Selects returns true if the window applies to a database of the given kind, with the given labels
+// and namespace labels.
+func (w MaintenanceWindow) Selects(kind string, nsLabels, dbLabels map[string]string) (bool, error) {
+	return w.Spec.DatabaseSelector.Matches(kind, nsLabels, dbLabels)
+}
+
+// Matches returns true if a database of the given kind, with the given labels and namespace labels,
+// is selected. A nil selector matches every database.
+func (sel *OpsDatabaseSelector) Matches(kind string, nsLabels, dbLabels map[string]string) (bool, error) {
+	if sel == nil {
+		return true, nil
+	}
+	if len(sel.Kinds) > 0 && !slices.Contains(sel.Kinds, kind) {
+		return false, nil
+	}
+	for _, m := range []struct {
+		s   *metav1.LabelSelector
+		set map[string]string
+	}{{sel.NamespaceSelector, nsLabels}, {sel.Selector, dbLabels}} {
+		if m.s == nil {
+			continue
+		}
+		s, err := metav1.LabelSelectorAsSelector(m.s)
+		if err != nil {
+			return false, err
+		}
+		if !s.Matches(labels.Set(m.set)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// HoldsOpsType returns true if ops requests of the given type wait for the window.
+func (w MaintenanceWindow) HoldsOpsType(opsType string) bool {
+	if len(w.Spec.OpsTypes) == 0 {
+		return slices.Contains(DefaultMaintenanceWindowOpsTypes, opsType)
+	}
+	return slices.Contains(w.Spec.OpsTypes, opsType)
+}
+
+// AppliesTo returns true if the window holds the given ops request, judged by its type, mode and
+// source. VerticalScaling in InPlace mode and Online VolumeExpansion keep the pods running and are
+// never held. The database selector is checked separately with Selects.
+func (w MaintenanceWindow) AppliesTo(req Accessor) bool {
+	if !w.HoldsOpsType(req.GetRequestType()) || KeepsPodsRunning(req) {
+		return false
+	}
+	return len(w.Spec.Sources) == 0 || slices.Contains(w.Spec.Sources, GetOpsRequestSource(req))
+}
+
+// KeepsPodsRunning returns true if the ops request changes the database without restarting its pods:
+// VerticalScaling in InPlace mode and VolumeExpansion in any mode but Offline.
+func KeepsPodsRunning(req Accessor) bool {
+	var field string
+	switch req.GetRequestType() {
+	case "VerticalScaling":
+		field = "verticalScaling"
+	case "VolumeExpansion":
+		field = "volumeExpansion"
+	default:
+		return false
+	}
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(req)
+	if err != nil {
+		return false
+	}
+	mode, _, _ := unstructured.NestedString(u, "spec", field, "mode")
+	if field == "verticalScaling" {
+		return mode == string(VerticalScalingModeInPlace)
+	}
+	return mode != string(VolumeExpansionModeOffline)
+}
+
+// supervisorGroupName is the API group of the Recommendations the auto ops recommender creates ops requests for.
+const supervisorGroupName = "supervisor.appscode.com"
+
+// GetOpsRequestSource returns the creator of an ops request from its LabelOpsRequestSource label or,
+// without the label, from its owner references.
+func GetOpsRequestSource(req metav1.Object) OpsRequestSource {
+	if src, ok := req.GetLabels()[LabelOpsRequestSource]; ok && src != "" {
+		return OpsRequestSource(src)
+	}
+	for _, ref := range req.GetOwnerReferences() {
+		gv, err := schema.ParseGroupVersion(ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		switch gv.Group {
+		case autoscaling.GroupName:
+			return OpsRequestSourceAutoscaler
+		case supervisorGroupName:
+			return OpsRequestSourceAutoOps
+		}
+	}
+	return OpsRequestSourceUser
+}
+
+func (w MaintenanceWindow) location() (*time.Location, error) {
+	if w.Spec.TimeZone == "" {
+		return time.UTC, nil
+	}
+	return time.LoadLocation(w.Spec.TimeZone)
+}
+
+// slots returns the slots that overlap the week before and the week after now.
+func (w MaintenanceWindow) slots(now time.Time) ([][2]time.Time, error) {
+	loc, err := w.location()
+	if err != nil {
+		return nil, err
+	}
+	var slots [][2]time.Time
+	local := now.In(loc)
+	for _, tw := range w.Spec.Windows {
+		start, err := time.Parse("15:04", tw.Start)
+		if err != nil {
+			return nil, err
+		}
+		for offset := -7; offset <= 7; offset++ {
+			day := local.AddDate(0, 0, offset)
+			if len(tw.Days) > 0 && !slices.Contains(tw.Days, MaintenanceDay(day.Weekday().String())) {
+				continue
+			}
+			from := time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), 0, 0, loc)
+			slots = append(slots, [2]time.Time{from, from.Add(tw.Duration.Duration)})
+		}
+	}
+	for _, dw := range w.Spec.Dates {
+		slots = append(slots, [2]time.Time{dw.Start.Time, dw.End.Time})
+	}
+	return slots, nil
+}
+
+// IsOpen returns whether a slot is open at now and, if so, when it closes. Overlapping slots are
+// not merged; the latest close time of the open slots is returned.
+func (w MaintenanceWindow) IsOpen(now time.Time) (bool, time.Time, error) {
+	slots, err := w.slots(now)
+	if err != nil {
+		return false, time.Time{}, err
+	}
+	var open bool
+	var closeAt time.Time
+	for _, s := range slots {
+		if !now.Before(s[0]) && now.Before(s[1]) {
+			open = true
+			if s[1].After(closeAt) {
+				closeAt = s[1]
+			}
+		}
+	}
+	return open, closeAt, nil
+}
+
+// NextOpenTime returns the start of the first slot after now, or nil if there is none within a week
+// and no later one-off date.
+func (w MaintenanceWindow) NextOpenTime(now time.Time) (*metav1.Time, error) {
+	slots, err := w.slots(now)
+	if err != nil {
+		return nil, err
+	}
+	var next *time.Time
+	for _, s := range slots {
+		if s[0].After(now) && (next == nil || s[0].Before(*next)) {
+			t := s[0]
+			next = &t
+		}
+	}
+	if next == nil {
+		return nil, nil
+	}
+	t := metav1.NewTime(*next)
+	return &t, nil
+}
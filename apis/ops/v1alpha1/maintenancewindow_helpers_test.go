@@ -0,0 +1,134 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceWindowAppliesTo(t *testing.T) {
+	request := func(typ MariaDBOpsRequestType, source OpsRequestSource, vsMode VerticalScalingMode, veMode VolumeExpansionMode) Accessor {
+		req := &MariaDBOpsRequest{}
+		req.Spec.Type = typ
+		if source != "" {
+			req.Labels = map[string]string{LabelOpsRequestSource: string(source)}
+		}
+		if vsMode != "" {
+			req.Spec.VerticalScaling = &MariaDBVerticalScalingSpec{Mode: vsMode}
+		}
+		if veMode != "" {
+			req.Spec.VolumeExpansion = &MariaDBVolumeExpansionSpec{Mode: veMode}
+		}
+		return req
+	}
+	owned := func(req Accessor, apiVersion string) Accessor {
+		req.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: apiVersion, Kind: "Owner", Name: "owner"}})
+		return req
+	}
+	window := func(opsTypes []string, sources ...OpsRequestSource) MaintenanceWindow {
+		return MaintenanceWindow{Spec: MaintenanceWindowSpec{OpsTypes: opsTypes, Sources: sources}}
+	}
+
+	tests := []struct {
+		name   string
+		window MaintenanceWindow
+		req    Accessor
+		want   bool
+	}{
+		{
+			name:   "default types hold a restart",
+			window: window(nil),
+			req:    request(MariaDBOpsRequestTypeRestart, "", "", ""),
+			want:   true,
+		},
+		{
+			name:   "default types skip horizontal scaling",
+			window: window(nil),
+			req:    request(MariaDBOpsRequestTypeHorizontalScaling, "", "", ""),
+			want:   false,
+		},
+		{
+			name:   "listed types only",
+			window: window([]string{"UpdateVersion"}),
+			req:    request(MariaDBOpsRequestTypeRestart, "", "", ""),
+			want:   false,
+		},
+		{
+			name:   "vertical scaling by restart",
+			window: window(nil),
+			req:    request(MariaDBOpsRequestTypeVerticalScaling, "", VerticalScalingModeRestart, ""),
+			want:   true,
+		},
+		{
+			name:   "vertical scaling in place is never held",
+			window: window([]string{"VerticalScaling"}),
+			req:    request(MariaDBOpsRequestTypeVerticalScaling, "", VerticalScalingModeInPlace, ""),
+			want:   false,
+		},
+		{
+			name:   "offline volume expansion",
+			window: window(nil),
+			req:    request(MariaDBOpsRequestTypeVolumeExpansion, "", "", VolumeExpansionModeOffline),
+			want:   true,
+		},
+		{
+			name:   "online volume expansion is never held",
+			window: window([]string{"VolumeExpansion"}),
+			req:    request(MariaDBOpsRequestTypeVolumeExpansion, "", "", VolumeExpansionModeOnline),
+			want:   false,
+		},
+		{
+			name:   "unlabelled requests are from User",
+			window: window(nil, OpsRequestSourceUser),
+			req:    request(MariaDBOpsRequestTypeRestart, "", "", ""),
+			want:   true,
+		},
+		{
+			name:   "other sources are not held",
+			window: window(nil, OpsRequestSourceAutoscaler),
+			req:    request(MariaDBOpsRequestTypeRestart, OpsRequestSourceUser, "", ""),
+			want:   false,
+		},
+		{
+			name:   "owned by an autoscaler",
+			window: window(nil, OpsRequestSourceAutoscaler),
+			req:    owned(request(MariaDBOpsRequestTypeRestart, "", "", ""), "autoscaling.kubedb.com/v1alpha1"),
+			want:   true,
+		},
+		{
+			name:   "owned by a recommendation",
+			window: window(nil, OpsRequestSourceAutoOps),
+			req:    owned(request(MariaDBOpsRequestTypeRestart, "", "", ""), "supervisor.appscode.com/v1alpha1"),
+			want:   true,
+		},
+		{
+			name:   "autoscaler source",
+			window: window(nil, OpsRequestSourceAutoscaler),
+			req:    request(MariaDBOpsRequestTypeVerticalScaling, OpsRequestSourceAutoscaler, VerticalScalingModeRestart, ""),
+			want:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.window.AppliesTo(tt.req); got != tt.want {
+				t.Errorf("AppliesTo() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,179 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	ResourceCodeMaintenanceWindow     = "mw"
+	ResourceKindMaintenanceWindow     = "MaintenanceWindow"
+	ResourceSingularMaintenanceWindow = "maintenancewindow"
+	ResourcePluralMaintenanceWindow   = "maintenancewindows"
+)
+
+// MaintenanceWindow restricts when disruptive ops requests may run. A gated ops request stays
+// Pending, with the WaitingForMaintenanceWindow condition, until a window that selects its
+// database opens.
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=maintenancewindows,singular=maintenancewindow,scope=Cluster,shortName=mw,categories={ops,kubedb,appscode}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Open",type="boolean",JSONPath=".status.open"
+// +kubebuilder:printcolumn:name="Next",type="string",JSONPath=".status.nextOpenTime"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type MaintenanceWindow struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              MaintenanceWindowSpec   `json:"spec,omitempty"`
+	Status            MaintenanceWindowStatus `json:"status,omitempty"`
+}
+
+// MaintenanceWindowSpec is the spec for MaintenanceWindow
+type MaintenanceWindowSpec struct {
+	// DatabaseSelector attaches the window to the selected databases. A window without a selector
+	// applies to every database in the cluster. When both kinds of windows exist, a database is
+	// governed only by the windows that select it.
+	// +optional
+	DatabaseSelector *OpsDatabaseSelector `json:"databaseSelector,omitempty"`
+	// Windows are the recurring weekly time slots.
+	// +optional
+	Windows []MaintenanceTimeWindow `json:"windows,omitempty"`
+	// Dates are one-off time slots, e.g. for an announced maintenance.
+	// +optional
+	Dates []MaintenanceDateWindow `json:"dates,omitempty"`
+	// TimeZone is the IANA time zone the windows are in, e.g. Europe/Berlin.
+	// +kubebuilder:default="UTC"
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+	// OpsTypes are the ops request types held until a window opens. The restart inducing types in
+	// DefaultMaintenanceWindowOpsTypes are used when empty. VerticalScaling in InPlace mode and
+	// Online VolumeExpansion are never held.
+	// +optional
+	OpsTypes []string `json:"opsTypes,omitempty"`
+	// Sources are the creators of ops requests the window applies to. All sources when empty.
+	// +optional
+	Sources []OpsRequestSource `json:"sources,omitempty"`
+	// OnWindowClose decides what happens to an ops request that is still running when the window closes.
+	// Hold finishes the current step and waits for the next window with the HeldByMaintenanceWindow
+	// condition; Cancel fails the ops request with the CancelledByMaintenanceWindow condition at the
+	// next safe point. Cancel wins if several closed windows hold the ops request.
+	// +kubebuilder:default="Hold"
+	// +optional
+	OnWindowClose MaintenanceWindowClosePolicy `json:"onWindowClose,omitempty"`
+}
+
+// OpsDatabaseSelector selects databases by kind, namespace and labels. All set fields must match.
+type OpsDatabaseSelector struct {
+	// Kinds are the database kinds, e.g. Postgres. All kinds when empty.
+	// +optional
+	Kinds []string `json:"kinds,omitempty"`
+	// NamespaceSelector selects the namespaces of the databases.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// Selector selects the databases by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// MaintenanceTimeWindow is a weekly recurring time slot.
+type MaintenanceTimeWindow struct {
+	// Days are the days of the week the slot opens on. Every day when empty.
+	// +optional
+	Days []MaintenanceDay `json:"days,omitempty"`
+	// Start is the time of day the slot opens, in HH:MM.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	Start string `json:"start"`
+	// Duration is how long the slot stays open.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// MaintenanceDateWindow is a one-off time slot.
+type MaintenanceDateWindow struct {
+	Start metav1.Time `json:"start"`
+	End   metav1.Time `json:"end"`
+}
+
+// +kubebuilder:validation:Enum=Sunday;Monday;Tuesday;Wednesday;Thursday;Friday;Saturday
+type MaintenanceDay string
+
+const (
+	MaintenanceDaySunday    MaintenanceDay = "Sunday"
+	MaintenanceDayMonday    MaintenanceDay = "Monday"
+	MaintenanceDayTuesday   MaintenanceDay = "Tuesday"
+	MaintenanceDayWednesday MaintenanceDay = "Wednesday"
+	MaintenanceDayThursday  MaintenanceDay = "Thursday"
+	MaintenanceDayFriday    MaintenanceDay = "Friday"
+	MaintenanceDaySaturday  MaintenanceDay = "Saturday"
+)
+
+// OpsRequestSource is the creator of an ops request. It is read from the LabelOpsRequestSource label;
+// without the label, ops requests owned by an autoscaler are from Autoscaler, ops requests owned by a
+// supervisor Recommendation are from AutoOps, and the others are from User.
+// +kubebuilder:validation:Enum=User;Autoscaler;AutoOps
+type OpsRequestSource string
+
+const (
+	OpsRequestSourceUser       OpsRequestSource = "User"
+	OpsRequestSourceAutoscaler OpsRequestSource = "Autoscaler"
+	OpsRequestSourceAutoOps    OpsRequestSource = "AutoOps"
+)
+
+// +kubebuilder:validation:Enum=Hold;Cancel
+type MaintenanceWindowClosePolicy string
+
+const (
+	MaintenanceWindowClosePolicyHold   MaintenanceWindowClosePolicy = "Hold"
+	MaintenanceWindowClosePolicyCancel MaintenanceWindowClosePolicy = "Cancel"
+)
+
+// MaintenanceWindowStatus is the status for MaintenanceWindow
+type MaintenanceWindowStatus struct {
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Open is true while a slot is open.
+	// +optional
+	Open bool `json:"open,omitempty"`
+	// CloseTime is when the open slot closes.
+	// +optional
+	CloseTime *metav1.Time `json:"closeTime,omitempty"`
+	// NextOpenTime is when the next slot opens.
+	// +optional
+	NextOpenTime *metav1.Time `json:"nextOpenTime,omitempty"`
+	// Conditions applied to the window.
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// MaintenanceWindowList is a list of MaintenanceWindows
+type MaintenanceWindowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of MaintenanceWindow CRD objects
+	Items []MaintenanceWindow `json:"items,omitempty"`
+}
@@ -29,6 +29,10 @@ const (
 	MariaDBOpsRequestTypeRotateAuth MariaDBOpsRequestType = "RotateAuth"
 	// MariaDBOpsRequestTypeStorageMigration is a MariaDBOpsRequestType of type StorageMigration.
 	MariaDBOpsRequestTypeStorageMigration MariaDBOpsRequestType = "StorageMigration"
+	// MariaDBOpsRequestTypeRollback is a MariaDBOpsRequestType of type Rollback.
+	MariaDBOpsRequestTypeRollback MariaDBOpsRequestType = "Rollback"
+	// MariaDBOpsRequestTypeSwitchover is a MariaDBOpsRequestType of type Switchover.
+	MariaDBOpsRequestTypeSwitchover MariaDBOpsRequestType = "Switchover"
 )
 
 var ErrInvalidMariaDBOpsRequestType = fmt.Errorf("not a valid MariaDBOpsRequestType, try [%s]", strings.Join(_MariaDBOpsRequestTypeNames, ", "))
@@ -43,6 +47,8 @@ var _MariaDBOpsRequestTypeNames = []string{
 	string(MariaDBOpsRequestTypeReconfigureTLS),
 	string(MariaDBOpsRequestTypeRotateAuth),
 	string(MariaDBOpsRequestTypeStorageMigration),
+	string(MariaDBOpsRequestTypeRollback),
+	string(MariaDBOpsRequestTypeSwitchover),
 }
 
 // MariaDBOpsRequestTypeNames returns a list of possible string values of MariaDBOpsRequestType.
@@ -64,6 +70,8 @@ func MariaDBOpsRequestTypeValues() []MariaDBOpsRequestType {
 		MariaDBOpsRequestTypeReconfigureTLS,
 		MariaDBOpsRequestTypeRotateAuth,
 		MariaDBOpsRequestTypeStorageMigration,
+		MariaDBOpsRequestTypeRollback,
+		MariaDBOpsRequestTypeSwitchover,
 	}
 }
 
@@ -89,6 +97,8 @@ var _MariaDBOpsRequestTypeValue = map[string]MariaDBOpsRequestType{
 	"ReconfigureTLS":    MariaDBOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        MariaDBOpsRequestTypeRotateAuth,
 	"StorageMigration":  MariaDBOpsRequestTypeStorageMigration,
+	"Rollback":          MariaDBOpsRequestTypeRollback,
+	"Switchover":        MariaDBOpsRequestTypeSwitchover,
 }
 
 // ParseMariaDBOpsRequestType attempts to convert a string to a MariaDBOpsRequestType.
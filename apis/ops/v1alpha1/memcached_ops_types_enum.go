@@ -27,6 +27,10 @@ const (
 	MemcachedOpsRequestTypeReconfigureTLS MemcachedOpsRequestType = "ReconfigureTLS"
 	// MemcachedOpsRequestTypeRotateAuth is a MemcachedOpsRequestType of type RotateAuth.
 	MemcachedOpsRequestTypeRotateAuth MemcachedOpsRequestType = "RotateAuth"
+	// MemcachedOpsRequestTypeRollback is a MemcachedOpsRequestType of type Rollback.
+	MemcachedOpsRequestTypeRollback MemcachedOpsRequestType = "Rollback"
+	// MemcachedOpsRequestTypeStorageMigration is a MemcachedOpsRequestType of type StorageMigration.
+	MemcachedOpsRequestTypeStorageMigration MemcachedOpsRequestType = "StorageMigration"
 )
 
 var ErrInvalidMemcachedOpsRequestType = fmt.Errorf("not a valid MemcachedOpsRequestType, try [%s]", strings.Join(_MemcachedOpsRequestTypeNames, ", "))
@@ -40,6 +44,8 @@ var _MemcachedOpsRequestTypeNames = []string{
 	string(MemcachedOpsRequestTypeReconfigure),
 	string(MemcachedOpsRequestTypeReconfigureTLS),
 	string(MemcachedOpsRequestTypeRotateAuth),
+	string(MemcachedOpsRequestTypeRollback),
+	string(MemcachedOpsRequestTypeStorageMigration),
 }
 
 // MemcachedOpsRequestTypeNames returns a list of possible string values of MemcachedOpsRequestType.
@@ -60,6 +66,8 @@ func MemcachedOpsRequestTypeValues() []MemcachedOpsRequestType {
 		MemcachedOpsRequestTypeReconfigure,
 		MemcachedOpsRequestTypeReconfigureTLS,
 		MemcachedOpsRequestTypeRotateAuth,
+		MemcachedOpsRequestTypeRollback,
+		MemcachedOpsRequestTypeStorageMigration,
 	}
 }
 
@@ -84,6 +92,8 @@ var _MemcachedOpsRequestTypeValue = map[string]MemcachedOpsRequestType{
 	"Reconfigure":       MemcachedOpsRequestTypeReconfigure,
 	"ReconfigureTLS":    MemcachedOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        MemcachedOpsRequestTypeRotateAuth,
+	"Rollback":          MemcachedOpsRequestTypeRollback,
+	"StorageMigration":  MemcachedOpsRequestTypeStorageMigration,
 }
 
 // ParseMemcachedOpsRequestType attempts to convert a string to a MemcachedOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	MilvusOpsRequestTypeRotateAuth MilvusOpsRequestType = "RotateAuth"
 	// MilvusOpsRequestTypeStorageMigration is a MilvusOpsRequestType of type StorageMigration.
 	MilvusOpsRequestTypeStorageMigration MilvusOpsRequestType = "StorageMigration"
+	// MilvusOpsRequestTypeRollback is a MilvusOpsRequestType of type Rollback.
+	MilvusOpsRequestTypeRollback MilvusOpsRequestType = "Rollback"
 )
 
 var ErrInvalidMilvusOpsRequestType = fmt.Errorf("not a valid MilvusOpsRequestType, try [%s]", strings.Join(_MilvusOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _MilvusOpsRequestTypeNames = []string{
 	string(MilvusOpsRequestTypeReconfigureTLS),
 	string(MilvusOpsRequestTypeRotateAuth),
 	string(MilvusOpsRequestTypeStorageMigration),
+	string(MilvusOpsRequestTypeRollback),
 }
 
 // MilvusOpsRequestTypeNames returns a list of possible string values of MilvusOpsRequestType.
@@ -64,6 +67,7 @@ func MilvusOpsRequestTypeValues() []MilvusOpsRequestType {
 		MilvusOpsRequestTypeReconfigureTLS,
 		MilvusOpsRequestTypeRotateAuth,
 		MilvusOpsRequestTypeStorageMigration,
+		MilvusOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _MilvusOpsRequestTypeValue = map[string]MilvusOpsRequestType{
 	"ReconfigureTLS":    MilvusOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        MilvusOpsRequestTypeRotateAuth,
 	"StorageMigration":  MilvusOpsRequestTypeStorageMigration,
+	"Rollback":          MilvusOpsRequestTypeRollback,
 }
 
 // ParseMilvusOpsRequestType attempts to convert a string to a MilvusOpsRequestType.
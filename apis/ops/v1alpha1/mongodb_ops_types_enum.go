@@ -33,6 +33,10 @@ const (
 	MongoDBOpsRequestTypeHorizons MongoDBOpsRequestType = "Horizons"
 	// MongoDBOpsRequestTypeStorageMigration is a MongoDBOpsRequestType of type StorageMigration.
 	MongoDBOpsRequestTypeStorageMigration MongoDBOpsRequestType = "StorageMigration"
+	// MongoDBOpsRequestTypeRollback is a MongoDBOpsRequestType of type Rollback.
+	MongoDBOpsRequestTypeRollback MongoDBOpsRequestType = "Rollback"
+	// MongoDBOpsRequestTypeSwitchover is a MongoDBOpsRequestType of type Switchover.
+	MongoDBOpsRequestTypeSwitchover MongoDBOpsRequestType = "Switchover"
 )
 
 var ErrInvalidMongoDBOpsRequestType = fmt.Errorf("not a valid MongoDBOpsRequestType, try [%s]", strings.Join(_MongoDBOpsRequestTypeNames, ", "))
@@ -49,6 +53,8 @@ var _MongoDBOpsRequestTypeNames = []string{
 	string(MongoDBOpsRequestTypeRotateAuth),
 	string(MongoDBOpsRequestTypeHorizons),
 	string(MongoDBOpsRequestTypeStorageMigration),
+	string(MongoDBOpsRequestTypeRollback),
+	string(MongoDBOpsRequestTypeSwitchover),
 }
 
 // MongoDBOpsRequestTypeNames returns a list of possible string values of MongoDBOpsRequestType.
@@ -72,6 +78,8 @@ func MongoDBOpsRequestTypeValues() []MongoDBOpsRequestType {
 		MongoDBOpsRequestTypeRotateAuth,
 		MongoDBOpsRequestTypeHorizons,
 		MongoDBOpsRequestTypeStorageMigration,
+		MongoDBOpsRequestTypeRollback,
+		MongoDBOpsRequestTypeSwitchover,
 	}
 }
 
@@ -99,6 +107,8 @@ var _MongoDBOpsRequestTypeValue = map[string]MongoDBOpsRequestType{
 	"RotateAuth":        MongoDBOpsRequestTypeRotateAuth,
 	"Horizons":          MongoDBOpsRequestTypeHorizons,
 	"StorageMigration":  MongoDBOpsRequestTypeStorageMigration,
+	"Rollback":          MongoDBOpsRequestTypeRollback,
+	"Switchover":        MongoDBOpsRequestTypeSwitchover,
 }
 
 // ParseMongoDBOpsRequestType attempts to convert a string to a MongoDBOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	MSSQLServerOpsRequestTypeRotateAuth MSSQLServerOpsRequestType = "RotateAuth"
 	// MSSQLServerOpsRequestTypeStorageMigration is a MSSQLServerOpsRequestType of type StorageMigration.
 	MSSQLServerOpsRequestTypeStorageMigration MSSQLServerOpsRequestType = "StorageMigration"
+	// MSSQLServerOpsRequestTypeRollback is a MSSQLServerOpsRequestType of type Rollback.
+	MSSQLServerOpsRequestTypeRollback MSSQLServerOpsRequestType = "Rollback"
 )
 
 var ErrInvalidMSSQLServerOpsRequestType = fmt.Errorf("not a valid MSSQLServerOpsRequestType, try [%s]", strings.Join(_MSSQLServerOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _MSSQLServerOpsRequestTypeNames = []string{
 	string(MSSQLServerOpsRequestTypeReconfigureTLS),
 	string(MSSQLServerOpsRequestTypeRotateAuth),
 	string(MSSQLServerOpsRequestTypeStorageMigration),
+	string(MSSQLServerOpsRequestTypeRollback),
 }
 
 // MSSQLServerOpsRequestTypeNames returns a list of possible string values of MSSQLServerOpsRequestType.
@@ -64,6 +67,7 @@ func MSSQLServerOpsRequestTypeValues() []MSSQLServerOpsRequestType {
 		MSSQLServerOpsRequestTypeReconfigureTLS,
 		MSSQLServerOpsRequestTypeRotateAuth,
 		MSSQLServerOpsRequestTypeStorageMigration,
+		MSSQLServerOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _MSSQLServerOpsRequestTypeValue = map[string]MSSQLServerOpsRequestType{
 	"ReconfigureTLS":    MSSQLServerOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        MSSQLServerOpsRequestTypeRotateAuth,
 	"StorageMigration":  MSSQLServerOpsRequestTypeStorageMigration,
+	"Rollback":          MSSQLServerOpsRequestTypeRollback,
 }
 
 // ParseMSSQLServerOpsRequestType attempts to convert a string to a MSSQLServerOpsRequestType.
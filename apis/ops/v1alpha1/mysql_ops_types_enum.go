@@ -31,6 +31,10 @@ const (
 	MySQLOpsRequestTypeReplicationModeTransformation MySQLOpsRequestType = "ReplicationModeTransformation"
 	// MySQLOpsRequestTypeStorageMigration is a MySQLOpsRequestType of type StorageMigration.
 	MySQLOpsRequestTypeStorageMigration MySQLOpsRequestType = "StorageMigration"
+	// MySQLOpsRequestTypeRollback is a MySQLOpsRequestType of type Rollback.
+	MySQLOpsRequestTypeRollback MySQLOpsRequestType = "Rollback"
+	// MySQLOpsRequestTypeSwitchover is a MySQLOpsRequestType of type Switchover.
+	MySQLOpsRequestTypeSwitchover MySQLOpsRequestType = "Switchover"
 )
 
 var ErrInvalidMySQLOpsRequestType = fmt.Errorf("not a valid MySQLOpsRequestType, try [%s]", strings.Join(_MySQLOpsRequestTypeNames, ", "))
@@ -46,6 +50,8 @@ var _MySQLOpsRequestTypeNames = []string{
 	string(MySQLOpsRequestTypeRotateAuth),
 	string(MySQLOpsRequestTypeReplicationModeTransformation),
 	string(MySQLOpsRequestTypeStorageMigration),
+	string(MySQLOpsRequestTypeRollback),
+	string(MySQLOpsRequestTypeSwitchover),
 }
 
 // MySQLOpsRequestTypeNames returns a list of possible string values of MySQLOpsRequestType.
@@ -68,6 +74,8 @@ func MySQLOpsRequestTypeValues() []MySQLOpsRequestType {
 		MySQLOpsRequestTypeRotateAuth,
 		MySQLOpsRequestTypeReplicationModeTransformation,
 		MySQLOpsRequestTypeStorageMigration,
+		MySQLOpsRequestTypeRollback,
+		MySQLOpsRequestTypeSwitchover,
 	}
 }
 
@@ -94,6 +102,8 @@ var _MySQLOpsRequestTypeValue = map[string]MySQLOpsRequestType{
 	"RotateAuth":                    MySQLOpsRequestTypeRotateAuth,
 	"ReplicationModeTransformation": MySQLOpsRequestTypeReplicationModeTransformation,
 	"StorageMigration":              MySQLOpsRequestTypeStorageMigration,
+	"Rollback":                      MySQLOpsRequestTypeRollback,
+	"Switchover":                    MySQLOpsRequestTypeSwitchover,
 }
 
 // ParseMySQLOpsRequestType attempts to convert a string to a MySQLOpsRequestType.
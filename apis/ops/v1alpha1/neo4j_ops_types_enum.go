@@ -29,6 +29,8 @@ const (
 	Neo4jOpsRequestTypeUpdateVersion Neo4jOpsRequestType = "UpdateVersion"
 	// Neo4jOpsRequestTypeStorageMigration is a Neo4jOpsRequestType of type StorageMigration.
 	Neo4jOpsRequestTypeStorageMigration Neo4jOpsRequestType = "StorageMigration"
+	// Neo4jOpsRequestTypeRollback is a Neo4jOpsRequestType of type Rollback.
+	Neo4jOpsRequestTypeRollback Neo4jOpsRequestType = "Rollback"
 )
 
 var ErrInvalidNeo4jOpsRequestType = fmt.Errorf("not a valid Neo4jOpsRequestType, try [%s]", strings.Join(_Neo4jOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _Neo4jOpsRequestTypeNames = []string{
 	string(Neo4jOpsRequestTypeVolumeExpansion),
 	string(Neo4jOpsRequestTypeUpdateVersion),
 	string(Neo4jOpsRequestTypeStorageMigration),
+	string(Neo4jOpsRequestTypeRollback),
 }
 
 // Neo4jOpsRequestTypeNames returns a list of possible string values of Neo4jOpsRequestType.
@@ -64,6 +67,7 @@ func Neo4jOpsRequestTypeValues() []Neo4jOpsRequestType {
 		Neo4jOpsRequestTypeVolumeExpansion,
 		Neo4jOpsRequestTypeUpdateVersion,
 		Neo4jOpsRequestTypeStorageMigration,
+		Neo4jOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _Neo4jOpsRequestTypeValue = map[string]Neo4jOpsRequestType{
 	"VolumeExpansion":   Neo4jOpsRequestTypeVolumeExpansion,
 	"UpdateVersion":     Neo4jOpsRequestTypeUpdateVersion,
 	"StorageMigration":  Neo4jOpsRequestTypeStorageMigration,
+	"Rollback":          Neo4jOpsRequestTypeRollback,
 }
 
 // ParseNeo4jOpsRequestType attempts to convert a string to a Neo4jOpsRequestType.
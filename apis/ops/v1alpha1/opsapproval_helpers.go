@@ -0,0 +1,89 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	"kubedb.dev/apimachinery/apis"
+	"kubedb.dev/apimachinery/apis/ops"
+	"kubedb.dev/apimachinery/crds"
+
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+func (a OpsApproval) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralOpsApproval))
+}
+
+var _ apis.ResourceInfo = &OpsApproval{}
+
+func (a OpsApproval) ResourceFQN() string {
+	return fmt.Sprintf("%s.%s", ResourcePluralOpsApproval, ops.GroupName)
+}
+
+func (a OpsApproval) ResourceShortCode() string {
+	return ResourceCodeOpsApproval
+}
+
+func (a OpsApproval) ResourceKind() string {
+	return ResourceKindOpsApproval
+}
+
+func (a OpsApproval) ResourceSingular() string {
+	return ResourceSingularOpsApproval
+}
+
+func (a OpsApproval) ResourcePlural() string {
+	return ResourcePluralOpsApproval
+}
+
+func (a OpsApproval) ValidateSpecs() error {
+	ref := a.Spec.OpsRequestRef
+	if ref.APIGroup != nil && *ref.APIGroup != ops.GroupName {
+		return fmt.Errorf("spec.opsRequestRef.apiGroup must be %s", ops.GroupName)
+	}
+	if !strings.HasSuffix(ref.Kind, "OpsRequest") || ref.Kind == "OpsRequest" {
+		return fmt.Errorf("spec.opsRequestRef.kind %q is not an ops request kind", ref.Kind)
+	}
+	if ref.Name == "" {
+		return fmt.Errorf("spec.opsRequestRef.name is required")
+	}
+	return nil
+}
+
+// DatabaseKind returns the kind of the database the referred ops request belongs to, e.g. Postgres
+// for a PostgresOpsRequest.
+func (a OpsApproval) DatabaseKind() string {
+	return strings.TrimSuffix(a.Spec.OpsRequestRef.Kind, "OpsRequest")
+}
+
+// Record returns the audit trail entry of the approval for the ops request status.
+func (a OpsApproval) Record() OpsApprovalRecord {
+	r := OpsApprovalRecord{
+		Name:     a.Name,
+		Decision: a.Spec.Decision,
+		Comment:  a.Spec.Comment,
+		Policies: a.Status.Policies,
+		Time:     a.CreationTimestamp,
+	}
+	if a.Spec.Approver != nil {
+		r.Approver = *a.Spec.Approver
+	}
+	return r
+}
@@ -0,0 +1,110 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	ResourceCodeOpsApproval     = "oa"
+	ResourceKindOpsApproval     = "OpsApproval"
+	ResourceSingularOpsApproval = "opsapproval"
+	ResourcePluralOpsApproval   = "opsapprovals"
+)
+
+// OpsApproval records the decision of an approver on an ops request waiting for approval. The
+// approver is taken from the identity that created the object, so it can not be forged.
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=opsapprovals,singular=opsapproval,shortName=oa,categories={ops,kubedb,appscode}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=".spec.opsRequestRef.kind"
+// +kubebuilder:printcolumn:name="OpsRequest",type="string",JSONPath=".spec.opsRequestRef.name"
+// +kubebuilder:printcolumn:name="Decision",type="string",JSONPath=".spec.decision"
+// +kubebuilder:printcolumn:name="Approver",type="string",JSONPath=".spec.approver.username"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type OpsApproval struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OpsApprovalSpec   `json:"spec,omitempty"`
+	Status            OpsApprovalStatus `json:"status,omitempty"`
+}
+
+// OpsApprovalSpec is the spec for OpsApproval
+type OpsApprovalSpec struct {
+	// OpsRequestRef refers to the ops request, in the same namespace, e.g. kind PostgresOpsRequest.
+	OpsRequestRef core.TypedLocalObjectReference `json:"opsRequestRef"`
+	// Decision approves or denies the ops request.
+	Decision OpsApprovalDecision `json:"decision"`
+	// Comment explains the decision.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+	// Approver is the identity that created the OpsApproval. It is set by the webhook.
+	// +optional
+	Approver *OpsApprover `json:"approver,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Approve;Deny
+type OpsApprovalDecision string
+
+const (
+	OpsApprovalDecisionApprove OpsApprovalDecision = "Approve"
+	OpsApprovalDecisionDeny    OpsApprovalDecision = "Deny"
+)
+
+// OpsApprover is the identity of an approver.
+type OpsApprover struct {
+	Username string `json:"username"`
+	// +optional
+	UID string `json:"uid,omitempty"`
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// OpsApprovalStatus is the status for OpsApproval
+type OpsApprovalStatus struct {
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Policies are the OpsApprovalPolicies that permitted the approver.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+	// Recorded is true once the decision is added to the approvals of the ops request.
+	// +optional
+	Recorded bool `json:"recorded,omitempty"`
+	// Conditions applied to the approval.
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpsApprovalList is a list of OpsApprovals
+type OpsApprovalList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of OpsApproval CRD objects
+	Items []OpsApproval `json:"items,omitempty"`
+}
@@ -0,0 +1,144 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	"kubedb.dev/apimachinery/apis"
+	"kubedb.dev/apimachinery/apis/ops"
+	"kubedb.dev/apimachinery/crds"
+
+	rbac "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+func (p OpsApprovalPolicy) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralOpsApprovalPolicy))
+}
+
+var _ apis.ResourceInfo = &OpsApprovalPolicy{}
+
+func (p OpsApprovalPolicy) ResourceFQN() string {
+	return fmt.Sprintf("%s.%s", ResourcePluralOpsApprovalPolicy, ops.GroupName)
+}
+
+func (p OpsApprovalPolicy) ResourceShortCode() string {
+	return ResourceCodeOpsApprovalPolicy
+}
+
+func (p OpsApprovalPolicy) ResourceKind() string {
+	return ResourceKindOpsApprovalPolicy
+}
+
+func (p OpsApprovalPolicy) ResourceSingular() string {
+	return ResourceSingularOpsApprovalPolicy
+}
+
+func (p OpsApprovalPolicy) ResourcePlural() string {
+	return ResourcePluralOpsApprovalPolicy
+}
+
+func (p OpsApprovalPolicy) ValidateSpecs() error {
+	if len(p.Spec.Approvers) == 0 {
+		return fmt.Errorf("spec.approvers must not be empty")
+	}
+	for i, s := range p.Spec.Approvers {
+		if s.Name == "" {
+			return fmt.Errorf("spec.approvers[%d].name is required", i)
+		}
+		switch s.Kind {
+		case rbac.UserKind, rbac.GroupKind:
+		case rbac.ServiceAccountKind:
+			if s.Namespace == "" {
+				return fmt.Errorf("spec.approvers[%d].namespace is required for kind ServiceAccount", i)
+			}
+		default:
+			return fmt.Errorf("spec.approvers[%d].kind %q is not one of User, Group and ServiceAccount", i, s.Kind)
+		}
+	}
+	if p.Spec.MinApprovals < 0 {
+		return fmt.Errorf("spec.minApprovals must not be negative")
+	}
+	return p.Spec.DatabaseSelector.Validate()
+}
+
+// Selects returns true if the policy applies to a database of the given kind, with the given labels
+// and namespace labels.
+func (p OpsApprovalPolicy) Selects(kind string, nsLabels, dbLabels map[string]string) (bool, error) {
+	return p.Spec.DatabaseSelector.Matches(kind, nsLabels, dbLabels)
+}
+
+// AppliesTo returns true if the given ops request needs approval under the policy, judged by its
+// type and source. The database selector is checked separately with Selects.
+func (p OpsApprovalPolicy) AppliesTo(req Accessor) bool {
+	if len(p.Spec.OpsTypes) > 0 && !slices.Contains(p.Spec.OpsTypes, req.GetRequestType()) {
+		return false
+	}
+	return len(p.Spec.Sources) == 0 || slices.Contains(p.Spec.Sources, GetOpsRequestSource(req))
+}
+
+// GetMinApprovals returns the number of approvals an ops request needs, defaulting to 1.
+func (p OpsApprovalPolicy) GetMinApprovals() int32 {
+	if p.Spec.MinApprovals < 1 {
+		return 1
+	}
+	return p.Spec.MinApprovals
+}
+
+// IsApprover returns true if the given identity is one of the approvers of the policy.
+func (p OpsApprovalPolicy) IsApprover(approver OpsApprover) bool {
+	for _, s := range p.Spec.Approvers {
+		switch s.Kind {
+		case rbac.UserKind:
+			if s.Name == approver.Username {
+				return true
+			}
+		case rbac.GroupKind:
+			if slices.Contains(approver.Groups, s.Name) {
+				return true
+			}
+		case rbac.ServiceAccountKind:
+			if fmt.Sprintf("system:serviceaccount:%s:%s", s.Namespace, s.Name) == approver.Username {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ApprovalPhase returns the phase an ops request reaches under the policy with the given approvals.
+// A single denial from an approver of the policy denies the request. Otherwise, it is approved once
+// MinApprovals distinct approvers of the policy approved it.
+func (p OpsApprovalPolicy) ApprovalPhase(records []OpsApprovalRecord) OpsRequestPhase {
+	approvers := sets.New[string]()
+	for _, r := range records {
+		if !slices.Contains(r.Policies, p.Name) || !p.IsApprover(r.Approver) {
+			continue
+		}
+		if r.Decision == OpsApprovalDecisionDeny {
+			return OpsRequestDenied
+		}
+		approvers.Insert(r.Approver.Username)
+	}
+	if int32(approvers.Len()) >= p.GetMinApprovals() {
+		return OpsRequestApproved
+	}
+	return OpsRequestPhaseWaitingForApproval
+}
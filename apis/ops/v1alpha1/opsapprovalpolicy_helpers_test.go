@@ -0,0 +1,150 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	rbac "k8s.io/api/rbac/v1"
+)
+
+func approvalPolicy(name string, minApprovals int32, approvers ...rbac.Subject) OpsApprovalPolicy {
+	p := OpsApprovalPolicy{}
+	p.Name = name
+	p.Spec.Approvers = approvers
+	p.Spec.MinApprovals = minApprovals
+	return p
+}
+
+func TestOpsApprovalPolicyIsApprover(t *testing.T) {
+	p := approvalPolicy("prod", 1,
+		rbac.Subject{Kind: rbac.UserKind, Name: "alice"},
+		rbac.Subject{Kind: rbac.GroupKind, Name: "dba"},
+		rbac.Subject{Kind: rbac.ServiceAccountKind, Name: "release", Namespace: "ci"},
+	)
+
+	tests := []struct {
+		name     string
+		approver OpsApprover
+		want     bool
+	}{
+		{name: "user", approver: OpsApprover{Username: "alice"}, want: true},
+		{name: "other user", approver: OpsApprover{Username: "bob"}},
+		{name: "member of group", approver: OpsApprover{Username: "bob", Groups: []string{"dev", "dba"}}, want: true},
+		{name: "user named like the group", approver: OpsApprover{Username: "dba"}},
+		{name: "service account", approver: OpsApprover{Username: "system:serviceaccount:ci:release"}, want: true},
+		{name: "service account of another namespace", approver: OpsApprover{Username: "system:serviceaccount:dev:release"}},
+		{name: "user named like the service account", approver: OpsApprover{Username: "release"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := p.IsApprover(tt.approver); got != tt.want {
+				t.Errorf("IsApprover() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOpsApprovalPolicyApprovalPhase(t *testing.T) {
+	p := approvalPolicy("prod", 2,
+		rbac.Subject{Kind: rbac.UserKind, Name: "alice"},
+		rbac.Subject{Kind: rbac.UserKind, Name: "bob"},
+	)
+	record := func(user string, decision OpsApprovalDecision, policies ...string) OpsApprovalRecord {
+		return OpsApprovalRecord{Approver: OpsApprover{Username: user}, Decision: decision, Policies: policies}
+	}
+
+	tests := []struct {
+		name    string
+		policy  OpsApprovalPolicy
+		records []OpsApprovalRecord
+		want    OpsRequestPhase
+	}{
+		{
+			name:   "no records",
+			policy: p,
+			want:   OpsRequestPhaseWaitingForApproval,
+		},
+		{
+			name:    "too few approvals",
+			policy:  p,
+			records: []OpsApprovalRecord{record("alice", OpsApprovalDecisionApprove, "prod")},
+			want:    OpsRequestPhaseWaitingForApproval,
+		},
+		{
+			name:   "same approver counted once",
+			policy: p,
+			records: []OpsApprovalRecord{
+				record("alice", OpsApprovalDecisionApprove, "prod"),
+				record("alice", OpsApprovalDecisionApprove, "prod"),
+			},
+			want: OpsRequestPhaseWaitingForApproval,
+		},
+		{
+			name:   "enough approvals",
+			policy: p,
+			records: []OpsApprovalRecord{
+				record("alice", OpsApprovalDecisionApprove, "prod"),
+				record("bob", OpsApprovalDecisionApprove, "prod"),
+			},
+			want: OpsRequestApproved,
+		},
+		{
+			name:    "min approvals defaults to one",
+			policy:  approvalPolicy("prod", 0, rbac.Subject{Kind: rbac.UserKind, Name: "alice"}),
+			records: []OpsApprovalRecord{record("alice", OpsApprovalDecisionApprove, "prod")},
+			want:    OpsRequestApproved,
+		},
+		{
+			name:   "single denial wins",
+			policy: p,
+			records: []OpsApprovalRecord{
+				record("alice", OpsApprovalDecisionApprove, "prod"),
+				record("bob", OpsApprovalDecisionApprove, "prod"),
+				record("alice", OpsApprovalDecisionDeny, "prod"),
+			},
+			want: OpsRequestDenied,
+		},
+		{
+			name:   "records of other policies are ignored",
+			policy: p,
+			records: []OpsApprovalRecord{
+				record("alice", OpsApprovalDecisionApprove, "prod"),
+				record("bob", OpsApprovalDecisionApprove, "staging"),
+				record("bob", OpsApprovalDecisionDeny, "staging"),
+			},
+			want: OpsRequestPhaseWaitingForApproval,
+		},
+		{
+			name:   "records of non approvers are ignored",
+			policy: p,
+			records: []OpsApprovalRecord{
+				record("alice", OpsApprovalDecisionApprove, "prod"),
+				record("carol", OpsApprovalDecisionApprove, "prod"),
+				record("carol", OpsApprovalDecisionDeny, "prod"),
+			},
+			want: OpsRequestPhaseWaitingForApproval,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.ApprovalPhase(tt.records); got != tt.want {
+				t.Errorf("ApprovalPhase() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
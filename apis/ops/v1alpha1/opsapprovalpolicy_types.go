@@ -0,0 +1,93 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	ResourceCodeOpsApprovalPolicy     = "oap"
+	ResourceKindOpsApprovalPolicy     = "OpsApprovalPolicy"
+	ResourceSingularOpsApprovalPolicy = "opsapprovalpolicy"
+	ResourcePluralOpsApprovalPolicy   = "opsapprovalpolicies"
+)
+
+// OpsApprovalPolicy requires ops requests to be approved before they run. A selected ops request
+// stays in WaitingForApproval phase until enough OpsApprovals from permitted approvers are recorded.
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=opsapprovalpolicies,singular=opsapprovalpolicy,scope=Cluster,shortName=oap,categories={ops,kubedb,appscode}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="MinApprovals",type="integer",JSONPath=".spec.minApprovals"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type OpsApprovalPolicy struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OpsApprovalPolicySpec   `json:"spec,omitempty"`
+	Status            OpsApprovalPolicyStatus `json:"status,omitempty"`
+}
+
+// OpsApprovalPolicySpec is the spec for OpsApprovalPolicy
+type OpsApprovalPolicySpec struct {
+	// DatabaseSelector selects the databases whose ops requests need approval. A policy without a
+	// selector applies to every database in the cluster.
+	// +optional
+	DatabaseSelector *OpsDatabaseSelector `json:"databaseSelector,omitempty"`
+	// OpsTypes are the ops request types that need approval. All types when empty.
+	// +optional
+	OpsTypes []string `json:"opsTypes,omitempty"`
+	// Sources are the creators of ops requests the policy applies to. All sources when empty.
+	// +optional
+	Sources []OpsRequestSource `json:"sources,omitempty"`
+	// Approvers are the users, groups and service accounts permitted to approve or deny.
+	// +kubebuilder:validation:MinItems=1
+	Approvers []rbac.Subject `json:"approvers"`
+	// MinApprovals is the number of approvals, from distinct approvers, an ops request needs.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinApprovals int32 `json:"minApprovals,omitempty"`
+}
+
+// OpsApprovalPolicyStatus is the status for OpsApprovalPolicy
+type OpsApprovalPolicyStatus struct {
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Conditions applied to the policy.
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpsApprovalPolicyList is a list of OpsApprovalPolicies
+type OpsApprovalPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of OpsApprovalPolicy CRD objects
+	Items []OpsApprovalPolicy `json:"items,omitempty"`
+}
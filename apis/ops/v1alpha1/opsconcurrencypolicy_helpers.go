@@ -0,0 +1,97 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"slices"
+
+	"kubedb.dev/apimachinery/apis"
+	"kubedb.dev/apimachinery/apis/ops"
+	"kubedb.dev/apimachinery/crds"
+
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+func (p OpsConcurrencyPolicy) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralOpsConcurrencyPolicy))
+}
+
+var _ apis.ResourceInfo = &OpsConcurrencyPolicy{}
+
+func (p OpsConcurrencyPolicy) ResourceFQN() string {
+	return fmt.Sprintf("%s.%s", ResourcePluralOpsConcurrencyPolicy, ops.GroupName)
+}
+
+func (p OpsConcurrencyPolicy) ResourceShortCode() string {
+	return ResourceCodeOpsConcurrencyPolicy
+}
+
+func (p OpsConcurrencyPolicy) ResourceKind() string {
+	return ResourceKindOpsConcurrencyPolicy
+}
+
+func (p OpsConcurrencyPolicy) ResourceSingular() string {
+	return ResourceSingularOpsConcurrencyPolicy
+}
+
+func (p OpsConcurrencyPolicy) ResourcePlural() string {
+	return ResourcePluralOpsConcurrencyPolicy
+}
+
+func (p OpsConcurrencyPolicy) ValidateSpecs() error {
+	if len(p.Spec.Limits) == 0 {
+		return fmt.Errorf("spec.limits must not be empty")
+	}
+	for i, l := range p.Spec.Limits {
+		if l.MaxConcurrent < 1 {
+			return fmt.Errorf("spec.limits[%d].maxConcurrent must be at least 1", i)
+		}
+		switch l.GetScope() {
+		case OpsConcurrencyScopeCluster, OpsConcurrencyScopeNamespace, OpsConcurrencyScopeStorageClass:
+			if l.NodePoolLabel != "" {
+				return fmt.Errorf("spec.limits[%d].nodePoolLabel is only allowed for the NodePool scope", i)
+			}
+		case OpsConcurrencyScopeNodePool:
+			if l.NodePoolLabel == "" {
+				return fmt.Errorf("spec.limits[%d].nodePoolLabel is required for the NodePool scope", i)
+			}
+		default:
+			return fmt.Errorf("spec.limits[%d].scope %q is not supported", i, l.Scope)
+		}
+	}
+	return p.Spec.DatabaseSelector.Validate()
+}
+
+// Selects returns true if the policy applies to a database of the given kind, with the given labels
+// and namespace labels.
+func (p OpsConcurrencyPolicy) Selects(kind string, nsLabels, dbLabels map[string]string) (bool, error) {
+	return p.Spec.DatabaseSelector.Matches(kind, nsLabels, dbLabels)
+}
+
+// GetScope returns the scope of the limit, Cluster when unset.
+func (l OpsConcurrencyLimit) GetScope() OpsConcurrencyScope {
+	if l.Scope == "" {
+		return OpsConcurrencyScopeCluster
+	}
+	return l.Scope
+}
+
+// CountsOpsType returns true if ops requests of the given type are counted against the limit.
+func (l OpsConcurrencyLimit) CountsOpsType(opsType string) bool {
+	return len(l.OpsTypes) == 0 || slices.Contains(l.OpsTypes, opsType)
+}
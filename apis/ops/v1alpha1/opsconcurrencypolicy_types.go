@@ -0,0 +1,119 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	ResourceCodeOpsConcurrencyPolicy     = "ocp"
+	ResourceKindOpsConcurrencyPolicy     = "OpsConcurrencyPolicy"
+	ResourceSingularOpsConcurrencyPolicy = "opsconcurrencypolicy"
+	ResourcePluralOpsConcurrencyPolicy   = "opsconcurrencypolicies"
+)
+
+// OpsConcurrencyPolicy caps how many ops requests run at the same time across all database kinds.
+// An ops request over a cap stays Pending, with the QueuedByConcurrencyPolicy condition carrying its
+// position in the queue, until a running ops request of the same group finishes.
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=opsconcurrencypolicies,singular=opsconcurrencypolicy,scope=Cluster,shortName=ocp,categories={ops,kubedb,appscode}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Running",type="integer",JSONPath=".status.running"
+// +kubebuilder:printcolumn:name="Queued",type="integer",JSONPath=".status.queued"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type OpsConcurrencyPolicy struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OpsConcurrencyPolicySpec   `json:"spec,omitempty"`
+	Status            OpsConcurrencyPolicyStatus `json:"status,omitempty"`
+}
+
+// OpsConcurrencyPolicySpec is the spec for OpsConcurrencyPolicy
+type OpsConcurrencyPolicySpec struct {
+	// DatabaseSelector attaches the policy to the selected databases. Every database in the cluster
+	// when empty.
+	// +optional
+	DatabaseSelector *OpsDatabaseSelector `json:"databaseSelector,omitempty"`
+	// Limits are the caps. An ops request must fit into every limit that applies to it.
+	// +kubebuilder:validation:MinItems=1
+	Limits []OpsConcurrencyLimit `json:"limits"`
+}
+
+// OpsConcurrencyLimit caps the running ops requests of the given types in each group of the scope.
+type OpsConcurrencyLimit struct {
+	// OpsTypes are the ops request types counted against the limit. All types when empty.
+	// +optional
+	OpsTypes []string `json:"opsTypes,omitempty"`
+	// Scope groups the ops requests the limit is counted in. Cluster counts all of them together;
+	// Namespace, NodePool and StorageClass count them per namespace, per node pool of the database
+	// pods and per StorageClass of the database volumes.
+	// +kubebuilder:default="Cluster"
+	// +optional
+	Scope OpsConcurrencyScope `json:"scope,omitempty"`
+	// NodePoolLabel is the node label that names the node pool, e.g. cloud.google.com/gke-nodepool.
+	// Required for the NodePool scope.
+	// +optional
+	NodePoolLabel string `json:"nodePoolLabel,omitempty"`
+	// MaxConcurrent is the number of ops requests allowed to run at the same time in each group.
+	// +kubebuilder:validation:Minimum=1
+	MaxConcurrent int32 `json:"maxConcurrent"`
+}
+
+// +kubebuilder:validation:Enum=Cluster;Namespace;NodePool;StorageClass
+type OpsConcurrencyScope string
+
+const (
+	OpsConcurrencyScopeCluster      OpsConcurrencyScope = "Cluster"
+	OpsConcurrencyScopeNamespace    OpsConcurrencyScope = "Namespace"
+	OpsConcurrencyScopeNodePool     OpsConcurrencyScope = "NodePool"
+	OpsConcurrencyScopeStorageClass OpsConcurrencyScope = "StorageClass"
+)
+
+// OpsConcurrencyPolicyStatus is the status for OpsConcurrencyPolicy
+type OpsConcurrencyPolicyStatus struct {
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Running is the number of running ops requests the policy applies to.
+	// +optional
+	Running int32 `json:"running,omitempty"`
+	// Queued is the number of ops requests held Pending by the policy.
+	// +optional
+	Queued int32 `json:"queued,omitempty"`
+	// Conditions applied to the policy.
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpsConcurrencyPolicyList is a list of OpsConcurrencyPolicies
+type OpsConcurrencyPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of OpsConcurrencyPolicy CRD objects
+	Items []OpsConcurrencyPolicy `json:"items,omitempty"`
+}
@@ -0,0 +1,235 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"kubedb.dev/apimachinery/apis"
+	"kubedb.dev/apimachinery/apis/ops"
+	"kubedb.dev/apimachinery/crds"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"kmodules.xyz/client-go/apiextensions"
+)
+
+func (p OpsPlan) CustomResourceDefinition() *apiextensions.CustomResourceDefinition {
+	return crds.MustCustomResourceDefinition(SchemeGroupVersion.WithResource(ResourcePluralOpsPlan))
+}
+
+var _ apis.ResourceInfo = &OpsPlan{}
+
+func (p OpsPlan) ResourceFQN() string {
+	return fmt.Sprintf("%s.%s", ResourcePluralOpsPlan, ops.GroupName)
+}
+
+func (p OpsPlan) ResourceShortCode() string {
+	return ResourceCodeOpsPlan
+}
+
+func (p OpsPlan) ResourceKind() string {
+	return ResourceKindOpsPlan
+}
+
+func (p OpsPlan) ResourceSingular() string {
+	return ResourceSingularOpsPlan
+}
+
+func (p OpsPlan) ResourcePlural() string {
+	return ResourcePluralOpsPlan
+}
+
+// ValidateSpecs checks that step names are unique DNS-1123 labels that keep the names of their ops
+// requests within 63 characters, dependencies exist and do not form a cycle, and every request
+// decodes to an ops request.
+func (p OpsPlan) ValidateSpecs() error {
+	if len(p.Spec.Steps) == 0 {
+		return errors.New("spec.steps must not be empty")
+	}
+	names := make(map[string]int, len(p.Spec.Steps))
+	for i, step := range p.Spec.Steps {
+		if step.Name == "" {
+			return fmt.Errorf("spec.steps[%d].name must not be empty", i)
+		}
+		if errs := validation.IsDNS1123Label(step.Name); len(errs) > 0 {
+			return fmt.Errorf("spec.steps[%d].name %q is invalid: %s", i, step.Name, strings.Join(errs, "; "))
+		}
+		// the step's ops requests are named after the plan and the step
+		name := p.StepOpsRequestName(step.Name)
+		if step.Rollback != nil {
+			name = p.StepRollbackName(step.Name)
+		}
+		if p.Name != "" && len(name) > validation.DNS1123LabelMaxLength {
+			return fmt.Errorf("spec.steps[%d].name %q is too long: ops request name %q must be no more than %d characters",
+				i, step.Name, name, validation.DNS1123LabelMaxLength)
+		}
+		if _, found := names[step.Name]; found {
+			return fmt.Errorf("spec.steps[%d].name %q is duplicate", i, step.Name)
+		}
+		names[step.Name] = i
+	}
+
+	for i, step := range p.Spec.Steps {
+		if len(step.DependsOn) > 0 && p.Spec.Order != OpsPlanOrderDAG {
+			return fmt.Errorf("spec.steps[%d].dependsOn is only supported with order %s", i, OpsPlanOrderDAG)
+		}
+		for _, dep := range step.DependsOn {
+			if _, found := names[dep]; !found {
+				return fmt.Errorf("spec.steps[%d].dependsOn refers to unknown step %q", i, dep)
+			}
+		}
+		if _, err := DecodeOpsRequest(step.Request); err != nil {
+			return fmt.Errorf("spec.steps[%d].request is invalid: %v", i, err)
+		}
+		if step.Rollback != nil {
+			if _, err := DecodeOpsRequest(*step.Rollback); err != nil {
+				return fmt.Errorf("spec.steps[%d].rollback is invalid: %v", i, err)
+			}
+		}
+	}
+
+	// depth first search for cycles; 1 = visiting, 2 = done
+	state := make(map[string]int, len(p.Spec.Steps))
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case 1:
+			return fmt.Errorf("spec.steps has a dependency cycle through step %q", name)
+		case 2:
+			return nil
+		}
+		state[name] = 1
+		for _, dep := range p.Spec.Steps[names[name]].DependsOn {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		state[name] = 2
+		return nil
+	}
+	for _, step := range p.Spec.Steps {
+		if err := visit(step.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// StepOpsRequestName returns the name of the ops request created for a step.
+func (p OpsPlan) StepOpsRequestName(step string) string {
+	return fmt.Sprintf("%s-%s", p.Name, step)
+}
+
+// StepRollbackName returns the name of the rollback ops request created for a step.
+func (p OpsPlan) StepRollbackName(step string) string {
+	return fmt.Sprintf("%s-%s-rollback", p.Name, step)
+}
+
+// Dependencies returns the names of the steps that must be Successful before step i starts.
+func (p OpsPlan) Dependencies(i int) []string {
+	if p.Spec.Order == OpsPlanOrderDAG {
+		return p.Spec.Steps[i].DependsOn
+	}
+	if i == 0 {
+		return nil
+	}
+	return []string{p.Spec.Steps[i-1].Name}
+}
+
+// GetStepStatus returns the status of the named step, or nil if it has none yet.
+func (p *OpsPlan) GetStepStatus(name string) *OpsPlanStepStatus {
+	for i := range p.Status.Steps {
+		if p.Status.Steps[i].Name == name {
+			return &p.Status.Steps[i]
+		}
+	}
+	return nil
+}
+
+// IsStepFailed returns true if a step's ops request ended without success: it Failed, was Denied by
+// its approvers, or was Skipped, e.g. because it was merged into another ops request.
+func IsStepFailed(phase OpsRequestPhase) bool {
+	switch phase {
+	case OpsRequestPhaseFailed, OpsRequestDenied, OpsRequestPhaseSkipped:
+		return true
+	}
+	return false
+}
+
+// FailedStep returns the status of the first step that ended without success, or nil if there is none.
+func (p *OpsPlan) FailedStep() *OpsPlanStepStatus {
+	for i := range p.Status.Steps {
+		if IsStepFailed(p.Status.Steps[i].Phase) {
+			return &p.Status.Steps[i]
+		}
+	}
+	return nil
+}
+
+// RunnableSteps returns the steps that are not started yet and whose dependencies are all Successful.
+// Once a step has ended without success no step is runnable: the remaining steps are skipped under
+// both failure policies.
+func (p *OpsPlan) RunnableSteps() []OpsPlanStep {
+	if p.FailedStep() != nil {
+		return nil
+	}
+	var steps []OpsPlanStep
+	for i, step := range p.Spec.Steps {
+		if s := p.GetStepStatus(step.Name); s != nil && s.OpsRequestRef != nil {
+			continue
+		}
+		ready := true
+		for _, dep := range p.Dependencies(i) {
+			if s := p.GetStepStatus(dep); s == nil || s.Phase != OpsRequestPhaseSuccessful {
+				ready = false
+				break
+			}
+		}
+		if ready {
+			steps = append(steps, step)
+		}
+	}
+	return steps
+}
+
+var opsRequestDecoder = sync.OnceValue(func() runtime.Decoder {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(AddToScheme(scheme))
+	return serializer.NewCodecFactory(scheme).UniversalDeserializer()
+})
+
+// DecodeOpsRequest decodes an embedded ops request of an OpsPlan step.
+func DecodeOpsRequest(raw runtime.RawExtension) (Accessor, error) {
+	if len(raw.Raw) == 0 {
+		return nil, errors.New("request is empty")
+	}
+	obj, gvk, err := opsRequestDecoder().Decode(raw.Raw, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	req, ok := obj.(Accessor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an ops request", gvk.Kind)
+	}
+	return req, nil
+}
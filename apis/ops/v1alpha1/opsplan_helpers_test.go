@@ -0,0 +1,240 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const restartRequest = `{"apiVersion":"ops.kubedb.com/v1alpha1","kind":"PostgresOpsRequest","spec":{"type":"Restart","databaseRef":{"name":"pg"}}}`
+
+func planStep(name string, deps ...string) OpsPlanStep {
+	return OpsPlanStep{
+		Name:      name,
+		DependsOn: deps,
+		Request:   runtime.RawExtension{Raw: []byte(restartRequest)},
+	}
+}
+
+func TestOpsPlanValidateSpecs(t *testing.T) {
+	tests := []struct {
+		name     string
+		planName string
+		order    OpsPlanOrder
+		steps    []OpsPlanStep
+		wantErr  string
+	}{
+		{
+			name:  "sequential",
+			order: OpsPlanOrderSequential,
+			steps: []OpsPlanStep{planStep("a"), planStep("b")},
+		},
+		{
+			name:  "dag",
+			order: OpsPlanOrderDAG,
+			steps: []OpsPlanStep{planStep("a"), planStep("b", "a"), planStep("c", "a"), planStep("d", "b", "c")},
+		},
+		{
+			name:    "no steps",
+			order:   OpsPlanOrderSequential,
+			wantErr: "must not be empty",
+		},
+		{
+			name:    "duplicate step",
+			order:   OpsPlanOrderSequential,
+			steps:   []OpsPlanStep{planStep("a"), planStep("a")},
+			wantErr: "is duplicate",
+		},
+		{
+			name:    "dependsOn without dag",
+			order:   OpsPlanOrderSequential,
+			steps:   []OpsPlanStep{planStep("a"), planStep("b", "a")},
+			wantErr: "only supported with order DAG",
+		},
+		{
+			name:    "unknown dependency",
+			order:   OpsPlanOrderDAG,
+			steps:   []OpsPlanStep{planStep("a"), planStep("b", "x")},
+			wantErr: `unknown step "x"`,
+		},
+		{
+			name:    "cycle",
+			order:   OpsPlanOrderDAG,
+			steps:   []OpsPlanStep{planStep("a", "c"), planStep("b", "a"), planStep("c", "b")},
+			wantErr: "dependency cycle",
+		},
+		{
+			name:    "self dependency",
+			order:   OpsPlanOrderDAG,
+			steps:   []OpsPlanStep{planStep("a", "a")},
+			wantErr: "dependency cycle",
+		},
+		{
+			name:    "step name is not a DNS-1123 label",
+			order:   OpsPlanOrderSequential,
+			steps:   []OpsPlanStep{planStep("Upgrade_PG")},
+			wantErr: `spec.steps[0].name "Upgrade_PG" is invalid`,
+		},
+		{
+			name:     "ops request name within 63 characters",
+			planName: strings.Repeat("p", 30),
+			order:    OpsPlanOrderSequential,
+			steps:    []OpsPlanStep{planStep(strings.Repeat("s", 32))},
+		},
+		{
+			name:     "ops request name too long",
+			planName: strings.Repeat("p", 30),
+			order:    OpsPlanOrderSequential,
+			steps:    []OpsPlanStep{planStep(strings.Repeat("s", 33))},
+			wantErr:  "is too long",
+		},
+		{
+			name:     "rollback request name too long",
+			planName: strings.Repeat("p", 30),
+			order:    OpsPlanOrderSequential,
+			steps: []OpsPlanStep{func() OpsPlanStep {
+				step := planStep(strings.Repeat("s", 30))
+				step.Rollback = &runtime.RawExtension{Raw: []byte(restartRequest)}
+				return step
+			}()},
+			wantErr: "-rollback\" must be no more than 63 characters",
+		},
+		{
+			name:    "not an ops request",
+			order:   OpsPlanOrderSequential,
+			steps:   []OpsPlanStep{{Name: "a", Request: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Pod"}`)}}},
+			wantErr: "spec.steps[0].request is invalid",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := OpsPlan{Spec: OpsPlanSpec{Order: tt.order, Steps: tt.steps}}
+			p.Name = tt.planName
+			err := p.ValidateSpecs()
+			switch {
+			case tt.wantErr == "" && err != nil:
+				t.Errorf("ValidateSpecs() error = %v", err)
+			case tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)):
+				t.Errorf("ValidateSpecs() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestOpsPlanRunnableSteps(t *testing.T) {
+	started := func(name string, phase OpsRequestPhase) OpsPlanStepStatus {
+		return OpsPlanStepStatus{Name: name, Phase: phase, OpsRequestRef: &kmapi.TypedObjectReference{Name: name}}
+	}
+	dag := []OpsPlanStep{planStep("a"), planStep("b", "a"), planStep("c"), planStep("d", "b", "c")}
+
+	tests := []struct {
+		name   string
+		order  OpsPlanOrder
+		steps  []OpsPlanStep
+		status []OpsPlanStepStatus
+		want   []string
+	}{
+		{
+			name:  "sequential start",
+			order: OpsPlanOrderSequential,
+			steps: []OpsPlanStep{planStep("a"), planStep("b")},
+			want:  []string{"a"},
+		},
+		{
+			name:   "sequential waits for the previous step",
+			order:  OpsPlanOrderSequential,
+			steps:  []OpsPlanStep{planStep("a"), planStep("b")},
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseProgressing)},
+		},
+		{
+			name:   "sequential next step",
+			order:  OpsPlanOrderSequential,
+			steps:  []OpsPlanStep{planStep("a"), planStep("b")},
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseSuccessful)},
+			want:   []string{"b"},
+		},
+		{
+			name:  "dag starts independent steps",
+			order: OpsPlanOrderDAG,
+			steps: dag,
+			want:  []string{"a", "c"},
+		},
+		{
+			name:   "dag waits for every dependency",
+			order:  OpsPlanOrderDAG,
+			steps:  dag,
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseSuccessful), started("b", OpsRequestPhaseSuccessful), started("c", OpsRequestPhaseProgressing)},
+		},
+		{
+			name:   "dag all dependencies done",
+			order:  OpsPlanOrderDAG,
+			steps:  dag,
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseSuccessful), started("b", OpsRequestPhaseSuccessful), started("c", OpsRequestPhaseSuccessful)},
+			want:   []string{"d"},
+		},
+		{
+			name:   "dag stops after a failure",
+			order:  OpsPlanOrderDAG,
+			steps:  dag,
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseFailed)},
+		},
+		{
+			name:   "dag stops after a denied step",
+			order:  OpsPlanOrderDAG,
+			steps:  dag,
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseSuccessful), started("c", OpsRequestDenied)},
+		},
+		{
+			name:   "dag stops after a skipped step",
+			order:  OpsPlanOrderDAG,
+			steps:  dag,
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseSkipped)},
+		},
+		{
+			name:   "sequential stops after a denied step",
+			order:  OpsPlanOrderSequential,
+			steps:  []OpsPlanStep{planStep("a"), planStep("b")},
+			status: []OpsPlanStepStatus{started("a", OpsRequestDenied)},
+		},
+		{
+			name:   "sequential stops after a skipped step",
+			order:  OpsPlanOrderSequential,
+			steps:  []OpsPlanStep{planStep("a"), planStep("b")},
+			status: []OpsPlanStepStatus{started("a", OpsRequestPhaseSkipped)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &OpsPlan{
+				Spec:   OpsPlanSpec{Order: tt.order, Steps: tt.steps, FailurePolicy: OpsPlanFailurePolicyStop},
+				Status: OpsPlanStatus{Steps: tt.status},
+			}
+			var got []string
+			for _, step := range p.RunnableSteps() {
+				got = append(got, step.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RunnableSteps() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
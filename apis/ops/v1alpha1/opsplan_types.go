@@ -0,0 +1,162 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const (
+	ResourceCodeOpsPlan     = "opsplan"
+	ResourceKindOpsPlan     = "OpsPlan"
+	ResourceSingularOpsPlan = "opsplan"
+	ResourcePluralOpsPlan   = "opsplans"
+)
+
+// OpsPlan runs a list of ops requests as one workflow. Each step is created only after the steps it
+// depends on are Successful.
+
+// +genclient
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:path=opsplans,singular=opsplan,shortName=opsplan,categories={ops,kubedb,appscode}
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Order",type="string",JSONPath=".spec.order"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+type OpsPlan struct {
+	metav1.TypeMeta   `json:",inline,omitempty"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              OpsPlanSpec   `json:"spec,omitempty"`
+	Status            OpsPlanStatus `json:"status,omitempty"`
+}
+
+// OpsPlanSpec is the spec for OpsPlan
+type OpsPlanSpec struct {
+	// Steps are the ops requests of the plan. Step names must be unique.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MinItems=1
+	Steps []OpsPlanStep `json:"steps"`
+	// Order decides when a step is started. Sequential runs the steps in list order; DAG starts every
+	// step as soon as all steps in its dependsOn are Successful, so independent steps run in parallel.
+	// +kubebuilder:default="Sequential"
+	Order OpsPlanOrder `json:"order,omitempty"`
+	// FailurePolicy decides what happens after a step fails. Stop leaves the completed steps in place
+	// and skips the remaining ones; Rollback also runs the rollback request of every Successful step,
+	// in the reverse order of completion.
+	// +kubebuilder:default="Stop"
+	FailurePolicy OpsPlanFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// OpsPlanStep is one ops request of an OpsPlan.
+type OpsPlanStep struct {
+	// Name identifies the step. It must be a DNS-1123 label; the ops request is created as
+	// <plan name>-<step name>, and the rollback request as <plan name>-<step name>-rollback, and those
+	// names must be no more than 63 characters.
+	Name string `json:"name"`
+	// DependsOn lists the steps that must be Successful before this one starts. Used only when order is DAG.
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+	// Request is the ops request of the step, e.g. a PostgresOpsRequest with apiVersion, kind and spec.
+	// Its metadata.name and metadata.namespace are set by the operator.
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Request runtime.RawExtension `json:"request"`
+	// Rollback is the ops request that undoes the step, run when failurePolicy is Rollback.
+	// A Successful step without a rollback request is left as is.
+	// +kubebuilder:validation:EmbeddedResource
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	Rollback *runtime.RawExtension `json:"rollback,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Sequential;DAG
+type OpsPlanOrder string
+
+const (
+	OpsPlanOrderSequential OpsPlanOrder = "Sequential"
+	OpsPlanOrderDAG        OpsPlanOrder = "DAG"
+)
+
+// +kubebuilder:validation:Enum=Stop;Rollback
+type OpsPlanFailurePolicy string
+
+const (
+	OpsPlanFailurePolicyStop     OpsPlanFailurePolicy = "Stop"
+	OpsPlanFailurePolicyRollback OpsPlanFailurePolicy = "Rollback"
+)
+
+// OpsPlanStatus is the status for OpsPlan
+type OpsPlanStatus struct {
+	// Specifies the current phase of the plan. It is Successful once every step is Successful, and
+	// Failed once a step ended Failed, Denied or Skipped and no rollback is running.
+	// +optional
+	Phase OpsRequestPhase `json:"phase,omitempty"`
+	// observedGeneration is the most recent generation observed for this resource. It corresponds to the
+	// resource's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Steps is the status of each step, in the order of spec.steps.
+	// +optional
+	Steps []OpsPlanStepStatus `json:"steps,omitempty"`
+	// Conditions applied to the plan.
+	// +optional
+	Conditions []kmapi.Condition `json:"conditions,omitempty"`
+}
+
+// OpsPlanStepStatus is the status of one step of an OpsPlan.
+type OpsPlanStepStatus struct {
+	// Name of the step.
+	Name string `json:"name"`
+	// Phase mirrors the phase of the step's ops request. It is Pending until the ops request is created,
+	// and Skipped for steps that were not started because an earlier step ended without success.
+	// +optional
+	Phase OpsRequestPhase `json:"phase,omitempty"`
+	// OpsRequestRef refers to the ops request created for the step.
+	// +optional
+	OpsRequestRef *kmapi.TypedObjectReference `json:"opsRequestRef,omitempty"`
+	// StartTime is when the ops request was created.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// CompletionTime is when the ops request completed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+	// RollbackPhase mirrors the phase of the step's rollback ops request.
+	// +optional
+	RollbackPhase OpsRequestPhase `json:"rollbackPhase,omitempty"`
+	// RollbackRef refers to the rollback ops request created for the step.
+	// +optional
+	RollbackRef *kmapi.TypedObjectReference `json:"rollbackRef,omitempty"`
+	// Message is an optional human-readable detail (for a failed step).
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// OpsPlanList is a list of OpsPlans
+type OpsPlanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// Items is a list of OpsPlan CRD objects
+	Items []OpsPlan `json:"items,omitempty"`
+}
@@ -55,6 +55,12 @@ type OracleOpsRequestSpec struct {
 	DatabaseRef core.LocalObjectReference `json:"databaseRef"`
 	// Specifies the ops request type: UpdateVersion, HorizontalScaling, VerticalScaling etc.
 	Type OracleOpsRequestType `json:"type"`
+	// Specifies information necessary for upgrading Oracle
+	UpdateVersion *OracleUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
+	// Specifies information necessary for horizontal scaling
+	HorizontalScaling *OracleHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
 	VerticalScaling *OracleVerticalScalingSpec `json:"verticalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
@@ -71,6 +77,10 @@ type OracleOpsRequestSpec struct {
 	// Specifies information necessary for TLS reconfiguration
 	// +optional
 	TLS *TLSSpec `json:"tls,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// ApplyOption is to control the execution of OpsRequest depending on the database state.
@@ -91,10 +101,28 @@ type OracleMigrationSpec struct {
 	OldPVReclaimPolicy core.PersistentVolumeReclaimPolicy `json:"oldPVReclaimPolicy,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=Restart;Reconfigure;ReconfigureTLS;StorageMigration;VerticalScaling;VolumeExpansion;RotateAuth
-// ENUM(Restart, Reconfigure, ReconfigureTLS, StorageMigration, VerticalScaling, VolumeExpansion, RotateAuth)
+// +kubebuilder:validation:Enum=Restart;Reconfigure;ReconfigureTLS;StorageMigration;VerticalScaling;VolumeExpansion;RotateAuth;UpdateVersion;HorizontalScaling;Rollback
+// ENUM(Restart, Reconfigure, ReconfigureTLS, StorageMigration, VerticalScaling, VolumeExpansion, RotateAuth, UpdateVersion, HorizontalScaling, Rollback)
 type OracleOpsRequestType string
 
+// OracleUpdateVersionSpec contains the update version information of an Oracle database.
+// In DataGuard mode the standbys are updated first. The primary role is then switched over
+// to an updated standby before the old primary is updated.
+type OracleUpdateVersionSpec struct {
+	// Specifies the target version name from catalog
+	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
+}
+
+// OracleHorizontalScalingSpec contains the horizontal scaling information of an Oracle database.
+// Standbys are added to or removed from the Data Guard configuration of the primary.
+type OracleHorizontalScalingSpec struct {
+	// Number of nodes of the Data Guard configuration, including the primary.
+	Replicas *int32 `json:"replicas,omitempty"`
+}
+
 // OracleVerticalScalingSpec contains the vertical scaling information of an Oracle cluster
 type OracleVerticalScalingSpec struct {
 	// Resource spec for nodes
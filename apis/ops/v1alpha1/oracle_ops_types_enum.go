@@ -25,6 +25,12 @@ const (
 	OracleOpsRequestTypeVolumeExpansion OracleOpsRequestType = "VolumeExpansion"
 	// OracleOpsRequestTypeRotateAuth is a OracleOpsRequestType of type RotateAuth.
 	OracleOpsRequestTypeRotateAuth OracleOpsRequestType = "RotateAuth"
+	// OracleOpsRequestTypeUpdateVersion is a OracleOpsRequestType of type UpdateVersion.
+	OracleOpsRequestTypeUpdateVersion OracleOpsRequestType = "UpdateVersion"
+	// OracleOpsRequestTypeHorizontalScaling is a OracleOpsRequestType of type HorizontalScaling.
+	OracleOpsRequestTypeHorizontalScaling OracleOpsRequestType = "HorizontalScaling"
+	// OracleOpsRequestTypeRollback is a OracleOpsRequestType of type Rollback.
+	OracleOpsRequestTypeRollback OracleOpsRequestType = "Rollback"
 )
 
 var ErrInvalidOracleOpsRequestType = fmt.Errorf("not a valid OracleOpsRequestType, try [%s]", strings.Join(_OracleOpsRequestTypeNames, ", "))
@@ -37,6 +43,9 @@ var _OracleOpsRequestTypeNames = []string{
 	string(OracleOpsRequestTypeVerticalScaling),
 	string(OracleOpsRequestTypeVolumeExpansion),
 	string(OracleOpsRequestTypeRotateAuth),
+	string(OracleOpsRequestTypeUpdateVersion),
+	string(OracleOpsRequestTypeHorizontalScaling),
+	string(OracleOpsRequestTypeRollback),
 }
 
 // OracleOpsRequestTypeNames returns a list of possible string values of OracleOpsRequestType.
@@ -56,6 +65,9 @@ func OracleOpsRequestTypeValues() []OracleOpsRequestType {
 		OracleOpsRequestTypeVerticalScaling,
 		OracleOpsRequestTypeVolumeExpansion,
 		OracleOpsRequestTypeRotateAuth,
+		OracleOpsRequestTypeUpdateVersion,
+		OracleOpsRequestTypeHorizontalScaling,
+		OracleOpsRequestTypeRollback,
 	}
 }
 
@@ -72,13 +84,16 @@ func (x OracleOpsRequestType) IsValid() bool {
 }
 
 var _OracleOpsRequestTypeValue = map[string]OracleOpsRequestType{
-	"Restart":          OracleOpsRequestTypeRestart,
-	"Reconfigure":      OracleOpsRequestTypeReconfigure,
-	"ReconfigureTLS":   OracleOpsRequestTypeReconfigureTLS,
-	"StorageMigration": OracleOpsRequestTypeStorageMigration,
-	"VerticalScaling":  OracleOpsRequestTypeVerticalScaling,
-	"VolumeExpansion":  OracleOpsRequestTypeVolumeExpansion,
-	"RotateAuth":       OracleOpsRequestTypeRotateAuth,
+	"Restart":           OracleOpsRequestTypeRestart,
+	"Reconfigure":       OracleOpsRequestTypeReconfigure,
+	"ReconfigureTLS":    OracleOpsRequestTypeReconfigureTLS,
+	"StorageMigration":  OracleOpsRequestTypeStorageMigration,
+	"VerticalScaling":   OracleOpsRequestTypeVerticalScaling,
+	"VolumeExpansion":   OracleOpsRequestTypeVolumeExpansion,
+	"RotateAuth":        OracleOpsRequestTypeRotateAuth,
+	"UpdateVersion":     OracleOpsRequestTypeUpdateVersion,
+	"HorizontalScaling": OracleOpsRequestTypeHorizontalScaling,
+	"Rollback":          OracleOpsRequestTypeRollback,
 }
 
 // ParseOracleOpsRequestType attempts to convert a string to a OracleOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	PerconaXtraDBOpsRequestTypeRotateAuth PerconaXtraDBOpsRequestType = "RotateAuth"
 	// PerconaXtraDBOpsRequestTypeStorageMigration is a PerconaXtraDBOpsRequestType of type StorageMigration.
 	PerconaXtraDBOpsRequestTypeStorageMigration PerconaXtraDBOpsRequestType = "StorageMigration"
+	// PerconaXtraDBOpsRequestTypeRollback is a PerconaXtraDBOpsRequestType of type Rollback.
+	PerconaXtraDBOpsRequestTypeRollback PerconaXtraDBOpsRequestType = "Rollback"
 )
 
 var ErrInvalidPerconaXtraDBOpsRequestType = fmt.Errorf("not a valid PerconaXtraDBOpsRequestType, try [%s]", strings.Join(_PerconaXtraDBOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _PerconaXtraDBOpsRequestTypeNames = []string{
 	string(PerconaXtraDBOpsRequestTypeReconfigureTLS),
 	string(PerconaXtraDBOpsRequestTypeRotateAuth),
 	string(PerconaXtraDBOpsRequestTypeStorageMigration),
+	string(PerconaXtraDBOpsRequestTypeRollback),
 }
 
 // PerconaXtraDBOpsRequestTypeNames returns a list of possible string values of PerconaXtraDBOpsRequestType.
@@ -64,6 +67,7 @@ func PerconaXtraDBOpsRequestTypeValues() []PerconaXtraDBOpsRequestType {
 		PerconaXtraDBOpsRequestTypeReconfigureTLS,
 		PerconaXtraDBOpsRequestTypeRotateAuth,
 		PerconaXtraDBOpsRequestTypeStorageMigration,
+		PerconaXtraDBOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _PerconaXtraDBOpsRequestTypeValue = map[string]PerconaXtraDBOpsRequestType{
 	"ReconfigureTLS":    PerconaXtraDBOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        PerconaXtraDBOpsRequestTypeRotateAuth,
 	"StorageMigration":  PerconaXtraDBOpsRequestTypeStorageMigration,
+	"Rollback":          PerconaXtraDBOpsRequestTypeRollback,
 }
 
 // ParsePerconaXtraDBOpsRequestType attempts to convert a string to a PerconaXtraDBOpsRequestType.
@@ -25,6 +25,8 @@ const (
 	PgBouncerOpsRequestTypeRestart PgBouncerOpsRequestType = "Restart"
 	// PgBouncerOpsRequestTypeReconfigureTLS is a PgBouncerOpsRequestType of type ReconfigureTLS.
 	PgBouncerOpsRequestTypeReconfigureTLS PgBouncerOpsRequestType = "ReconfigureTLS"
+	// PgBouncerOpsRequestTypeRollback is a PgBouncerOpsRequestType of type Rollback.
+	PgBouncerOpsRequestTypeRollback PgBouncerOpsRequestType = "Rollback"
 )
 
 var ErrInvalidPgBouncerOpsRequestType = fmt.Errorf("not a valid PgBouncerOpsRequestType, try [%s]", strings.Join(_PgBouncerOpsRequestTypeNames, ", "))
@@ -37,6 +39,7 @@ var _PgBouncerOpsRequestTypeNames = []string{
 	string(PgBouncerOpsRequestTypeRotateAuth),
 	string(PgBouncerOpsRequestTypeRestart),
 	string(PgBouncerOpsRequestTypeReconfigureTLS),
+	string(PgBouncerOpsRequestTypeRollback),
 }
 
 // PgBouncerOpsRequestTypeNames returns a list of possible string values of PgBouncerOpsRequestType.
@@ -56,6 +59,7 @@ func PgBouncerOpsRequestTypeValues() []PgBouncerOpsRequestType {
 		PgBouncerOpsRequestTypeRotateAuth,
 		PgBouncerOpsRequestTypeRestart,
 		PgBouncerOpsRequestTypeReconfigureTLS,
+		PgBouncerOpsRequestTypeRollback,
 	}
 }
 
@@ -79,6 +83,7 @@ var _PgBouncerOpsRequestTypeValue = map[string]PgBouncerOpsRequestType{
 	"RotateAuth":        PgBouncerOpsRequestTypeRotateAuth,
 	"Restart":           PgBouncerOpsRequestTypeRestart,
 	"ReconfigureTLS":    PgBouncerOpsRequestTypeReconfigureTLS,
+	"Rollback":          PgBouncerOpsRequestTypeRollback,
 }
 
 // ParsePgBouncerOpsRequestType attempts to convert a string to a PgBouncerOpsRequestType.
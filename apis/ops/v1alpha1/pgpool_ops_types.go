@@ -58,6 +58,8 @@ type PgpoolOpsRequestSpec struct {
 	Type PgpoolOpsRequestType `json:"type"`
 	// Specifies information necessary for upgrading pgpool
 	UpdateVersion *PgpoolUpdateVersionSpec `json:"updateVersion,omitempty"`
+	// Specifies information necessary for rolling back an UpdateVersion request
+	Rollback *RollbackSpec `json:"rollback,omitempty"`
 	// Specifies information necessary for horizontal scaling
 	HorizontalScaling *PgpoolHorizontalScalingSpec `json:"horizontalScaling,omitempty"`
 	// Specifies information necessary for vertical scaling
@@ -70,6 +72,10 @@ type PgpoolOpsRequestSpec struct {
 	Authentication *AuthSpec `json:"authentication,omitempty"`
 	// Specifies information necessary for restarting database
 	Restart *RestartSpec `json:"restart,omitempty"`
+	// Rollout selects how a VerticalScaling, UpdateVersion, Reconfigure or ReconfigureTLS request is rolled
+	// through the pods. All pods are rolled in sequence when unset.
+	// +optional
+	Rollout *RolloutStrategy `json:"rollout,omitempty"`
 	// Timeout for each step of the ops request in second. If a step doesn't finish within the specified timeout, the ops request will result in failure.
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// ApplyOption is to control the execution of OpsRequest depending on the database state.
@@ -91,14 +97,17 @@ type PgpoolTLSSpec struct {
 	ClientAuthMode v1alpha2.PgpoolClientAuthMode `json:"clientAuthMode,omitempty"`
 }
 
-// +kubebuilder:validation:Enum=UpdateVersion;Restart;Reconfigure;VerticalScaling;HorizontalScaling;ReconfigureTLS;RotateAuth
-// ENUM(UpdateVersion, Restart, Reconfigure, VerticalScaling, HorizontalScaling, ReconfigureTLS, RotateAuth)
+// +kubebuilder:validation:Enum=UpdateVersion;Restart;Reconfigure;VerticalScaling;HorizontalScaling;ReconfigureTLS;RotateAuth;Rollback
+// ENUM(UpdateVersion, Restart, Reconfigure, VerticalScaling, HorizontalScaling, ReconfigureTLS, RotateAuth, Rollback)
 type PgpoolOpsRequestType string
 
 // PgpoolUpdateVersionSpec contains the update version information of a pgpool cluster
 type PgpoolUpdateVersionSpec struct {
 	// Specifies the target version name from catalog
 	TargetVersion string `json:"targetVersion,omitempty"`
+	// Rollback captures the pre-update state, so that it can be restored on failure or by a Rollback request.
+	// +optional
+	Rollback *UpdateVersionRollbackSpec `json:"rollback,omitempty"`
 }
 
 // PgpoolHorizontalScalingSpec contains the horizontal scaling information of a Pgpool cluster
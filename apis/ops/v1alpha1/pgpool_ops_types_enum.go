@@ -25,6 +25,8 @@ const (
 	PgpoolOpsRequestTypeReconfigureTLS PgpoolOpsRequestType = "ReconfigureTLS"
 	// PgpoolOpsRequestTypeRotateAuth is a PgpoolOpsRequestType of type RotateAuth.
 	PgpoolOpsRequestTypeRotateAuth PgpoolOpsRequestType = "RotateAuth"
+	// PgpoolOpsRequestTypeRollback is a PgpoolOpsRequestType of type Rollback.
+	PgpoolOpsRequestTypeRollback PgpoolOpsRequestType = "Rollback"
 )
 
 var ErrInvalidPgpoolOpsRequestType = fmt.Errorf("not a valid PgpoolOpsRequestType, try [%s]", strings.Join(_PgpoolOpsRequestTypeNames, ", "))
@@ -37,6 +39,7 @@ var _PgpoolOpsRequestTypeNames = []string{
 	string(PgpoolOpsRequestTypeHorizontalScaling),
 	string(PgpoolOpsRequestTypeReconfigureTLS),
 	string(PgpoolOpsRequestTypeRotateAuth),
+	string(PgpoolOpsRequestTypeRollback),
 }
 
 // PgpoolOpsRequestTypeNames returns a list of possible string values of PgpoolOpsRequestType.
@@ -56,6 +59,7 @@ func PgpoolOpsRequestTypeValues() []PgpoolOpsRequestType {
 		PgpoolOpsRequestTypeHorizontalScaling,
 		PgpoolOpsRequestTypeReconfigureTLS,
 		PgpoolOpsRequestTypeRotateAuth,
+		PgpoolOpsRequestTypeRollback,
 	}
 }
 
@@ -79,6 +83,7 @@ var _PgpoolOpsRequestTypeValue = map[string]PgpoolOpsRequestType{
 	"HorizontalScaling": PgpoolOpsRequestTypeHorizontalScaling,
 	"ReconfigureTLS":    PgpoolOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        PgpoolOpsRequestTypeRotateAuth,
+	"Rollback":          PgpoolOpsRequestTypeRollback,
 }
 
 // ParsePgpoolOpsRequestType attempts to convert a string to a PgpoolOpsRequestType.
@@ -35,6 +35,8 @@ const (
 	PostgresOpsRequestTypeSetRaftKeyPair PostgresOpsRequestType = "SetRaftKeyPair"
 	// PostgresOpsRequestTypeStorageMigration is a PostgresOpsRequestType of type StorageMigration.
 	PostgresOpsRequestTypeStorageMigration PostgresOpsRequestType = "StorageMigration"
+	// PostgresOpsRequestTypeRollback is a PostgresOpsRequestType of type Rollback.
+	PostgresOpsRequestTypeRollback PostgresOpsRequestType = "Rollback"
 )
 
 var ErrInvalidPostgresOpsRequestType = fmt.Errorf("not a valid PostgresOpsRequestType, try [%s]", strings.Join(_PostgresOpsRequestTypeNames, ", "))
@@ -52,6 +54,7 @@ var _PostgresOpsRequestTypeNames = []string{
 	string(PostgresOpsRequestTypeForceFailOver),
 	string(PostgresOpsRequestTypeSetRaftKeyPair),
 	string(PostgresOpsRequestTypeStorageMigration),
+	string(PostgresOpsRequestTypeRollback),
 }
 
 // PostgresOpsRequestTypeNames returns a list of possible string values of PostgresOpsRequestType.
@@ -76,6 +79,7 @@ func PostgresOpsRequestTypeValues() []PostgresOpsRequestType {
 		PostgresOpsRequestTypeForceFailOver,
 		PostgresOpsRequestTypeSetRaftKeyPair,
 		PostgresOpsRequestTypeStorageMigration,
+		PostgresOpsRequestTypeRollback,
 	}
 }
 
@@ -104,6 +108,7 @@ var _PostgresOpsRequestTypeValue = map[string]PostgresOpsRequestType{
 	"ForceFailOver":     PostgresOpsRequestTypeForceFailOver,
 	"SetRaftKeyPair":    PostgresOpsRequestTypeSetRaftKeyPair,
 	"StorageMigration":  PostgresOpsRequestTypeStorageMigration,
+	"Rollback":          PostgresOpsRequestTypeRollback,
 }
 
 // ParsePostgresOpsRequestType attempts to convert a string to a PostgresOpsRequestType.
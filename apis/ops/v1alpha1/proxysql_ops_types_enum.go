@@ -25,6 +25,8 @@ const (
 	ProxySQLOpsRequestTypeReconfigureTLS ProxySQLOpsRequestType = "ReconfigureTLS"
 	// ProxySQLOpsRequestTypeRotateAuth is a ProxySQLOpsRequestType of type RotateAuth.
 	ProxySQLOpsRequestTypeRotateAuth ProxySQLOpsRequestType = "RotateAuth"
+	// ProxySQLOpsRequestTypeRollback is a ProxySQLOpsRequestType of type Rollback.
+	ProxySQLOpsRequestTypeRollback ProxySQLOpsRequestType = "Rollback"
 )
 
 var ErrInvalidProxySQLOpsRequestType = fmt.Errorf("not a valid ProxySQLOpsRequestType, try [%s]", strings.Join(_ProxySQLOpsRequestTypeNames, ", "))
@@ -37,6 +39,7 @@ var _ProxySQLOpsRequestTypeNames = []string{
 	string(ProxySQLOpsRequestTypeReconfigure),
 	string(ProxySQLOpsRequestTypeReconfigureTLS),
 	string(ProxySQLOpsRequestTypeRotateAuth),
+	string(ProxySQLOpsRequestTypeRollback),
 }
 
 // ProxySQLOpsRequestTypeNames returns a list of possible string values of ProxySQLOpsRequestType.
@@ -56,6 +59,7 @@ func ProxySQLOpsRequestTypeValues() []ProxySQLOpsRequestType {
 		ProxySQLOpsRequestTypeReconfigure,
 		ProxySQLOpsRequestTypeReconfigureTLS,
 		ProxySQLOpsRequestTypeRotateAuth,
+		ProxySQLOpsRequestTypeRollback,
 	}
 }
 
@@ -79,6 +83,7 @@ var _ProxySQLOpsRequestTypeValue = map[string]ProxySQLOpsRequestType{
 	"Reconfigure":       ProxySQLOpsRequestTypeReconfigure,
 	"ReconfigureTLS":    ProxySQLOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        ProxySQLOpsRequestTypeRotateAuth,
+	"Rollback":          ProxySQLOpsRequestTypeRollback,
 }
 
 // ParseProxySQLOpsRequestType attempts to convert a string to a ProxySQLOpsRequestType.
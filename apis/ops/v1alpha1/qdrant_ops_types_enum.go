@@ -29,6 +29,8 @@ const (
 	QdrantOpsRequestTypeRotateAuth QdrantOpsRequestType = "RotateAuth"
 	// QdrantOpsRequestTypeStorageMigration is a QdrantOpsRequestType of type StorageMigration.
 	QdrantOpsRequestTypeStorageMigration QdrantOpsRequestType = "StorageMigration"
+	// QdrantOpsRequestTypeRollback is a QdrantOpsRequestType of type Rollback.
+	QdrantOpsRequestTypeRollback QdrantOpsRequestType = "Rollback"
 )
 
 var ErrInvalidQdrantOpsRequestType = fmt.Errorf("not a valid QdrantOpsRequestType, try [%s]", strings.Join(_QdrantOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _QdrantOpsRequestTypeNames = []string{
 	string(QdrantOpsRequestTypeReconfigureTLS),
 	string(QdrantOpsRequestTypeRotateAuth),
 	string(QdrantOpsRequestTypeStorageMigration),
+	string(QdrantOpsRequestTypeRollback),
 }
 
 // QdrantOpsRequestTypeNames returns a list of possible string values of QdrantOpsRequestType.
@@ -64,6 +67,7 @@ func QdrantOpsRequestTypeValues() []QdrantOpsRequestType {
 		QdrantOpsRequestTypeReconfigureTLS,
 		QdrantOpsRequestTypeRotateAuth,
 		QdrantOpsRequestTypeStorageMigration,
+		QdrantOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _QdrantOpsRequestTypeValue = map[string]QdrantOpsRequestType{
 	"ReconfigureTLS":    QdrantOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        QdrantOpsRequestTypeRotateAuth,
 	"StorageMigration":  QdrantOpsRequestTypeStorageMigration,
+	"Rollback":          QdrantOpsRequestTypeRollback,
 }
 
 // ParseQdrantOpsRequestType attempts to convert a string to a QdrantOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	RabbitMQOpsRequestTypeRotateAuth RabbitMQOpsRequestType = "RotateAuth"
 	// RabbitMQOpsRequestTypeStorageMigration is a RabbitMQOpsRequestType of type StorageMigration.
 	RabbitMQOpsRequestTypeStorageMigration RabbitMQOpsRequestType = "StorageMigration"
+	// RabbitMQOpsRequestTypeRollback is a RabbitMQOpsRequestType of type Rollback.
+	RabbitMQOpsRequestTypeRollback RabbitMQOpsRequestType = "Rollback"
 )
 
 var ErrInvalidRabbitMQOpsRequestType = fmt.Errorf("not a valid RabbitMQOpsRequestType, try [%s]", strings.Join(_RabbitMQOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _RabbitMQOpsRequestTypeNames = []string{
 	string(RabbitMQOpsRequestTypeReconfigureTLS),
 	string(RabbitMQOpsRequestTypeRotateAuth),
 	string(RabbitMQOpsRequestTypeStorageMigration),
+	string(RabbitMQOpsRequestTypeRollback),
 }
 
 // RabbitMQOpsRequestTypeNames returns a list of possible string values of RabbitMQOpsRequestType.
@@ -64,6 +67,7 @@ func RabbitMQOpsRequestTypeValues() []RabbitMQOpsRequestType {
 		RabbitMQOpsRequestTypeReconfigureTLS,
 		RabbitMQOpsRequestTypeRotateAuth,
 		RabbitMQOpsRequestTypeStorageMigration,
+		RabbitMQOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _RabbitMQOpsRequestTypeValue = map[string]RabbitMQOpsRequestType{
 	"ReconfigureTLS":    RabbitMQOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        RabbitMQOpsRequestTypeRotateAuth,
 	"StorageMigration":  RabbitMQOpsRequestTypeStorageMigration,
+	"Rollback":          RabbitMQOpsRequestTypeRollback,
 }
 
 // ParseRabbitMQOpsRequestType attempts to convert a string to a RabbitMQOpsRequestType.
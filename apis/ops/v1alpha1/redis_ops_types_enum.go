@@ -33,6 +33,10 @@ const (
 	RedisOpsRequestTypeAnnounce RedisOpsRequestType = "Announce"
 	// RedisOpsRequestTypeStorageMigration is a RedisOpsRequestType of type StorageMigration.
 	RedisOpsRequestTypeStorageMigration RedisOpsRequestType = "StorageMigration"
+	// RedisOpsRequestTypeRollback is a RedisOpsRequestType of type Rollback.
+	RedisOpsRequestTypeRollback RedisOpsRequestType = "Rollback"
+	// RedisOpsRequestTypeSwitchover is a RedisOpsRequestType of type Switchover.
+	RedisOpsRequestTypeSwitchover RedisOpsRequestType = "Switchover"
 )
 
 var ErrInvalidRedisOpsRequestType = fmt.Errorf("not a valid RedisOpsRequestType, try [%s]", strings.Join(_RedisOpsRequestTypeNames, ", "))
@@ -49,6 +53,8 @@ var _RedisOpsRequestTypeNames = []string{
 	string(RedisOpsRequestTypeRotateAuth),
 	string(RedisOpsRequestTypeAnnounce),
 	string(RedisOpsRequestTypeStorageMigration),
+	string(RedisOpsRequestTypeRollback),
+	string(RedisOpsRequestTypeSwitchover),
 }
 
 // RedisOpsRequestTypeNames returns a list of possible string values of RedisOpsRequestType.
@@ -72,6 +78,8 @@ func RedisOpsRequestTypeValues() []RedisOpsRequestType {
 		RedisOpsRequestTypeRotateAuth,
 		RedisOpsRequestTypeAnnounce,
 		RedisOpsRequestTypeStorageMigration,
+		RedisOpsRequestTypeRollback,
+		RedisOpsRequestTypeSwitchover,
 	}
 }
 
@@ -99,6 +107,8 @@ var _RedisOpsRequestTypeValue = map[string]RedisOpsRequestType{
 	"RotateAuth":        RedisOpsRequestTypeRotateAuth,
 	"Announce":          RedisOpsRequestTypeAnnounce,
 	"StorageMigration":  RedisOpsRequestTypeStorageMigration,
+	"Rollback":          RedisOpsRequestTypeRollback,
+	"Switchover":        RedisOpsRequestTypeSwitchover,
 }
 
 // ParseRedisOpsRequestType attempts to convert a string to a RedisOpsRequestType.
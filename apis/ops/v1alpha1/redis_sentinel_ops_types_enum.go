@@ -25,6 +25,8 @@ const (
 	RedisSentinelOpsRequestTypeReconfigureTLS RedisSentinelOpsRequestType = "ReconfigureTLS"
 	// RedisSentinelOpsRequestTypeRotateAuth is a RedisSentinelOpsRequestType of type RotateAuth.
 	RedisSentinelOpsRequestTypeRotateAuth RedisSentinelOpsRequestType = "RotateAuth"
+	// RedisSentinelOpsRequestTypeRollback is a RedisSentinelOpsRequestType of type Rollback.
+	RedisSentinelOpsRequestTypeRollback RedisSentinelOpsRequestType = "Rollback"
 )
 
 var ErrInvalidRedisSentinelOpsRequestType = fmt.Errorf("not a valid RedisSentinelOpsRequestType, try [%s]", strings.Join(_RedisSentinelOpsRequestTypeNames, ", "))
@@ -37,6 +39,7 @@ var _RedisSentinelOpsRequestTypeNames = []string{
 	string(RedisSentinelOpsRequestTypeReconfigure),
 	string(RedisSentinelOpsRequestTypeReconfigureTLS),
 	string(RedisSentinelOpsRequestTypeRotateAuth),
+	string(RedisSentinelOpsRequestTypeRollback),
 }
 
 // RedisSentinelOpsRequestTypeNames returns a list of possible string values of RedisSentinelOpsRequestType.
@@ -56,6 +59,7 @@ func RedisSentinelOpsRequestTypeValues() []RedisSentinelOpsRequestType {
 		RedisSentinelOpsRequestTypeReconfigure,
 		RedisSentinelOpsRequestTypeReconfigureTLS,
 		RedisSentinelOpsRequestTypeRotateAuth,
+		RedisSentinelOpsRequestTypeRollback,
 	}
 }
 
@@ -79,6 +83,7 @@ var _RedisSentinelOpsRequestTypeValue = map[string]RedisSentinelOpsRequestType{
 	"Reconfigure":       RedisSentinelOpsRequestTypeReconfigure,
 	"ReconfigureTLS":    RedisSentinelOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        RedisSentinelOpsRequestTypeRotateAuth,
+	"Rollback":          RedisSentinelOpsRequestTypeRollback,
 }
 
 // ParseRedisSentinelOpsRequestType attempts to convert a string to a RedisSentinelOpsRequestType.
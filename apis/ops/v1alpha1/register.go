@@ -79,6 +79,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&KafkaOpsRequestList{},
 		&MariaDBOpsRequest{},
 		&MariaDBOpsRequestList{},
+		&MaintenanceWindow{},
+		&MaintenanceWindowList{},
 		&MemcachedOpsRequest{},
 		&MemcachedOpsRequestList{},
 		&MilvusOpsRequest{},
@@ -91,6 +93,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&MySQLOpsRequestList{},
 		&Neo4jOpsRequest{},
 		&Neo4jOpsRequestList{},
+		&OpsApproval{},
+		&OpsApprovalList{},
+		&OpsApprovalPolicy{},
+		&OpsApprovalPolicyList{},
+		&OpsConcurrencyPolicy{},
+		&OpsConcurrencyPolicyList{},
+		&OpsPlan{},
+		&OpsPlanList{},
 		&OracleOpsRequest{},
 		&OracleOpsRequestList{},
 		&PerconaXtraDBOpsRequest{},
@@ -29,6 +29,8 @@ const (
 	SinglestoreOpsRequestTypeRotateAuth SinglestoreOpsRequestType = "RotateAuth"
 	// SinglestoreOpsRequestTypeStorageMigration is a SinglestoreOpsRequestType of type StorageMigration.
 	SinglestoreOpsRequestTypeStorageMigration SinglestoreOpsRequestType = "StorageMigration"
+	// SinglestoreOpsRequestTypeRollback is a SinglestoreOpsRequestType of type Rollback.
+	SinglestoreOpsRequestTypeRollback SinglestoreOpsRequestType = "Rollback"
 )
 
 var ErrInvalidSinglestoreOpsRequestType = fmt.Errorf("not a valid SinglestoreOpsRequestType, try [%s]", strings.Join(_SinglestoreOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _SinglestoreOpsRequestTypeNames = []string{
 	string(SinglestoreOpsRequestTypeReconfigureTLS),
 	string(SinglestoreOpsRequestTypeRotateAuth),
 	string(SinglestoreOpsRequestTypeStorageMigration),
+	string(SinglestoreOpsRequestTypeRollback),
 }
 
 // SinglestoreOpsRequestTypeNames returns a list of possible string values of SinglestoreOpsRequestType.
@@ -64,6 +67,7 @@ func SinglestoreOpsRequestTypeValues() []SinglestoreOpsRequestType {
 		SinglestoreOpsRequestTypeReconfigureTLS,
 		SinglestoreOpsRequestTypeRotateAuth,
 		SinglestoreOpsRequestTypeStorageMigration,
+		SinglestoreOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _SinglestoreOpsRequestTypeValue = map[string]SinglestoreOpsRequestType{
 	"ReconfigureTLS":    SinglestoreOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        SinglestoreOpsRequestTypeRotateAuth,
 	"StorageMigration":  SinglestoreOpsRequestTypeStorageMigration,
+	"Rollback":          SinglestoreOpsRequestTypeRollback,
 }
 
 // ParseSinglestoreOpsRequestType attempts to convert a string to a SinglestoreOpsRequestType.
@@ -29,6 +29,8 @@ const (
 	SolrOpsRequestTypeRotateAuth SolrOpsRequestType = "RotateAuth"
 	// SolrOpsRequestTypeStorageMigration is a SolrOpsRequestType of type StorageMigration.
 	SolrOpsRequestTypeStorageMigration SolrOpsRequestType = "StorageMigration"
+	// SolrOpsRequestTypeRollback is a SolrOpsRequestType of type Rollback.
+	SolrOpsRequestTypeRollback SolrOpsRequestType = "Rollback"
 )
 
 var ErrInvalidSolrOpsRequestType = fmt.Errorf("not a valid SolrOpsRequestType, try [%s]", strings.Join(_SolrOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _SolrOpsRequestTypeNames = []string{
 	string(SolrOpsRequestTypeReconfigureTLS),
 	string(SolrOpsRequestTypeRotateAuth),
 	string(SolrOpsRequestTypeStorageMigration),
+	string(SolrOpsRequestTypeRollback),
 }
 
 // SolrOpsRequestTypeNames returns a list of possible string values of SolrOpsRequestType.
@@ -64,6 +67,7 @@ func SolrOpsRequestTypeValues() []SolrOpsRequestType {
 		SolrOpsRequestTypeReconfigureTLS,
 		SolrOpsRequestTypeRotateAuth,
 		SolrOpsRequestTypeStorageMigration,
+		SolrOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _SolrOpsRequestTypeValue = map[string]SolrOpsRequestType{
 	"ReconfigureTLS":    SolrOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        SolrOpsRequestTypeRotateAuth,
 	"StorageMigration":  SolrOpsRequestTypeStorageMigration,
+	"Rollback":          SolrOpsRequestTypeRollback,
 }
 
 // ParseSolrOpsRequestType attempts to convert a string to a SolrOpsRequestType.
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	kmapi "kmodules.xyz/client-go/api/v1"
 	appcat "kmodules.xyz/custom-resources/apis/appcatalog/v1alpha1"
@@ -77,6 +78,202 @@ type OpsRequestStatus struct {
 	// PausedBackups represents the list of backups that have been paused.
 	// +optional
 	PausedBackups []kmapi.TypedObjectReference `json:"pausedBackups,omitempty"`
+	// PreUpdateState is the database state captured by an UpdateVersion request with rollback enabled,
+	// before the update started. A Rollback request restores it.
+	// +optional
+	PreUpdateState *PreUpdateState `json:"preUpdateState,omitempty"`
+	// Approvals is the audit trail of the OpsApprovals recorded for the request.
+	// +optional
+	Approvals []OpsApprovalRecord `json:"approvals,omitempty"`
+	// Switchover reports the primary before and after a Switchover request.
+	// +optional
+	Switchover *SwitchoverStatus `json:"switchover,omitempty"`
+	// Impact is the expected impact of the request on the database, estimated when it was created.
+	// +optional
+	Impact *OpsRequestImpact `json:"impact,omitempty"`
+	// Canary reports the canary of a request with the canary rollout strategy.
+	// +optional
+	Canary *CanaryStatus `json:"canary,omitempty"`
+}
+
+// OpsRequestImpact is the expected impact of an ops request on its database.
+type OpsRequestImpact struct {
+	// RestartOrder lists the pods the request restarts, in the expected order.
+	// +optional
+	RestartOrder []string `json:"restartOrder,omitempty"`
+	// Failover is true if the primary role is expected to move to another pod.
+	// +optional
+	Failover bool `json:"failover,omitempty"`
+	// Volumes is the number of data volumes resized by a VolumeExpansion or copied by a StorageMigration.
+	// +optional
+	Volumes int32 `json:"volumes,omitempty"`
+	// DataSize is the total capacity of those volumes, i.e. an upper bound of the data a StorageMigration copies.
+	// +optional
+	DataSize *resource.Quantity `json:"dataSize,omitempty"`
+	// PausedBackups are the BackupConfigurations expected to be paused while the request runs.
+	// +optional
+	PausedBackups []kmapi.TypedObjectReference `json:"pausedBackups,omitempty"`
+	// EstimateTime is when the impact was estimated.
+	// +optional
+	EstimateTime *metav1.Time `json:"estimateTime,omitempty"`
+}
+
+// OpsApprovalRecord is an OpsApproval recorded for an ops request.
+type OpsApprovalRecord struct {
+	// Name of the OpsApproval.
+	Name string `json:"name"`
+	// Approver is the identity that made the decision.
+	Approver OpsApprover `json:"approver"`
+	// Decision approves or denies the ops request.
+	Decision OpsApprovalDecision `json:"decision"`
+	// Comment explains the decision.
+	// +optional
+	Comment string `json:"comment,omitempty"`
+	// Policies are the OpsApprovalPolicies that permitted the approver.
+	// +optional
+	Policies []string `json:"policies,omitempty"`
+	// Time is when the decision was made.
+	Time metav1.Time `json:"time"`
+}
+
+// UpdateVersionRollbackSpec enables the capture of the pre-update state of an UpdateVersion request.
+// The update from the current to the target version must be declared reversible in the current
+// version's catalog (spec.updateConstraints.reversible).
+type UpdateVersionRollbackSpec struct {
+	// OnFailure restores the pre-update state if the update fails.
+	// +optional
+	OnFailure bool `json:"onFailure,omitempty"`
+	// VolumeSnapshotClassName takes a VolumeSnapshot of every data volume before the update. Without it,
+	// only the version and the PetSet templates are captured, and data written by the new version
+	// is kept on rollback.
+	// +optional
+	VolumeSnapshotClassName *string `json:"volumeSnapshotClassName,omitempty"`
+}
+
+// RollbackSpec is the spec for rolling back an UpdateVersion request.
+type RollbackSpec struct {
+	// OpsRequestRef refers to the UpdateVersion request, in the same namespace, whose pre-update state is restored.
+	OpsRequestRef core.LocalObjectReference `json:"opsRequestRef"`
+	// RestoreVolumes restores the data volumes from the captured VolumeSnapshots. Data written since
+	// the snapshots were taken is lost.
+	// +optional
+	RestoreVolumes bool `json:"restoreVolumes,omitempty"`
+}
+
+// PreUpdateState is the database state captured before an UpdateVersion request.
+type PreUpdateState struct {
+	// Version is the database version before the update.
+	Version string `json:"version"`
+	// CaptureTime is when the state was captured.
+	// +optional
+	CaptureTime *metav1.Time `json:"captureTime,omitempty"`
+	// PetSetTemplates refers to the Secret, in the ops request namespace, holding the PetSet pod templates
+	// before the update, keyed by PetSet name.
+	// +optional
+	PetSetTemplates *core.LocalObjectReference `json:"petSetTemplates,omitempty"`
+	// VolumeSnapshots are the VolumeSnapshots of the data volumes, keyed by PVC name.
+	// +optional
+	VolumeSnapshots map[string]string `json:"volumeSnapshots,omitempty"`
+	// RolledBack is true once the state has been restored.
+	// +optional
+	RolledBack bool `json:"rolledBack,omitempty"`
+}
+
+// SwitchoverSpec is the spec for a planned switchover of the primary role.
+type SwitchoverSpec struct {
+	// Target is the pod to hand the primary role over to. The member with the least replication lag
+	// is chosen when empty.
+	// +optional
+	Target *core.LocalObjectReference `json:"target,omitempty"`
+	// MaxLag is the replication lag the target may have behind the primary. The switchover waits until
+	// the lag of the target drops within it, and fails if that does not happen before the timeout.
+	// +optional
+	// +kubebuilder:default="10s"
+	MaxLag *metav1.Duration `json:"maxLag,omitempty"`
+}
+
+// SwitchoverStatus reports the primary before and after a Switchover request.
+type SwitchoverStatus struct {
+	// OldPrimary is the pod that held the primary role before the switchover.
+	// +optional
+	OldPrimary string `json:"oldPrimary,omitempty"`
+	// NewPrimary is the pod that holds the primary role after the switchover.
+	// +optional
+	NewPrimary string `json:"newPrimary,omitempty"`
+	// Lag is the replication lag of the new primary measured right before the switchover.
+	// +optional
+	Lag *metav1.Duration `json:"lag,omitempty"`
+	// Time is when the primary role was handed over.
+	// +optional
+	Time *metav1.Time `json:"time,omitempty"`
+}
+
+// RolloutStrategy selects how a restart inducing ops request is rolled through the pods of the database.
+type RolloutStrategy struct {
+	// Canary applies the change to one replica first and continues with the rest only after the
+	// replica passes the health checks.
+	// +optional
+	Canary *CanaryRolloutStrategy `json:"canary,omitempty"`
+}
+
+// CanaryRolloutStrategy applies the change to one replica, a secondary if there is one, waits for
+// the bake time and checks the replica before the change is rolled out to the other pods.
+type CanaryRolloutStrategy struct {
+	// BakeTime is how long the canary runs with the change before it is checked.
+	// +optional
+	// +kubebuilder:default="5m"
+	BakeTime *metav1.Duration `json:"bakeTime,omitempty"`
+	// HealthCheck configures the checks of the canary during the bake time. The health checker of
+	// the database is used when unset.
+	// +optional
+	HealthCheck *kmapi.HealthCheckSpec `json:"healthCheck,omitempty"`
+	// MaxReplicationLag is the replication lag the canary may have behind the primary at the end of
+	// the bake time. Replication lag is not checked when unset, or when the canary is the primary.
+	// +optional
+	MaxReplicationLag *metav1.Duration `json:"maxReplicationLag,omitempty"`
+	// OnFailure decides what happens when the canary fails a check. Pause keeps the ops request
+	// Progressing until it is deleted or the canary recovers; Revert restores the canary and fails
+	// the ops request.
+	// +optional
+	// +kubebuilder:default="Pause"
+	OnFailure CanaryFailurePolicy `json:"onFailure,omitempty"`
+}
+
+// +kubebuilder:validation:Enum=Pause;Revert
+type CanaryFailurePolicy string
+
+const (
+	CanaryFailurePolicyPause  CanaryFailurePolicy = "Pause"
+	CanaryFailurePolicyRevert CanaryFailurePolicy = "Revert"
+)
+
+// +kubebuilder:validation:Enum=Baking;Passed;Failed;Reverted
+type CanaryPhase string
+
+const (
+	CanaryPhaseBaking   CanaryPhase = "Baking"
+	CanaryPhasePassed   CanaryPhase = "Passed"
+	CanaryPhaseFailed   CanaryPhase = "Failed"
+	CanaryPhaseReverted CanaryPhase = "Reverted"
+)
+
+// CanaryStatus reports the canary of an ops request with the canary rollout strategy.
+type CanaryStatus struct {
+	// Pod is the replica the change was applied to first.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+	// Phase is the state of the canary.
+	// +optional
+	Phase CanaryPhase `json:"phase,omitempty"`
+	// BakeStartTime is when the canary started running with the change.
+	// +optional
+	BakeStartTime *metav1.Time `json:"bakeStartTime,omitempty"`
+	// ReplicationLag is the replication lag of the canary measured at the last check.
+	// +optional
+	ReplicationLag *metav1.Duration `json:"replicationLag,omitempty"`
+	// Message explains the result of the last check.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:validation:Enum=Pending;Progressing;Successful;WaitingForApproval;Failed;Approved;Denied;Skipped
@@ -29,6 +29,8 @@ const (
 	WeaviateOpsRequestTypeRotateAuth WeaviateOpsRequestType = "RotateAuth"
 	// WeaviateOpsRequestTypeStorageMigration is a WeaviateOpsRequestType of type StorageMigration.
 	WeaviateOpsRequestTypeStorageMigration WeaviateOpsRequestType = "StorageMigration"
+	// WeaviateOpsRequestTypeRollback is a WeaviateOpsRequestType of type Rollback.
+	WeaviateOpsRequestTypeRollback WeaviateOpsRequestType = "Rollback"
 )
 
 var ErrInvalidWeaviateOpsRequestType = fmt.Errorf("not a valid WeaviateOpsRequestType, try [%s]", strings.Join(_WeaviateOpsRequestTypeNames, ", "))
@@ -43,6 +45,7 @@ var _WeaviateOpsRequestTypeNames = []string{
 	string(WeaviateOpsRequestTypeReconfigureTLS),
 	string(WeaviateOpsRequestTypeRotateAuth),
 	string(WeaviateOpsRequestTypeStorageMigration),
+	string(WeaviateOpsRequestTypeRollback),
 }
 
 // WeaviateOpsRequestTypeNames returns a list of possible string values of WeaviateOpsRequestType.
@@ -64,6 +67,7 @@ func WeaviateOpsRequestTypeValues() []WeaviateOpsRequestType {
 		WeaviateOpsRequestTypeReconfigureTLS,
 		WeaviateOpsRequestTypeRotateAuth,
 		WeaviateOpsRequestTypeStorageMigration,
+		WeaviateOpsRequestTypeRollback,
 	}
 }
 
@@ -89,6 +93,7 @@ var _WeaviateOpsRequestTypeValue = map[string]WeaviateOpsRequestType{
 	"ReconfigureTLS":    WeaviateOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        WeaviateOpsRequestTypeRotateAuth,
 	"StorageMigration":  WeaviateOpsRequestTypeStorageMigration,
+	"Rollback":          WeaviateOpsRequestTypeRollback,
 }
 
 // ParseWeaviateOpsRequestType attempts to convert a string to a WeaviateOpsRequestType.
@@ -27,6 +27,10 @@ const (
 	ZooKeeperOpsRequestTypeReconfigureTLS ZooKeeperOpsRequestType = "ReconfigureTLS"
 	// ZooKeeperOpsRequestTypeRotateAuth is a ZooKeeperOpsRequestType of type RotateAuth.
 	ZooKeeperOpsRequestTypeRotateAuth ZooKeeperOpsRequestType = "RotateAuth"
+	// ZooKeeperOpsRequestTypeRollback is a ZooKeeperOpsRequestType of type Rollback.
+	ZooKeeperOpsRequestTypeRollback ZooKeeperOpsRequestType = "Rollback"
+	// ZooKeeperOpsRequestTypeStorageMigration is a ZooKeeperOpsRequestType of type StorageMigration.
+	ZooKeeperOpsRequestTypeStorageMigration ZooKeeperOpsRequestType = "StorageMigration"
 )
 
 var ErrInvalidZooKeeperOpsRequestType = fmt.Errorf("not a valid ZooKeeperOpsRequestType, try [%s]", strings.Join(_ZooKeeperOpsRequestTypeNames, ", "))
@@ -40,6 +44,8 @@ var _ZooKeeperOpsRequestTypeNames = []string{
 	string(ZooKeeperOpsRequestTypeReconfigure),
 	string(ZooKeeperOpsRequestTypeReconfigureTLS),
 	string(ZooKeeperOpsRequestTypeRotateAuth),
+	string(ZooKeeperOpsRequestTypeRollback),
+	string(ZooKeeperOpsRequestTypeStorageMigration),
 }
 
 // ZooKeeperOpsRequestTypeNames returns a list of possible string values of ZooKeeperOpsRequestType.
@@ -60,6 +66,8 @@ func ZooKeeperOpsRequestTypeValues() []ZooKeeperOpsRequestType {
 		ZooKeeperOpsRequestTypeReconfigure,
 		ZooKeeperOpsRequestTypeReconfigureTLS,
 		ZooKeeperOpsRequestTypeRotateAuth,
+		ZooKeeperOpsRequestTypeRollback,
+		ZooKeeperOpsRequestTypeStorageMigration,
 	}
 }
 
@@ -84,6 +92,8 @@ var _ZooKeeperOpsRequestTypeValue = map[string]ZooKeeperOpsRequestType{
 	"Reconfigure":       ZooKeeperOpsRequestTypeReconfigure,
 	"ReconfigureTLS":    ZooKeeperOpsRequestTypeReconfigureTLS,
 	"RotateAuth":        ZooKeeperOpsRequestTypeRotateAuth,
+	"Rollback":          ZooKeeperOpsRequestTypeRollback,
+	"StorageMigration":  ZooKeeperOpsRequestTypeStorageMigration,
 }
 
 // ParseZooKeeperOpsRequestType attempts to convert a string to a ZooKeeperOpsRequestType.
@@ -26,6 +26,7 @@ import (
 	v1alpha2 "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
 
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	clientgoapiv1 "kmodules.xyz/client-go/api/v1"
@@ -33,6 +34,32 @@ import (
 	apiv1 "kmodules.xyz/offshoot-api/api/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeHorizontalScalingSpec) DeepCopyInto(out *AerospikeHorizontalScalingSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MigrationTimeout != nil {
+		in, out := &in.MigrationTimeout, &out.MigrationTimeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeHorizontalScalingSpec.
+func (in *AerospikeHorizontalScalingSpec) DeepCopy() *AerospikeHorizontalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeHorizontalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AerospikeOpsRequest) DeepCopyInto(out *AerospikeOpsRequest) {
 	*out = *in
@@ -98,16 +125,61 @@ func (in *AerospikeOpsRequestList) DeepCopyObject() runtime.Object {
 func (in *AerospikeOpsRequestSpec) DeepCopyInto(out *AerospikeOpsRequestSpec) {
 	*out = *in
 	out.DatabaseRef = in.DatabaseRef
+	if in.UpdateVersion != nil {
+		in, out := &in.UpdateVersion, &out.UpdateVersion
+		*out = new(AerospikeUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
+	if in.HorizontalScaling != nil {
+		in, out := &in.HorizontalScaling, &out.HorizontalScaling
+		*out = new(AerospikeHorizontalScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VerticalScaling != nil {
 		in, out := &in.VerticalScaling, &out.VerticalScaling
 		*out = new(AerospikeVerticalScalingSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeExpansion != nil {
+		in, out := &in.VolumeExpansion, &out.VolumeExpansion
+		*out = new(AerospikeVolumeExpansionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(ReconfigurationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(StorageMigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Restart != nil {
 		in, out := &in.Restart, &out.Restart
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -126,6 +198,27 @@ func (in *AerospikeOpsRequestSpec) DeepCopy() *AerospikeOpsRequestSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeUpdateVersionSpec) DeepCopyInto(out *AerospikeUpdateVersionSpec) {
+	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeUpdateVersionSpec.
+func (in *AerospikeUpdateVersionSpec) DeepCopy() *AerospikeUpdateVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeUpdateVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AerospikeVerticalScalingSpec) DeepCopyInto(out *AerospikeVerticalScalingSpec) {
 	*out = *in
@@ -152,6 +245,27 @@ func (in *AerospikeVerticalScalingSpec) DeepCopy() *AerospikeVerticalScalingSpec
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AerospikeVolumeExpansionSpec) DeepCopyInto(out *AerospikeVolumeExpansionSpec) {
+	*out = *in
+	if in.Aerospike != nil {
+		in, out := &in.Aerospike, &out.Aerospike
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AerospikeVolumeExpansionSpec.
+func (in *AerospikeVolumeExpansionSpec) DeepCopy() *AerospikeVolumeExpansionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AerospikeVolumeExpansionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Announce) DeepCopyInto(out *Announce) {
 	*out = *in
@@ -213,6 +327,62 @@ func (in *AuthSpec) DeepCopy() *AuthSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryRolloutStrategy) DeepCopyInto(out *CanaryRolloutStrategy) {
+	*out = *in
+	if in.BakeTime != nil {
+		in, out := &in.BakeTime, &out.BakeTime
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(clientgoapiv1.HealthCheckSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxReplicationLag != nil {
+		in, out := &in.MaxReplicationLag, &out.MaxReplicationLag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryRolloutStrategy.
+func (in *CanaryRolloutStrategy) DeepCopy() *CanaryRolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryRolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CanaryStatus) DeepCopyInto(out *CanaryStatus) {
+	*out = *in
+	if in.BakeStartTime != nil {
+		in, out := &in.BakeStartTime, &out.BakeStartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ReplicationLag != nil {
+		in, out := &in.ReplicationLag, &out.ReplicationLag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CanaryStatus.
+func (in *CanaryStatus) DeepCopy() *CanaryStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CanaryStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CassandraCustomConfigurationSpec) DeepCopyInto(out *CassandraCustomConfigurationSpec) {
 	*out = *in
@@ -340,6 +510,11 @@ func (in *CassandraOpsRequestSpec) DeepCopyInto(out *CassandraOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(CassandraUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.VerticalScaling != nil {
@@ -362,6 +537,11 @@ func (in *CassandraOpsRequestSpec) DeepCopyInto(out *CassandraOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -398,6 +578,11 @@ func (in *CassandraOpsRequestSpec) DeepCopy() *CassandraOpsRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CassandraUpdateVersionSpec) DeepCopyInto(out *CassandraUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -593,6 +778,11 @@ func (in *ClickHouseOpsRequestSpec) DeepCopyInto(out *ClickHouseOpsRequestSpec)
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(ClickHouseUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.VerticalScaling != nil {
@@ -615,6 +805,11 @@ func (in *ClickHouseOpsRequestSpec) DeepCopyInto(out *ClickHouseOpsRequestSpec)
 		*out = new(ClickHouseMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -658,6 +853,11 @@ func (in *ClickHouseTLSSpec) DeepCopy() *ClickHouseTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClickHouseUpdateVersionSpec) DeepCopyInto(out *ClickHouseUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -746,6 +946,27 @@ func (in *ContainerResources) DeepCopy() *ContainerResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DB2HorizontalScalingSpec) DeepCopyInto(out *DB2HorizontalScalingSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DB2HorizontalScalingSpec.
+func (in *DB2HorizontalScalingSpec) DeepCopy() *DB2HorizontalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DB2HorizontalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DB2OpsRequest) DeepCopyInto(out *DB2OpsRequest) {
 	*out = *in
@@ -811,16 +1032,61 @@ func (in *DB2OpsRequestList) DeepCopyObject() runtime.Object {
 func (in *DB2OpsRequestSpec) DeepCopyInto(out *DB2OpsRequestSpec) {
 	*out = *in
 	out.DatabaseRef = in.DatabaseRef
+	if in.UpdateVersion != nil {
+		in, out := &in.UpdateVersion, &out.UpdateVersion
+		*out = new(DB2UpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
+	if in.HorizontalScaling != nil {
+		in, out := &in.HorizontalScaling, &out.HorizontalScaling
+		*out = new(DB2HorizontalScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VerticalScaling != nil {
 		in, out := &in.VerticalScaling, &out.VerticalScaling
 		*out = new(DB2VerticalScalingSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VolumeExpansion != nil {
+		in, out := &in.VolumeExpansion, &out.VolumeExpansion
+		*out = new(DB2VolumeExpansionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Configuration != nil {
+		in, out := &in.Configuration, &out.Configuration
+		*out = new(ReconfigurationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Authentication != nil {
+		in, out := &in.Authentication, &out.Authentication
+		*out = new(AuthSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(StorageMigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Restart != nil {
 		in, out := &in.Restart, &out.Restart
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -839,6 +1105,27 @@ func (in *DB2OpsRequestSpec) DeepCopy() *DB2OpsRequestSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DB2UpdateVersionSpec) DeepCopyInto(out *DB2UpdateVersionSpec) {
+	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DB2UpdateVersionSpec.
+func (in *DB2UpdateVersionSpec) DeepCopy() *DB2UpdateVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DB2UpdateVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DB2VerticalScalingSpec) DeepCopyInto(out *DB2VerticalScalingSpec) {
 	*out = *in
@@ -865,6 +1152,27 @@ func (in *DB2VerticalScalingSpec) DeepCopy() *DB2VerticalScalingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DB2VolumeExpansionSpec) DeepCopyInto(out *DB2VolumeExpansionSpec) {
+	*out = *in
+	if in.DB2 != nil {
+		in, out := &in.DB2, &out.DB2
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DB2VolumeExpansionSpec.
+func (in *DB2VolumeExpansionSpec) DeepCopy() *DB2VolumeExpansionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DB2VolumeExpansionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DocumentDBCustomConfiguration) DeepCopyInto(out *DocumentDBCustomConfiguration) {
 	*out = *in
@@ -1063,6 +1371,11 @@ func (in *DocumentDBOpsRequestSpec) DeepCopyInto(out *DocumentDBOpsRequestSpec)
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(DocumentDBUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -1120,6 +1433,11 @@ func (in *DocumentDBOpsRequestSpec) DeepCopyInto(out *DocumentDBOpsRequestSpec)
 		*out = new(DocumentDBMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -1305,6 +1623,11 @@ func (in *DocumentDBTuningConfig) DeepCopy() *DocumentDBTuningConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DocumentDBUpdateVersionSpec) DeepCopyInto(out *DocumentDBUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1547,6 +1870,11 @@ func (in *DruidOpsRequestSpec) DeepCopyInto(out *DruidOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(DruidUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.Configuration != nil {
@@ -1564,6 +1892,11 @@ func (in *DruidOpsRequestSpec) DeepCopyInto(out *DruidOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -1585,6 +1918,11 @@ func (in *DruidOpsRequestSpec) DeepCopy() *DruidOpsRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DruidUpdateVersionSpec) DeepCopyInto(out *DruidUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1939,6 +2277,11 @@ func (in *ElasticsearchOpsRequestSpec) DeepCopyInto(out *ElasticsearchOpsRequest
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(ElasticsearchUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -1981,6 +2324,11 @@ func (in *ElasticsearchOpsRequestSpec) DeepCopyInto(out *ElasticsearchOpsRequest
 		*out = new(ElasticsearchMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -2051,6 +2399,11 @@ func (in *ElasticsearchReplicaReadinessCriteria) DeepCopy() *ElasticsearchReplic
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ElasticsearchUpdateVersionSpec) DeepCopyInto(out *ElasticsearchUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2221,6 +2574,27 @@ func (in *ElasticsearchVolumeExpansionSpec) DeepCopy() *ElasticsearchVolumeExpan
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HanaDBHorizontalScalingSpec) DeepCopyInto(out *HanaDBHorizontalScalingSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HanaDBHorizontalScalingSpec.
+func (in *HanaDBHorizontalScalingSpec) DeepCopy() *HanaDBHorizontalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HanaDBHorizontalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HanaDBOpsRequest) DeepCopyInto(out *HanaDBOpsRequest) {
 	*out = *in
@@ -2286,8 +2660,23 @@ func (in *HanaDBOpsRequestList) DeepCopyObject() runtime.Object {
 func (in *HanaDBOpsRequestSpec) DeepCopyInto(out *HanaDBOpsRequestSpec) {
 	*out = *in
 	out.DatabaseRef = in.DatabaseRef
-	if in.VerticalScaling != nil {
-		in, out := &in.VerticalScaling, &out.VerticalScaling
+	if in.UpdateVersion != nil {
+		in, out := &in.UpdateVersion, &out.UpdateVersion
+		*out = new(HanaDBUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
+	if in.HorizontalScaling != nil {
+		in, out := &in.HorizontalScaling, &out.HorizontalScaling
+		*out = new(HanaDBHorizontalScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VerticalScaling != nil {
+		in, out := &in.VerticalScaling, &out.VerticalScaling
 		*out = new(HanaDBVerticalScalingSpec)
 		(*in).DeepCopyInto(*out)
 	}
@@ -2311,6 +2700,11 @@ func (in *HanaDBOpsRequestSpec) DeepCopyInto(out *HanaDBOpsRequestSpec) {
 		*out = new(AuthSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Restart != nil {
 		in, out := &in.Restart, &out.Restart
 		*out = new(RestartSpec)
@@ -2356,6 +2750,27 @@ func (in *HanaDBTLSSpec) DeepCopy() *HanaDBTLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HanaDBUpdateVersionSpec) DeepCopyInto(out *HanaDBUpdateVersionSpec) {
+	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HanaDBUpdateVersionSpec.
+func (in *HanaDBUpdateVersionSpec) DeepCopy() *HanaDBUpdateVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HanaDBUpdateVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HanaDBVerticalScalingSpec) DeepCopyInto(out *HanaDBVerticalScalingSpec) {
 	*out = *in
@@ -2497,6 +2912,11 @@ func (in *HazelcastOpsRequestSpec) DeepCopyInto(out *HazelcastOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(HazelcastUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -2539,6 +2959,11 @@ func (in *HazelcastOpsRequestSpec) DeepCopyInto(out *HazelcastOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -2560,6 +2985,11 @@ func (in *HazelcastOpsRequestSpec) DeepCopy() *HazelcastOpsRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HazelcastUpdateVersionSpec) DeepCopyInto(out *HazelcastUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2741,6 +3171,11 @@ func (in *IgniteOpsRequestSpec) DeepCopyInto(out *IgniteOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(IgniteUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -2783,6 +3218,11 @@ func (in *IgniteOpsRequestSpec) DeepCopyInto(out *IgniteOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -2804,6 +3244,11 @@ func (in *IgniteOpsRequestSpec) DeepCopy() *IgniteOpsRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IgniteUpdateVersionSpec) DeepCopyInto(out *IgniteUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3010,6 +3455,11 @@ func (in *KafkaOpsRequestSpec) DeepCopyInto(out *KafkaOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(KafkaUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -3052,6 +3502,11 @@ func (in *KafkaOpsRequestSpec) DeepCopyInto(out *KafkaOpsRequestSpec) {
 		*out = new(KafkaMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -3089,6 +3544,11 @@ func (in *KafkaReplicaReadinessCriteria) DeepCopy() *KafkaReplicaReadinessCriter
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KafkaUpdateVersionSpec) DeepCopyInto(out *KafkaUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3253,6 +3713,11 @@ func (in *MSSQLServerOpsRequestSpec) DeepCopyInto(out *MSSQLServerOpsRequestSpec
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(MSSQLServerUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -3295,6 +3760,11 @@ func (in *MSSQLServerOpsRequestSpec) DeepCopyInto(out *MSSQLServerOpsRequestSpec
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -3349,6 +3819,11 @@ func (in *MSSQLServerTLSSpec) DeepCopy() *MSSQLServerTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MSSQLServerUpdateVersionSpec) DeepCopyInto(out *MSSQLServerUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3419,6 +3894,183 @@ func (in *MSSQLServerVolumeExpansionSpec) DeepCopy() *MSSQLServerVolumeExpansion
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceDateWindow) DeepCopyInto(out *MaintenanceDateWindow) {
+	*out = *in
+	in.Start.DeepCopyInto(&out.Start)
+	in.End.DeepCopyInto(&out.End)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceDateWindow.
+func (in *MaintenanceDateWindow) DeepCopy() *MaintenanceDateWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceDateWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceTimeWindow) DeepCopyInto(out *MaintenanceTimeWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]MaintenanceDay, len(*in))
+		copy(*out, *in)
+	}
+	out.Duration = in.Duration
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceTimeWindow.
+func (in *MaintenanceTimeWindow) DeepCopy() *MaintenanceTimeWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceTimeWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindow) DeepCopyInto(out *MaintenanceWindow) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindow.
+func (in *MaintenanceWindow) DeepCopy() *MaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindow) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowList) DeepCopyInto(out *MaintenanceWindowList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MaintenanceWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowList.
+func (in *MaintenanceWindowList) DeepCopy() *MaintenanceWindowList {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MaintenanceWindowList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowSpec) DeepCopyInto(out *MaintenanceWindowSpec) {
+	*out = *in
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(OpsDatabaseSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Windows != nil {
+		in, out := &in.Windows, &out.Windows
+		*out = make([]MaintenanceTimeWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Dates != nil {
+		in, out := &in.Dates, &out.Dates
+		*out = make([]MaintenanceDateWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.OpsTypes != nil {
+		in, out := &in.OpsTypes, &out.OpsTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]OpsRequestSource, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowSpec.
+func (in *MaintenanceWindowSpec) DeepCopy() *MaintenanceWindowSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MaintenanceWindowStatus) DeepCopyInto(out *MaintenanceWindowStatus) {
+	*out = *in
+	if in.CloseTime != nil {
+		in, out := &in.CloseTime, &out.CloseTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NextOpenTime != nil {
+		in, out := &in.NextOpenTime, &out.NextOpenTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]clientgoapiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MaintenanceWindowStatus.
+func (in *MaintenanceWindowStatus) DeepCopy() *MaintenanceWindowStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MaintenanceWindowStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MariaDBCustomConfiguration) DeepCopyInto(out *MariaDBCustomConfiguration) {
 	*out = *in
@@ -3557,6 +4209,11 @@ func (in *MariaDBOpsRequestSpec) DeepCopyInto(out *MariaDBOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(MariaDBUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -3594,11 +4251,21 @@ func (in *MariaDBOpsRequestSpec) DeepCopyInto(out *MariaDBOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Migration != nil {
 		in, out := &in.Migration, &out.Migration
 		*out = new(MariaDBMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -3658,6 +4325,11 @@ func (in *MariaDBTLSSpec) DeepCopy() *MariaDBTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MariaDBUpdateVersionSpec) DeepCopyInto(out *MariaDBUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3880,6 +4552,11 @@ func (in *MemcachedOpsRequestSpec) DeepCopyInto(out *MemcachedOpsRequestSpec) {
 		*out = new(MemcachedUpdateVersionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
 	if in.HorizontalScaling != nil {
 		in, out := &in.HorizontalScaling, &out.HorizontalScaling
 		*out = new(MemcachedHorizontalScalingSpec)
@@ -3915,6 +4592,16 @@ func (in *MemcachedOpsRequestSpec) DeepCopyInto(out *MemcachedOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(StorageMigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -3957,6 +4644,11 @@ func (in *MemcachedUpdateVersionSpec) DeepCopyInto(out *MemcachedUpdateVersionSp
 		*out = new(MemcachedReplicaReadinessCriteria)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4147,6 +4839,11 @@ func (in *MilvusOpsRequestSpec) DeepCopyInto(out *MilvusOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(MilvusUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -4189,6 +4886,11 @@ func (in *MilvusOpsRequestSpec) DeepCopyInto(out *MilvusOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -4243,6 +4945,11 @@ func (in *MilvusTLSSpec) DeepCopy() *MilvusTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MilvusUpdateVersionSpec) DeepCopyInto(out *MilvusUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4478,6 +5185,11 @@ func (in *MongoDBOpsRequestSpec) DeepCopyInto(out *MongoDBOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(MongoDBUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -4515,6 +5227,11 @@ func (in *MongoDBOpsRequestSpec) DeepCopyInto(out *MongoDBOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Reprovision != nil {
 		in, out := &in.Reprovision, &out.Reprovision
 		*out = new(Reprovision)
@@ -4540,6 +5257,11 @@ func (in *MongoDBOpsRequestSpec) DeepCopyInto(out *MongoDBOpsRequestSpec) {
 		*out = new(MongoDBReplicaReadinessCriteria)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -4644,6 +5366,11 @@ func (in *MongoDBShardNode) DeepCopy() *MongoDBShardNode {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MongoDBUpdateVersionSpec) DeepCopyInto(out *MongoDBUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4866,6 +5593,11 @@ func (in *MySQLOpsRequestSpec) DeepCopyInto(out *MySQLOpsRequestSpec) {
 		*out = new(MySQLUpdateVersionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
 	if in.HorizontalScaling != nil {
 		in, out := &in.HorizontalScaling, &out.HorizontalScaling
 		*out = new(MySQLHorizontalScalingSpec)
@@ -4906,11 +5638,21 @@ func (in *MySQLOpsRequestSpec) DeepCopyInto(out *MySQLOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Migration != nil {
 		in, out := &in.Migration, &out.Migration
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -5029,6 +5771,11 @@ func (in *MySQLUpdateVersionSpec) DeepCopyInto(out *MySQLUpdateVersionSpec) {
 		*out = new(MySQLReplicaReadinessCriteria)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5246,6 +5993,11 @@ func (in *Neo4jOpsRequestSpec) DeepCopyInto(out *Neo4jOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(Neo4jUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.Migration != nil {
@@ -5253,6 +6005,11 @@ func (in *Neo4jOpsRequestSpec) DeepCopyInto(out *Neo4jOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -5291,6 +6048,11 @@ func (in *Neo4jTLSSpec) DeepCopy() *Neo4jTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Neo4jUpdateVersionSpec) DeepCopyInto(out *Neo4jUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5315,33 +6077,682 @@ func (in *Neo4jVerticalScalingSpec) DeepCopyInto(out *Neo4jVerticalScalingSpec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jVerticalScalingSpec.
-func (in *Neo4jVerticalScalingSpec) DeepCopy() *Neo4jVerticalScalingSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jVerticalScalingSpec.
+func (in *Neo4jVerticalScalingSpec) DeepCopy() *Neo4jVerticalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jVerticalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Neo4jVolumeExpansionSpec) DeepCopyInto(out *Neo4jVolumeExpansionSpec) {
+	*out = *in
+	if in.Server != nil {
+		in, out := &in.Server, &out.Server
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jVolumeExpansionSpec.
+func (in *Neo4jVolumeExpansionSpec) DeepCopy() *Neo4jVolumeExpansionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(Neo4jVolumeExpansionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApproval) DeepCopyInto(out *OpsApproval) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApproval.
+func (in *OpsApproval) DeepCopy() *OpsApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsApproval) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalList) DeepCopyInto(out *OpsApprovalList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpsApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalList.
+func (in *OpsApprovalList) DeepCopy() *OpsApprovalList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsApprovalList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalPolicy) DeepCopyInto(out *OpsApprovalPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalPolicy.
+func (in *OpsApprovalPolicy) DeepCopy() *OpsApprovalPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsApprovalPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalPolicyList) DeepCopyInto(out *OpsApprovalPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpsApprovalPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalPolicyList.
+func (in *OpsApprovalPolicyList) DeepCopy() *OpsApprovalPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsApprovalPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalPolicySpec) DeepCopyInto(out *OpsApprovalPolicySpec) {
+	*out = *in
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(OpsDatabaseSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OpsTypes != nil {
+		in, out := &in.OpsTypes, &out.OpsTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]OpsRequestSource, len(*in))
+		copy(*out, *in)
+	}
+	if in.Approvers != nil {
+		in, out := &in.Approvers, &out.Approvers
+		*out = make([]rbacv1.Subject, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalPolicySpec.
+func (in *OpsApprovalPolicySpec) DeepCopy() *OpsApprovalPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalPolicyStatus) DeepCopyInto(out *OpsApprovalPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]clientgoapiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalPolicyStatus.
+func (in *OpsApprovalPolicyStatus) DeepCopy() *OpsApprovalPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalRecord) DeepCopyInto(out *OpsApprovalRecord) {
+	*out = *in
+	in.Approver.DeepCopyInto(&out.Approver)
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Time.DeepCopyInto(&out.Time)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalRecord.
+func (in *OpsApprovalRecord) DeepCopy() *OpsApprovalRecord {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalRecord)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalSpec) DeepCopyInto(out *OpsApprovalSpec) {
+	*out = *in
+	in.OpsRequestRef.DeepCopyInto(&out.OpsRequestRef)
+	if in.Approver != nil {
+		in, out := &in.Approver, &out.Approver
+		*out = new(OpsApprover)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalSpec.
+func (in *OpsApprovalSpec) DeepCopy() *OpsApprovalSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprovalStatus) DeepCopyInto(out *OpsApprovalStatus) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]clientgoapiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprovalStatus.
+func (in *OpsApprovalStatus) DeepCopy() *OpsApprovalStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprovalStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsApprover) DeepCopyInto(out *OpsApprover) {
+	*out = *in
+	if in.Groups != nil {
+		in, out := &in.Groups, &out.Groups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsApprover.
+func (in *OpsApprover) DeepCopy() *OpsApprover {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsApprover)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsConcurrencyLimit) DeepCopyInto(out *OpsConcurrencyLimit) {
+	*out = *in
+	if in.OpsTypes != nil {
+		in, out := &in.OpsTypes, &out.OpsTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsConcurrencyLimit.
+func (in *OpsConcurrencyLimit) DeepCopy() *OpsConcurrencyLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsConcurrencyLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsConcurrencyPolicy) DeepCopyInto(out *OpsConcurrencyPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsConcurrencyPolicy.
+func (in *OpsConcurrencyPolicy) DeepCopy() *OpsConcurrencyPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsConcurrencyPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsConcurrencyPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsConcurrencyPolicyList) DeepCopyInto(out *OpsConcurrencyPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpsConcurrencyPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsConcurrencyPolicyList.
+func (in *OpsConcurrencyPolicyList) DeepCopy() *OpsConcurrencyPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsConcurrencyPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsConcurrencyPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsConcurrencyPolicySpec) DeepCopyInto(out *OpsConcurrencyPolicySpec) {
+	*out = *in
+	if in.DatabaseSelector != nil {
+		in, out := &in.DatabaseSelector, &out.DatabaseSelector
+		*out = new(OpsDatabaseSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Limits != nil {
+		in, out := &in.Limits, &out.Limits
+		*out = make([]OpsConcurrencyLimit, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsConcurrencyPolicySpec.
+func (in *OpsConcurrencyPolicySpec) DeepCopy() *OpsConcurrencyPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsConcurrencyPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsConcurrencyPolicyStatus) DeepCopyInto(out *OpsConcurrencyPolicyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]clientgoapiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsConcurrencyPolicyStatus.
+func (in *OpsConcurrencyPolicyStatus) DeepCopy() *OpsConcurrencyPolicyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsConcurrencyPolicyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsDatabaseSelector) DeepCopyInto(out *OpsDatabaseSelector) {
+	*out = *in
+	if in.Kinds != nil {
+		in, out := &in.Kinds, &out.Kinds
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsDatabaseSelector.
+func (in *OpsDatabaseSelector) DeepCopy() *OpsDatabaseSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsDatabaseSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsPlan) DeepCopyInto(out *OpsPlan) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsPlan.
+func (in *OpsPlan) DeepCopy() *OpsPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsPlan) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsPlanList) DeepCopyInto(out *OpsPlanList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpsPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsPlanList.
+func (in *OpsPlanList) DeepCopy() *OpsPlanList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsPlanList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpsPlanList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsPlanSpec) DeepCopyInto(out *OpsPlanSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]OpsPlanStep, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsPlanSpec.
+func (in *OpsPlanSpec) DeepCopy() *OpsPlanSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsPlanSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsPlanStatus) DeepCopyInto(out *OpsPlanStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]OpsPlanStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]clientgoapiv1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsPlanStatus.
+func (in *OpsPlanStatus) DeepCopy() *OpsPlanStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsPlanStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsPlanStep) DeepCopyInto(out *OpsPlanStep) {
+	*out = *in
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Request.DeepCopyInto(&out.Request)
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsPlanStep.
+func (in *OpsPlanStep) DeepCopy() *OpsPlanStep {
+	if in == nil {
+		return nil
+	}
+	out := new(OpsPlanStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpsPlanStepStatus) DeepCopyInto(out *OpsPlanStepStatus) {
+	*out = *in
+	if in.OpsRequestRef != nil {
+		in, out := &in.OpsRequestRef, &out.OpsRequestRef
+		*out = new(clientgoapiv1.TypedObjectReference)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RollbackRef != nil {
+		in, out := &in.RollbackRef, &out.RollbackRef
+		*out = new(clientgoapiv1.TypedObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsPlanStepStatus.
+func (in *OpsPlanStepStatus) DeepCopy() *OpsPlanStepStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jVerticalScalingSpec)
+	out := new(OpsPlanStepStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Neo4jVolumeExpansionSpec) DeepCopyInto(out *Neo4jVolumeExpansionSpec) {
+func (in *OpsRequestImpact) DeepCopyInto(out *OpsRequestImpact) {
 	*out = *in
-	if in.Server != nil {
-		in, out := &in.Server, &out.Server
+	if in.RestartOrder != nil {
+		in, out := &in.RestartOrder, &out.RestartOrder
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DataSize != nil {
+		in, out := &in.DataSize, &out.DataSize
 		x := (*in).DeepCopy()
 		*out = &x
 	}
+	if in.PausedBackups != nil {
+		in, out := &in.PausedBackups, &out.PausedBackups
+		*out = make([]clientgoapiv1.TypedObjectReference, len(*in))
+		copy(*out, *in)
+	}
+	if in.EstimateTime != nil {
+		in, out := &in.EstimateTime, &out.EstimateTime
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Neo4jVolumeExpansionSpec.
-func (in *Neo4jVolumeExpansionSpec) DeepCopy() *Neo4jVolumeExpansionSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpsRequestImpact.
+func (in *OpsRequestImpact) DeepCopy() *OpsRequestImpact {
 	if in == nil {
 		return nil
 	}
-	out := new(Neo4jVolumeExpansionSpec)
+	out := new(OpsRequestImpact)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -5361,6 +6772,33 @@ func (in *OpsRequestStatus) DeepCopyInto(out *OpsRequestStatus) {
 		*out = make([]clientgoapiv1.TypedObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	if in.PreUpdateState != nil {
+		in, out := &in.PreUpdateState, &out.PreUpdateState
+		*out = new(PreUpdateState)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]OpsApprovalRecord, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Impact != nil {
+		in, out := &in.Impact, &out.Impact
+		*out = new(OpsRequestImpact)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5374,6 +6812,27 @@ func (in *OpsRequestStatus) DeepCopy() *OpsRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OracleHorizontalScalingSpec) DeepCopyInto(out *OracleHorizontalScalingSpec) {
+	*out = *in
+	if in.Replicas != nil {
+		in, out := &in.Replicas, &out.Replicas
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OracleHorizontalScalingSpec.
+func (in *OracleHorizontalScalingSpec) DeepCopy() *OracleHorizontalScalingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OracleHorizontalScalingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OracleMigrationSpec) DeepCopyInto(out *OracleMigrationSpec) {
 	*out = *in
@@ -5460,6 +6919,21 @@ func (in *OracleOpsRequestList) DeepCopyObject() runtime.Object {
 func (in *OracleOpsRequestSpec) DeepCopyInto(out *OracleOpsRequestSpec) {
 	*out = *in
 	out.DatabaseRef = in.DatabaseRef
+	if in.UpdateVersion != nil {
+		in, out := &in.UpdateVersion, &out.UpdateVersion
+		*out = new(OracleUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
+	if in.HorizontalScaling != nil {
+		in, out := &in.HorizontalScaling, &out.HorizontalScaling
+		*out = new(OracleHorizontalScalingSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.VerticalScaling != nil {
 		in, out := &in.VerticalScaling, &out.VerticalScaling
 		*out = new(OracleVerticalScalingSpec)
@@ -5495,6 +6969,11 @@ func (in *OracleOpsRequestSpec) DeepCopyInto(out *OracleOpsRequestSpec) {
 		*out = new(TLSSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -5535,6 +7014,27 @@ func (in *OracleReconfigurationSpec) DeepCopy() *OracleReconfigurationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OracleUpdateVersionSpec) DeepCopyInto(out *OracleUpdateVersionSpec) {
+	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OracleUpdateVersionSpec.
+func (in *OracleUpdateVersionSpec) DeepCopy() *OracleUpdateVersionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OracleUpdateVersionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OracleVerticalScalingSpec) DeepCopyInto(out *OracleVerticalScalingSpec) {
 	*out = *in
@@ -5699,6 +7199,11 @@ func (in *PerconaXtraDBOpsRequestSpec) DeepCopyInto(out *PerconaXtraDBOpsRequest
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(PerconaXtraDBUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -5741,6 +7246,11 @@ func (in *PerconaXtraDBOpsRequestSpec) DeepCopyInto(out *PerconaXtraDBOpsRequest
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -5800,6 +7310,11 @@ func (in *PerconaXtraDBTLSSpec) DeepCopy() *PerconaXtraDBTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PerconaXtraDBUpdateVersionSpec) DeepCopyInto(out *PerconaXtraDBUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5954,6 +7469,11 @@ func (in *PgBouncerOpsRequestSpec) DeepCopyInto(out *PgBouncerOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(PgBouncerUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -5986,6 +7506,11 @@ func (in *PgBouncerOpsRequestSpec) DeepCopyInto(out *PgBouncerOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -6024,6 +7549,11 @@ func (in *PgBouncerTLSSpec) DeepCopy() *PgBouncerTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PgBouncerUpdateVersionSpec) DeepCopyInto(out *PgBouncerUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6202,6 +7732,11 @@ func (in *PgpoolOpsRequestSpec) DeepCopyInto(out *PgpoolOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(PgpoolUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -6234,6 +7769,11 @@ func (in *PgpoolOpsRequestSpec) DeepCopyInto(out *PgpoolOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -6272,6 +7812,11 @@ func (in *PgpoolTLSSpec) DeepCopy() *PgpoolTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PgpoolUpdateVersionSpec) DeepCopyInto(out *PgpoolUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6505,6 +8050,11 @@ func (in *PostgresOpsRequestSpec) DeepCopyInto(out *PostgresOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(PostgresUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -6562,6 +8112,11 @@ func (in *PostgresOpsRequestSpec) DeepCopyInto(out *PostgresOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -6675,6 +8230,11 @@ func (in *PostgresTuningConfig) DeepCopy() *PostgresTuningConfig {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PostgresUpdateVersionSpec) DeepCopyInto(out *PostgresUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -6757,6 +8317,38 @@ func (in *PostgresVolumeExpansionSpec) DeepCopy() *PostgresVolumeExpansionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreUpdateState) DeepCopyInto(out *PreUpdateState) {
+	*out = *in
+	if in.CaptureTime != nil {
+		in, out := &in.CaptureTime, &out.CaptureTime
+		*out = (*in).DeepCopy()
+	}
+	if in.PetSetTemplates != nil {
+		in, out := &in.PetSetTemplates, &out.PetSetTemplates
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.VolumeSnapshots != nil {
+		in, out := &in.VolumeSnapshots, &out.VolumeSnapshots
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreUpdateState.
+func (in *PreUpdateState) DeepCopy() *PreUpdateState {
+	if in == nil {
+		return nil
+	}
+	out := new(PreUpdateState)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxySQLCustomConfiguration) DeepCopyInto(out *ProxySQLCustomConfiguration) {
 	*out = *in
@@ -6876,6 +8468,11 @@ func (in *ProxySQLOpsRequestSpec) DeepCopyInto(out *ProxySQLOpsRequestSpec) {
 		*out = new(ProxySQLUpdateVersionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
 	if in.HorizontalScaling != nil {
 		in, out := &in.HorizontalScaling, &out.HorizontalScaling
 		*out = new(ProxySQLHorizontalScalingSpec)
@@ -6906,6 +8503,11 @@ func (in *ProxySQLOpsRequestSpec) DeepCopyInto(out *ProxySQLOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -6984,6 +8586,11 @@ func (in *ProxySQLUpdateVersionSpec) DeepCopyInto(out *ProxySQLUpdateVersionSpec
 		*out = new(ProxySQLReplicaReadinessCriteria)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7107,6 +8714,11 @@ func (in *QdrantOpsRequestSpec) DeepCopyInto(out *QdrantOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(QdrantUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -7149,6 +8761,11 @@ func (in *QdrantOpsRequestSpec) DeepCopyInto(out *QdrantOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -7203,6 +8820,11 @@ func (in *QdrantTLSSpec) DeepCopy() *QdrantTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *QdrantUpdateVersionSpec) DeepCopyInto(out *QdrantUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7347,6 +8969,11 @@ func (in *RabbitMQOpsRequestSpec) DeepCopyInto(out *RabbitMQOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(RabbitMQUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -7389,6 +9016,11 @@ func (in *RabbitMQOpsRequestSpec) DeepCopyInto(out *RabbitMQOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -7426,6 +9058,11 @@ func (in *RabbitMQReplicaReadinessCriteria) DeepCopy() *RabbitMQReplicaReadiness
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RabbitMQUpdateVersionSpec) DeepCopyInto(out *RabbitMQUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -7756,6 +9393,11 @@ func (in *RedisOpsRequestSpec) DeepCopyInto(out *RedisOpsRequestSpec) {
 		*out = new(RedisUpdateVersionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
 	if in.HorizontalScaling != nil {
 		in, out := &in.HorizontalScaling, &out.HorizontalScaling
 		*out = new(RedisHorizontalScalingSpec)
@@ -7791,6 +9433,11 @@ func (in *RedisOpsRequestSpec) DeepCopyInto(out *RedisOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Switchover != nil {
+		in, out := &in.Switchover, &out.Switchover
+		*out = new(SwitchoverSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Announce != nil {
 		in, out := &in.Announce, &out.Announce
 		*out = new(Announce)
@@ -7806,6 +9453,11 @@ func (in *RedisOpsRequestSpec) DeepCopyInto(out *RedisOpsRequestSpec) {
 		*out = new(StorageMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -7959,6 +9611,11 @@ func (in *RedisSentinelOpsRequestSpec) DeepCopyInto(out *RedisSentinelOpsRequest
 		*out = new(RedisSentinelUpdateVersionSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
+		**out = **in
+	}
 	if in.HorizontalScaling != nil {
 		in, out := &in.HorizontalScaling, &out.HorizontalScaling
 		*out = new(RedisSentinelHorizontalScalingSpec)
@@ -7989,6 +9646,11 @@ func (in *RedisSentinelOpsRequestSpec) DeepCopyInto(out *RedisSentinelOpsRequest
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -8068,6 +9730,11 @@ func (in *RedisSentinelUpdateVersionSpec) DeepCopyInto(out *RedisSentinelUpdateV
 		*out = new(RedisSentinelReplicaReadinessCriteria)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -8158,6 +9825,11 @@ func (in *RedisUpdateVersionSpec) DeepCopyInto(out *RedisUpdateVersionSpec) {
 		*out = new(RedisReplicaReadinessCriteria)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -8255,6 +9927,44 @@ func (in *RestartSpec) DeepCopy() *RestartSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RollbackSpec) DeepCopyInto(out *RollbackSpec) {
+	*out = *in
+	out.OpsRequestRef = in.OpsRequestRef
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollbackSpec.
+func (in *RollbackSpec) DeepCopy() *RollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
+	*out = *in
+	if in.Canary != nil {
+		in, out := &in.Canary, &out.Canary
+		*out = new(CanaryRolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
+func (in *RolloutStrategy) DeepCopy() *RolloutStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Shards) DeepCopyInto(out *Shards) {
 	*out = *in
@@ -8460,6 +10170,11 @@ func (in *SinglestoreOpsRequestSpec) DeepCopyInto(out *SinglestoreOpsRequestSpec
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(SinglestoreUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -8502,6 +10217,11 @@ func (in *SinglestoreOpsRequestSpec) DeepCopyInto(out *SinglestoreOpsRequestSpec
 		*out = new(SinglestoreMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -8523,6 +10243,11 @@ func (in *SinglestoreOpsRequestSpec) DeepCopy() *SinglestoreOpsRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SinglestoreUpdateVersionSpec) DeepCopyInto(out *SinglestoreUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -8743,6 +10468,11 @@ func (in *SolrOpsRequestSpec) DeepCopyInto(out *SolrOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(SolrUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -8785,6 +10515,11 @@ func (in *SolrOpsRequestSpec) DeepCopyInto(out *SolrOpsRequestSpec) {
 		*out = new(SolrMigrationSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -8806,6 +10541,11 @@ func (in *SolrOpsRequestSpec) DeepCopy() *SolrOpsRequestSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SolrUpdateVersionSpec) DeepCopyInto(out *SolrUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -8912,6 +10652,57 @@ func (in *StorageMigrationSpec) DeepCopy() *StorageMigrationSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchoverSpec) DeepCopyInto(out *SwitchoverSpec) {
+	*out = *in
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.MaxLag != nil {
+		in, out := &in.MaxLag, &out.MaxLag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverSpec.
+func (in *SwitchoverSpec) DeepCopy() *SwitchoverSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchoverSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SwitchoverStatus) DeepCopyInto(out *SwitchoverStatus) {
+	*out = *in
+	if in.Lag != nil {
+		in, out := &in.Lag, &out.Lag
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Time != nil {
+		in, out := &in.Time, &out.Time
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SwitchoverStatus.
+func (in *SwitchoverStatus) DeepCopy() *SwitchoverStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SwitchoverStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 	*out = *in
@@ -8945,6 +10736,27 @@ func (in *Topology) DeepCopy() *Topology {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdateVersionRollbackSpec) DeepCopyInto(out *UpdateVersionRollbackSpec) {
+	*out = *in
+	if in.VolumeSnapshotClassName != nil {
+		in, out := &in.VolumeSnapshotClassName, &out.VolumeSnapshotClassName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdateVersionRollbackSpec.
+func (in *UpdateVersionRollbackSpec) DeepCopy() *UpdateVersionRollbackSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdateVersionRollbackSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeaviateHorizontalScalingSpec) DeepCopyInto(out *WeaviateHorizontalScalingSpec) {
 	*out = *in
@@ -9034,6 +10846,11 @@ func (in *WeaviateOpsRequestSpec) DeepCopyInto(out *WeaviateOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(WeaviateUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -9076,6 +10893,11 @@ func (in *WeaviateOpsRequestSpec) DeepCopyInto(out *WeaviateOpsRequestSpec) {
 		*out = new(AuthSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -9152,6 +10974,11 @@ func (in *WeaviateTLSSpec) DeepCopy() *WeaviateTLSSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WeaviateUpdateVersionSpec) DeepCopyInto(out *WeaviateUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -9296,6 +11123,11 @@ func (in *ZooKeeperOpsRequestSpec) DeepCopyInto(out *ZooKeeperOpsRequestSpec) {
 	if in.UpdateVersion != nil {
 		in, out := &in.UpdateVersion, &out.UpdateVersion
 		*out = new(ZooKeeperUpdateVersionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RollbackSpec)
 		**out = **in
 	}
 	if in.HorizontalScaling != nil {
@@ -9333,6 +11165,16 @@ func (in *ZooKeeperOpsRequestSpec) DeepCopyInto(out *ZooKeeperOpsRequestSpec) {
 		*out = new(RestartSpec)
 		**out = **in
 	}
+	if in.Migration != nil {
+		in, out := &in.Migration, &out.Migration
+		*out = new(StorageMigrationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(RolloutStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
 		*out = new(v1.Duration)
@@ -9370,6 +11212,11 @@ func (in *ZooKeeperReplicaReadinessCriteria) DeepCopy() *ZooKeeperReplicaReadine
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ZooKeeperUpdateVersionSpec) DeepCopyInto(out *ZooKeeperUpdateVersionSpec) {
 	*out = *in
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(UpdateVersionRollbackSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -29,13 +29,17 @@ import (
 
 type CourierV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	BranchQuotasGetter
 	BranchesGetter
 	BranchWorksGetter
+	ElasticsearchMigrationsGetter
 	MSSQLServerMigrationsGetter
 	MariaDBMigrationsGetter
 	MongoDBMigrationsGetter
 	MySQLMigrationsGetter
+	MySQLToPostgresMigrationsGetter
 	PostgresMigrationsGetter
+	RedisMigrationsGetter
 }
 
 // CourierV1alpha1Client is used to interact with features provided by the courier.kubedb.com group.
@@ -43,6 +47,10 @@ type CourierV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *CourierV1alpha1Client) BranchQuotas(namespace string) BranchQuotaInterface {
+	return newBranchQuotas(c, namespace)
+}
+
 func (c *CourierV1alpha1Client) Branches(namespace string) BranchInterface {
 	return newBranches(c, namespace)
 }
@@ -51,6 +59,10 @@ func (c *CourierV1alpha1Client) BranchWorks(namespace string) BranchWorkInterfac
 	return newBranchWorks(c, namespace)
 }
 
+func (c *CourierV1alpha1Client) ElasticsearchMigrations(namespace string) ElasticsearchMigrationInterface {
+	return newElasticsearchMigrations(c, namespace)
+}
+
 func (c *CourierV1alpha1Client) MSSQLServerMigrations(namespace string) MSSQLServerMigrationInterface {
 	return newMSSQLServerMigrations(c, namespace)
 }
@@ -67,10 +79,18 @@ func (c *CourierV1alpha1Client) MySQLMigrations(namespace string) MySQLMigration
 	return newMySQLMigrations(c, namespace)
 }
 
+func (c *CourierV1alpha1Client) MySQLToPostgresMigrations(namespace string) MySQLToPostgresMigrationInterface {
+	return newMySQLToPostgresMigrations(c, namespace)
+}
+
 func (c *CourierV1alpha1Client) PostgresMigrations(namespace string) PostgresMigrationInterface {
 	return newPostgresMigrations(c, namespace)
 }
 
+func (c *CourierV1alpha1Client) RedisMigrations(namespace string) RedisMigrationInterface {
+	return newRedisMigrations(c, namespace)
+}
+
 // NewForConfig creates a new CourierV1alpha1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -29,6 +29,10 @@ type FakeCourierV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeCourierV1alpha1) BranchQuotas(namespace string) v1alpha1.BranchQuotaInterface {
+	return &FakeBranchQuotas{c, namespace}
+}
+
 func (c *FakeCourierV1alpha1) Branches(namespace string) v1alpha1.BranchInterface {
 	return &FakeBranches{c, namespace}
 }
@@ -37,6 +41,10 @@ func (c *FakeCourierV1alpha1) BranchWorks(namespace string) v1alpha1.BranchWorkI
 	return &FakeBranchWorks{c, namespace}
 }
 
+func (c *FakeCourierV1alpha1) ElasticsearchMigrations(namespace string) v1alpha1.ElasticsearchMigrationInterface {
+	return &FakeElasticsearchMigrations{c, namespace}
+}
+
 func (c *FakeCourierV1alpha1) MSSQLServerMigrations(namespace string) v1alpha1.MSSQLServerMigrationInterface {
 	return &FakeMSSQLServerMigrations{c, namespace}
 }
@@ -53,10 +61,18 @@ func (c *FakeCourierV1alpha1) MySQLMigrations(namespace string) v1alpha1.MySQLMi
 	return &FakeMySQLMigrations{c, namespace}
 }
 
+func (c *FakeCourierV1alpha1) MySQLToPostgresMigrations(namespace string) v1alpha1.MySQLToPostgresMigrationInterface {
+	return &FakeMySQLToPostgresMigrations{c, namespace}
+}
+
 func (c *FakeCourierV1alpha1) PostgresMigrations(namespace string) v1alpha1.PostgresMigrationInterface {
 	return &FakePostgresMigrations{c, namespace}
 }
 
+func (c *FakeCourierV1alpha1) RedisMigrations(namespace string) v1alpha1.RedisMigrationInterface {
+	return &FakeRedisMigrations{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeCourierV1alpha1) RESTClient() rest.Interface {
@@ -20,8 +20,12 @@ package v1alpha1
 
 type BranchExpansion interface{}
 
+type BranchQuotaExpansion interface{}
+
 type BranchWorkExpansion interface{}
 
+type ElasticsearchMigrationExpansion interface{}
+
 type MSSQLServerMigrationExpansion interface{}
 
 type MariaDBMigrationExpansion interface{}
@@ -30,4 +34,8 @@ type MongoDBMigrationExpansion interface{}
 
 type MySQLMigrationExpansion interface{}
 
+type MySQLToPostgresMigrationExpansion interface{}
+
 type PostgresMigrationExpansion interface{}
+
+type RedisMigrationExpansion interface{}
@@ -77,6 +77,10 @@ func (c *FakeOpsV1alpha1) MSSQLServerOpsRequests(namespace string) v1alpha1.MSSQ
 	return &FakeMSSQLServerOpsRequests{c, namespace}
 }
 
+func (c *FakeOpsV1alpha1) MaintenanceWindows() v1alpha1.MaintenanceWindowInterface {
+	return &FakeMaintenanceWindows{c}
+}
+
 func (c *FakeOpsV1alpha1) MariaDBOpsRequests(namespace string) v1alpha1.MariaDBOpsRequestInterface {
 	return &FakeMariaDBOpsRequests{c, namespace}
 }
@@ -101,6 +105,22 @@ func (c *FakeOpsV1alpha1) Neo4jOpsRequests(namespace string) v1alpha1.Neo4jOpsRe
 	return &FakeNeo4jOpsRequests{c, namespace}
 }
 
+func (c *FakeOpsV1alpha1) OpsApprovalPolicies() v1alpha1.OpsApprovalPolicyInterface {
+	return &FakeOpsApprovalPolicies{c}
+}
+
+func (c *FakeOpsV1alpha1) OpsApprovals(namespace string) v1alpha1.OpsApprovalInterface {
+	return &FakeOpsApprovals{c, namespace}
+}
+
+func (c *FakeOpsV1alpha1) OpsConcurrencyPolicies() v1alpha1.OpsConcurrencyPolicyInterface {
+	return &FakeOpsConcurrencyPolicies{c}
+}
+
+func (c *FakeOpsV1alpha1) OpsPlans(namespace string) v1alpha1.OpsPlanInterface {
+	return &FakeOpsPlans{c, namespace}
+}
+
 func (c *FakeOpsV1alpha1) OracleOpsRequests(namespace string) v1alpha1.OracleOpsRequestInterface {
 	return &FakeOracleOpsRequests{c, namespace}
 }
@@ -42,6 +42,8 @@ type KafkaOpsRequestExpansion interface{}
 
 type MSSQLServerOpsRequestExpansion interface{}
 
+type MaintenanceWindowExpansion interface{}
+
 type MariaDBOpsRequestExpansion interface{}
 
 type MemcachedOpsRequestExpansion interface{}
@@ -54,6 +56,14 @@ type MySQLOpsRequestExpansion interface{}
 
 type Neo4jOpsRequestExpansion interface{}
 
+type OpsApprovalExpansion interface{}
+
+type OpsApprovalPolicyExpansion interface{}
+
+type OpsConcurrencyPolicyExpansion interface{}
+
+type OpsPlanExpansion interface{}
+
 type OracleOpsRequestExpansion interface{}
 
 type PerconaXtraDBOpsRequestExpansion interface{}
@@ -41,12 +41,17 @@ type OpsV1alpha1Interface interface {
 	IgniteOpsRequestsGetter
 	KafkaOpsRequestsGetter
 	MSSQLServerOpsRequestsGetter
+	MaintenanceWindowsGetter
 	MariaDBOpsRequestsGetter
 	MemcachedOpsRequestsGetter
 	MilvusOpsRequestsGetter
 	MongoDBOpsRequestsGetter
 	MySQLOpsRequestsGetter
 	Neo4jOpsRequestsGetter
+	OpsApprovalPoliciesGetter
+	OpsApprovalsGetter
+	OpsConcurrencyPoliciesGetter
+	OpsPlansGetter
 	OracleOpsRequestsGetter
 	PerconaXtraDBOpsRequestsGetter
 	PgBouncerOpsRequestsGetter
@@ -116,6 +121,10 @@ func (c *OpsV1alpha1Client) MSSQLServerOpsRequests(namespace string) MSSQLServer
 	return newMSSQLServerOpsRequests(c, namespace)
 }
 
+func (c *OpsV1alpha1Client) MaintenanceWindows() MaintenanceWindowInterface {
+	return newMaintenanceWindows(c)
+}
+
 func (c *OpsV1alpha1Client) MariaDBOpsRequests(namespace string) MariaDBOpsRequestInterface {
 	return newMariaDBOpsRequests(c, namespace)
 }
@@ -140,6 +149,22 @@ func (c *OpsV1alpha1Client) Neo4jOpsRequests(namespace string) Neo4jOpsRequestIn
 	return newNeo4jOpsRequests(c, namespace)
 }
 
+func (c *OpsV1alpha1Client) OpsApprovalPolicies() OpsApprovalPolicyInterface {
+	return newOpsApprovalPolicies(c)
+}
+
+func (c *OpsV1alpha1Client) OpsApprovals(namespace string) OpsApprovalInterface {
+	return newOpsApprovals(c, namespace)
+}
+
+func (c *OpsV1alpha1Client) OpsConcurrencyPolicies() OpsConcurrencyPolicyInterface {
+	return newOpsConcurrencyPolicies(c)
+}
+
+func (c *OpsV1alpha1Client) OpsPlans(namespace string) OpsPlanInterface {
+	return newOpsPlans(c, namespace)
+}
+
 func (c *OpsV1alpha1Client) OracleOpsRequests(namespace string) OracleOpsRequestInterface {
 	return newOracleOpsRequests(c, namespace)
 }
@@ -24,10 +24,14 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// BranchQuotas returns a BranchQuotaInformer.
+	BranchQuotas() BranchQuotaInformer
 	// Branches returns a BranchInformer.
 	Branches() BranchInformer
 	// BranchWorks returns a BranchWorkInformer.
 	BranchWorks() BranchWorkInformer
+	// ElasticsearchMigrations returns a ElasticsearchMigrationInformer.
+	ElasticsearchMigrations() ElasticsearchMigrationInformer
 	// MSSQLServerMigrations returns a MSSQLServerMigrationInformer.
 	MSSQLServerMigrations() MSSQLServerMigrationInformer
 	// MariaDBMigrations returns a MariaDBMigrationInformer.
@@ -36,8 +40,12 @@ type Interface interface {
 	MongoDBMigrations() MongoDBMigrationInformer
 	// MySQLMigrations returns a MySQLMigrationInformer.
 	MySQLMigrations() MySQLMigrationInformer
+	// MySQLToPostgresMigrations returns a MySQLToPostgresMigrationInformer.
+	MySQLToPostgresMigrations() MySQLToPostgresMigrationInformer
 	// PostgresMigrations returns a PostgresMigrationInformer.
 	PostgresMigrations() PostgresMigrationInformer
+	// RedisMigrations returns a RedisMigrationInformer.
+	RedisMigrations() RedisMigrationInformer
 }
 
 type version struct {
@@ -51,6 +59,11 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// BranchQuotas returns a BranchQuotaInformer.
+func (v *version) BranchQuotas() BranchQuotaInformer {
+	return &branchQuotaInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Branches returns a BranchInformer.
 func (v *version) Branches() BranchInformer {
 	return &branchInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -61,6 +74,11 @@ func (v *version) BranchWorks() BranchWorkInformer {
 	return &branchWorkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ElasticsearchMigrations returns a ElasticsearchMigrationInformer.
+func (v *version) ElasticsearchMigrations() ElasticsearchMigrationInformer {
+	return &elasticsearchMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MSSQLServerMigrations returns a MSSQLServerMigrationInformer.
 func (v *version) MSSQLServerMigrations() MSSQLServerMigrationInformer {
 	return &mSSQLServerMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -81,7 +99,17 @@ func (v *version) MySQLMigrations() MySQLMigrationInformer {
 	return &mySQLMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MySQLToPostgresMigrations returns a MySQLToPostgresMigrationInformer.
+func (v *version) MySQLToPostgresMigrations() MySQLToPostgresMigrationInformer {
+	return &mySQLToPostgresMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // PostgresMigrations returns a PostgresMigrationInformer.
 func (v *version) PostgresMigrations() PostgresMigrationInformer {
 	return &postgresMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
+
+// RedisMigrations returns a RedisMigrationInformer.
+func (v *version) RedisMigrations() RedisMigrationInformer {
+	return &redisMigrationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
@@ -216,8 +216,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		// Group=courier.kubedb.com, Version=v1alpha1
 	case courierv1alpha1.SchemeGroupVersion.WithResource("branches"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().Branches().Informer()}, nil
+	case courierv1alpha1.SchemeGroupVersion.WithResource("branchquotas"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().BranchQuotas().Informer()}, nil
 	case courierv1alpha1.SchemeGroupVersion.WithResource("branchworks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().BranchWorks().Informer()}, nil
+	case courierv1alpha1.SchemeGroupVersion.WithResource("elasticsearchmigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().ElasticsearchMigrations().Informer()}, nil
 	case courierv1alpha1.SchemeGroupVersion.WithResource("mssqlservermigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().MSSQLServerMigrations().Informer()}, nil
 	case courierv1alpha1.SchemeGroupVersion.WithResource("mariadbmigrations"):
@@ -226,8 +230,12 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().MongoDBMigrations().Informer()}, nil
 	case courierv1alpha1.SchemeGroupVersion.WithResource("mysqlmigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().MySQLMigrations().Informer()}, nil
+	case courierv1alpha1.SchemeGroupVersion.WithResource("mysqltopostgresmigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().MySQLToPostgresMigrations().Informer()}, nil
 	case courierv1alpha1.SchemeGroupVersion.WithResource("postgresmigrations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().PostgresMigrations().Informer()}, nil
+	case courierv1alpha1.SchemeGroupVersion.WithResource("redismigrations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Courier().V1alpha1().RedisMigrations().Informer()}, nil
 
 		// Group=elasticsearch.kubedb.com, Version=v1alpha1
 	case elasticsearchv1alpha1.SchemeGroupVersion.WithResource("elasticsearchdashboards"):
@@ -424,6 +432,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().IgniteOpsRequests().Informer()}, nil
 	case opsv1alpha1.SchemeGroupVersion.WithResource("kafkaopsrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().KafkaOpsRequests().Informer()}, nil
+	case opsv1alpha1.SchemeGroupVersion.WithResource("maintenancewindows"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().MaintenanceWindows().Informer()}, nil
 	case opsv1alpha1.SchemeGroupVersion.WithResource("mssqlserveropsrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().MSSQLServerOpsRequests().Informer()}, nil
 	case opsv1alpha1.SchemeGroupVersion.WithResource("mariadbopsrequests"):
@@ -438,6 +448,14 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().MySQLOpsRequests().Informer()}, nil
 	case opsv1alpha1.SchemeGroupVersion.WithResource("neo4jopsrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().Neo4jOpsRequests().Informer()}, nil
+	case opsv1alpha1.SchemeGroupVersion.WithResource("opsapprovalpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().OpsApprovalPolicies().Informer()}, nil
+	case opsv1alpha1.SchemeGroupVersion.WithResource("opsapprovals"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().OpsApprovals().Informer()}, nil
+	case opsv1alpha1.SchemeGroupVersion.WithResource("opsconcurrencypolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().OpsConcurrencyPolicies().Informer()}, nil
+	case opsv1alpha1.SchemeGroupVersion.WithResource("opsplans"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().OpsPlans().Informer()}, nil
 	case opsv1alpha1.SchemeGroupVersion.WithResource("oracleopsrequests"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Ops().V1alpha1().OracleOpsRequests().Informer()}, nil
 	case opsv1alpha1.SchemeGroupVersion.WithResource("perconaxtradbopsrequests"):
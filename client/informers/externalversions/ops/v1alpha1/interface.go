@@ -48,6 +48,8 @@ type Interface interface {
 	KafkaOpsRequests() KafkaOpsRequestInformer
 	// MSSQLServerOpsRequests returns a MSSQLServerOpsRequestInformer.
 	MSSQLServerOpsRequests() MSSQLServerOpsRequestInformer
+	// MaintenanceWindows returns a MaintenanceWindowInformer.
+	MaintenanceWindows() MaintenanceWindowInformer
 	// MariaDBOpsRequests returns a MariaDBOpsRequestInformer.
 	MariaDBOpsRequests() MariaDBOpsRequestInformer
 	// MemcachedOpsRequests returns a MemcachedOpsRequestInformer.
@@ -60,6 +62,14 @@ type Interface interface {
 	MySQLOpsRequests() MySQLOpsRequestInformer
 	// Neo4jOpsRequests returns a Neo4jOpsRequestInformer.
 	Neo4jOpsRequests() Neo4jOpsRequestInformer
+	// OpsApprovalPolicies returns a OpsApprovalPolicyInformer.
+	OpsApprovalPolicies() OpsApprovalPolicyInformer
+	// OpsApprovals returns a OpsApprovalInformer.
+	OpsApprovals() OpsApprovalInformer
+	// OpsConcurrencyPolicies returns a OpsConcurrencyPolicyInformer.
+	OpsConcurrencyPolicies() OpsConcurrencyPolicyInformer
+	// OpsPlans returns a OpsPlanInformer.
+	OpsPlans() OpsPlanInformer
 	// OracleOpsRequests returns a OracleOpsRequestInformer.
 	OracleOpsRequests() OracleOpsRequestInformer
 	// PerconaXtraDBOpsRequests returns a PerconaXtraDBOpsRequestInformer.
@@ -161,6 +171,11 @@ func (v *version) MSSQLServerOpsRequests() MSSQLServerOpsRequestInformer {
 	return &mSSQLServerOpsRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MaintenanceWindows returns a MaintenanceWindowInformer.
+func (v *version) MaintenanceWindows() MaintenanceWindowInformer {
+	return &maintenanceWindowInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // MariaDBOpsRequests returns a MariaDBOpsRequestInformer.
 func (v *version) MariaDBOpsRequests() MariaDBOpsRequestInformer {
 	return &mariaDBOpsRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -191,6 +206,26 @@ func (v *version) Neo4jOpsRequests() Neo4jOpsRequestInformer {
 	return &neo4jOpsRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OpsApprovalPolicies returns a OpsApprovalPolicyInformer.
+func (v *version) OpsApprovalPolicies() OpsApprovalPolicyInformer {
+	return &opsApprovalPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// OpsApprovals returns a OpsApprovalInformer.
+func (v *version) OpsApprovals() OpsApprovalInformer {
+	return &opsApprovalInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// OpsConcurrencyPolicies returns a OpsConcurrencyPolicyInformer.
+func (v *version) OpsConcurrencyPolicies() OpsConcurrencyPolicyInformer {
+	return &opsConcurrencyPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
+// OpsPlans returns a OpsPlanInformer.
+func (v *version) OpsPlans() OpsPlanInformer {
+	return &opsPlanInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // OracleOpsRequests returns a OracleOpsRequestInformer.
 func (v *version) OracleOpsRequests() OracleOpsRequestInformer {
 	return &oracleOpsRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
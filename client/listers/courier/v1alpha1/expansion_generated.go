@@ -26,6 +26,14 @@ type BranchListerExpansion interface{}
 // BranchNamespaceLister.
 type BranchNamespaceListerExpansion interface{}
 
+// BranchQuotaListerExpansion allows custom methods to be added to
+// BranchQuotaLister.
+type BranchQuotaListerExpansion interface{}
+
+// BranchQuotaNamespaceListerExpansion allows custom methods to be added to
+// BranchQuotaNamespaceLister.
+type BranchQuotaNamespaceListerExpansion interface{}
+
 // BranchWorkListerExpansion allows custom methods to be added to
 // BranchWorkLister.
 type BranchWorkListerExpansion interface{}
@@ -34,6 +42,14 @@ type BranchWorkListerExpansion interface{}
 // BranchWorkNamespaceLister.
 type BranchWorkNamespaceListerExpansion interface{}
 
+// ElasticsearchMigrationListerExpansion allows custom methods to be added to
+// ElasticsearchMigrationLister.
+type ElasticsearchMigrationListerExpansion interface{}
+
+// ElasticsearchMigrationNamespaceListerExpansion allows custom methods to be added to
+// ElasticsearchMigrationNamespaceLister.
+type ElasticsearchMigrationNamespaceListerExpansion interface{}
+
 // MSSQLServerMigrationListerExpansion allows custom methods to be added to
 // MSSQLServerMigrationLister.
 type MSSQLServerMigrationListerExpansion interface{}
@@ -66,6 +82,14 @@ type MySQLMigrationListerExpansion interface{}
 // MySQLMigrationNamespaceLister.
 type MySQLMigrationNamespaceListerExpansion interface{}
 
+// MySQLToPostgresMigrationListerExpansion allows custom methods to be added to
+// MySQLToPostgresMigrationLister.
+type MySQLToPostgresMigrationListerExpansion interface{}
+
+// MySQLToPostgresMigrationNamespaceListerExpansion allows custom methods to be added to
+// MySQLToPostgresMigrationNamespaceLister.
+type MySQLToPostgresMigrationNamespaceListerExpansion interface{}
+
 // PostgresMigrationListerExpansion allows custom methods to be added to
 // PostgresMigrationLister.
 type PostgresMigrationListerExpansion interface{}
@@ -73,3 +97,11 @@ type PostgresMigrationListerExpansion interface{}
 // PostgresMigrationNamespaceListerExpansion allows custom methods to be added to
 // PostgresMigrationNamespaceLister.
 type PostgresMigrationNamespaceListerExpansion interface{}
+
+// RedisMigrationListerExpansion allows custom methods to be added to
+// RedisMigrationLister.
+type RedisMigrationListerExpansion interface{}
+
+// RedisMigrationNamespaceListerExpansion allows custom methods to be added to
+// RedisMigrationNamespaceLister.
+type RedisMigrationNamespaceListerExpansion interface{}
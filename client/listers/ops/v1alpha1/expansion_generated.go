@@ -114,6 +114,10 @@ type MSSQLServerOpsRequestListerExpansion interface{}
 // MSSQLServerOpsRequestNamespaceLister.
 type MSSQLServerOpsRequestNamespaceListerExpansion interface{}
 
+// MaintenanceWindowListerExpansion allows custom methods to be added to
+// MaintenanceWindowLister.
+type MaintenanceWindowListerExpansion interface{}
+
 // MariaDBOpsRequestListerExpansion allows custom methods to be added to
 // MariaDBOpsRequestLister.
 type MariaDBOpsRequestListerExpansion interface{}
@@ -162,6 +166,30 @@ type Neo4jOpsRequestListerExpansion interface{}
 // Neo4jOpsRequestNamespaceLister.
 type Neo4jOpsRequestNamespaceListerExpansion interface{}
 
+// OpsApprovalListerExpansion allows custom methods to be added to
+// OpsApprovalLister.
+type OpsApprovalListerExpansion interface{}
+
+// OpsApprovalNamespaceListerExpansion allows custom methods to be added to
+// OpsApprovalNamespaceLister.
+type OpsApprovalNamespaceListerExpansion interface{}
+
+// OpsApprovalPolicyListerExpansion allows custom methods to be added to
+// OpsApprovalPolicyLister.
+type OpsApprovalPolicyListerExpansion interface{}
+
+// OpsConcurrencyPolicyListerExpansion allows custom methods to be added to
+// OpsConcurrencyPolicyLister.
+type OpsConcurrencyPolicyListerExpansion interface{}
+
+// OpsPlanListerExpansion allows custom methods to be added to
+// OpsPlanLister.
+type OpsPlanListerExpansion interface{}
+
+// OpsPlanNamespaceListerExpansion allows custom methods to be added to
+// OpsPlanNamespaceLister.
+type OpsPlanNamespaceListerExpansion interface{}
+
 // OracleOpsRequestListerExpansion allows custom methods to be added to
 // OracleOpsRequestLister.
 type OracleOpsRequestListerExpansion interface{}
@@ -107,7 +107,11 @@ func generateSwaggerJson() {
 			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralMariaDBMigrations, courierv1alpha1.ResourceKindMariaDBMigration, true},
 			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralMongoDBMigrations, courierv1alpha1.ResourceKindMongoDBMigration, true},
 			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralMSSQLServerMigrations, courierv1alpha1.ResourceKindMSSQLServerMigration, true},
+			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralRedisMigrations, courierv1alpha1.ResourceKindRedisMigration, true},
+			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralElasticsearchMigrations, courierv1alpha1.ResourceKindElasticsearchMigration, true},
+			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralMySQLToPostgresMigrations, courierv1alpha1.ResourceKindMySQLToPostgresMigration, true},
 			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralBranches, courierv1alpha1.ResourceKindBranch, true},
+			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralBranchQuotas, courierv1alpha1.ResourceKindBranchQuota, true},
 			{courierv1alpha1.SchemeGroupVersion, courierv1alpha1.ResourcePluralBranchWorks, courierv1alpha1.ResourceKindBranchWork, true},
 		},
 	})
@@ -0,0 +1,276 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	cu "kmodules.xyz/client-go/client"
+	cutil "kmodules.xyz/client-go/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// maxMaintenanceRequeue bounds the requeue of a held ops request, so that edited windows are picked up.
+const maxMaintenanceRequeue = 5 * time.Minute
+
+// maintenanceHold is the result of checking an ops request against the MaintenanceWindows.
+type maintenanceHold struct {
+	windows []string
+	next    *metav1.Time
+	// policy is what happens to a running ops request: Cancel if any of the closed windows cancels, Hold otherwise.
+	policy opsapi.MaintenanceWindowClosePolicy
+}
+
+func (h *maintenanceHold) message() string {
+	msg := fmt.Sprintf("waiting for MaintenanceWindow %s to open", strings.Join(h.windows, ", "))
+	if h.next != nil {
+		msg += fmt.Sprintf(", next slot opens at %s", h.next.UTC().Format(time.RFC3339))
+	}
+	return msg
+}
+
+// requeueAfter returns when the held ops request is checked again: when the next slot opens, at most
+// after maxMaintenanceRequeue.
+func (h *maintenanceHold) requeueAfter(now time.Time) time.Duration {
+	d := maxMaintenanceRequeue
+	if h.next != nil {
+		if until := h.next.Sub(now); until < d {
+			d = until
+		}
+	}
+	return max(d, time.Second)
+}
+
+// governingWindows returns the MaintenanceWindows that govern a database: the windows that select it or,
+// if none does, the cluster wide windows.
+func governingWindows(windows []opsapi.MaintenanceWindow, kind string, nsLabels, dbLabels map[string]string) ([]opsapi.MaintenanceWindow, error) {
+	var selecting, clusterWide []opsapi.MaintenanceWindow
+	for _, w := range windows {
+		if w.IsClusterWide() {
+			clusterWide = append(clusterWide, w)
+			continue
+		}
+		ok, err := w.Selects(kind, nsLabels, dbLabels)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			selecting = append(selecting, w)
+		}
+	}
+	if len(selecting) == 0 {
+		return clusterWide, nil
+	}
+	return selecting, nil
+}
+
+// maintenanceHoldFor checks an ops request against the MaintenanceWindows that govern its database. The
+// ops request is held if a governing window applies to it and none of the applicable windows is open at now.
+func maintenanceHoldFor(windows []opsapi.MaintenanceWindow, kind string, nsLabels, dbLabels map[string]string, req opsapi.Accessor, now time.Time) (*maintenanceHold, error) {
+	governing, err := governingWindows(windows, kind, nsLabels, dbLabels)
+	if err != nil {
+		return nil, err
+	}
+
+	var hold *maintenanceHold
+	for _, w := range governing {
+		if !w.AppliesTo(req) {
+			continue
+		}
+		open, _, err := w.IsOpen(now)
+		if err != nil {
+			return nil, err
+		}
+		if open {
+			return nil, nil
+		}
+		next, err := w.NextOpenTime(now)
+		if err != nil {
+			return nil, err
+		}
+		if hold == nil {
+			hold = &maintenanceHold{policy: opsapi.MaintenanceWindowClosePolicyHold}
+		}
+		hold.windows = append(hold.windows, w.Name)
+		if w.Spec.OnWindowClose == opsapi.MaintenanceWindowClosePolicyCancel {
+			hold.policy = opsapi.MaintenanceWindowClosePolicyCancel
+		}
+		if next != nil && (hold.next == nil || next.Before(hold.next)) {
+			hold.next = next
+		}
+	}
+	return hold, nil
+}
+
+// maintenanceCloseFor checks a running ops request against the MaintenanceWindows that govern its
+// database. While an applicable window is open, it returns when the last of the open windows closes;
+// once all of them are closed, it returns the hold. It returns neither if no window applies.
+func maintenanceCloseFor(windows []opsapi.MaintenanceWindow, kind string, nsLabels, dbLabels map[string]string, req opsapi.Accessor, now time.Time) (*time.Time, *maintenanceHold, error) {
+	hold, err := maintenanceHoldFor(windows, kind, nsLabels, dbLabels, req, now)
+	if err != nil || hold != nil {
+		return nil, hold, err
+	}
+	governing, err := governingWindows(windows, kind, nsLabels, dbLabels)
+	if err != nil {
+		return nil, nil, err
+	}
+	var closeAt *time.Time
+	for _, w := range governing {
+		if !w.AppliesTo(req) {
+			continue
+		}
+		open, at, err := w.IsOpen(now)
+		if err != nil {
+			return nil, nil, err
+		}
+		if open && (closeAt == nil || at.After(*closeAt)) {
+			closeAt = &at
+		}
+	}
+	return closeAt, nil, nil
+}
+
+// checkMaintenanceWindows checks the ops request against the MaintenanceWindows of the cluster.
+func (c *OpsRequestController) checkMaintenanceWindows(ctx context.Context, req opsapi.Accessor, now time.Time) (*maintenanceHold, error) {
+	_, hold, err := c.checkMaintenanceWindowClose(ctx, req, now)
+	return hold, err
+}
+
+// checkMaintenanceWindowClose loads the MaintenanceWindows of the cluster and the labels of the database
+// for maintenanceCloseFor.
+func (c *OpsRequestController) checkMaintenanceWindowClose(ctx context.Context, req opsapi.Accessor, now time.Time) (*time.Time, *maintenanceHold, error) {
+	var windows opsapi.MaintenanceWindowList
+	if err := c.kbClient.List(ctx, &windows); err != nil {
+		return nil, nil, err
+	}
+	if len(windows.Items) == 0 {
+		return nil, nil, nil
+	}
+	nsLabels, dbLabels, found, err := c.databaseLabels(ctx, req)
+	if err != nil || !found {
+		return nil, nil, err
+	}
+	return maintenanceCloseFor(windows.Items, c.dbKind(), nsLabels, dbLabels, req, now)
+}
+
+// databaseLabels returns the labels of the namespace and of the database of an ops request, as matched
+// by an OpsDatabaseSelector. found is false if the database is gone.
+func (c *OpsRequestController) databaseLabels(ctx context.Context, req opsapi.Accessor) (nsLabels, dbLabels map[string]string, found bool, err error) {
+	mapping, err := c.kbClient.RESTMapper().RESTMapping(schema.GroupKind{Group: kubedb.GroupName, Kind: c.dbKind()})
+	if err != nil {
+		return nil, nil, false, err
+	}
+	var db unstructured.Unstructured
+	db.SetGroupVersionKind(mapping.GroupVersionKind)
+	if err := c.kbClient.Get(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetDBRefName()}, &db); err != nil {
+		return nil, nil, false, client.IgnoreNotFound(err)
+	}
+	var ns core.Namespace
+	if err := c.kbClient.Get(ctx, types.NamespacedName{Name: req.GetNamespace()}, &ns); err != nil {
+		return nil, nil, false, err
+	}
+	return ns.Labels, db.GetLabels(), true, nil
+}
+
+// CheckMaintenanceWindowClose is called by the step runner of a Progressing ops request at its safe
+// points, e.g. between two steps, and returns whether the runner may start the next step. Once every
+// MaintenanceWindow holding the ops request is closed, their onWindowClose policy applies: Hold sets the
+// HeldByMaintenanceWindow condition and the runner checks again after the returned duration; Cancel
+// fails the ops request with the CancelledByMaintenanceWindow condition. While a window is open, the
+// returned duration is the time left until it closes, and zero if no window applies.
+func (c *OpsRequestController) CheckMaintenanceWindowClose(ctx context.Context, req opsapi.Accessor) (bool, time.Duration, error) {
+	now := time.Now()
+	closeAt, hold, err := c.checkMaintenanceWindowClose(ctx, req, now)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if hold == nil {
+		if cutil.HasCondition(req.GetStatus().Conditions, opsapi.HeldByMaintenanceWindow) {
+			_, err = cu.PatchStatus(ctx, c.kbClient, req, func(obj client.Object) client.Object {
+				ret := obj.(opsapi.Accessor)
+				sts := ret.GetStatus()
+				sts.Conditions = cutil.RemoveCondition(sts.Conditions, opsapi.HeldByMaintenanceWindow)
+				ret.SetStatus(sts)
+				return ret
+			})
+			if err != nil {
+				return false, 0, err
+			}
+		}
+		var left time.Duration
+		if closeAt != nil {
+			left = closeAt.Sub(now)
+		}
+		return true, left, nil
+	}
+
+	if hold.policy == opsapi.MaintenanceWindowClosePolicyCancel {
+		msg := fmt.Sprintf("MaintenanceWindow %s closed before the ops request completed", strings.Join(hold.windows, ", "))
+		klog.Info(fmt.Sprintf("%s %s/%s is cancelled: %s", c.kind, req.GetNamespace(), req.GetName(), msg))
+		_, err = cu.PatchStatus(ctx, c.kbClient, req, func(obj client.Object) client.Object {
+			ret := obj.(opsapi.Accessor)
+			sts := ret.GetStatus()
+			sts.Phase = opsapi.OpsRequestPhaseFailed
+			sts.Conditions = cutil.SetCondition(sts.Conditions, cutil.NewCondition(opsapi.CancelledByMaintenanceWindow, msg, req.GetObjectMeta().Generation))
+			ret.SetStatus(sts)
+			return ret
+		})
+		return false, 0, err
+	}
+
+	klog.Info(fmt.Sprintf("%s %s/%s is held: %s", c.kind, req.GetNamespace(), req.GetName(), hold.message()))
+	_, err = cu.PatchStatus(ctx, c.kbClient, req, func(obj client.Object) client.Object {
+		ret := obj.(opsapi.Accessor)
+		sts := ret.GetStatus()
+		sts.Conditions = cutil.SetCondition(sts.Conditions, cutil.NewCondition(opsapi.HeldByMaintenanceWindow, hold.message(), req.GetObjectMeta().Generation))
+		ret.SetStatus(sts)
+		return ret
+	})
+	return false, hold.requeueAfter(now), err
+}
+
+// ensureOpsRequestSource sets the LabelOpsRequestSource label of an ops request that has none, so that
+// the creator a MaintenanceWindow judges it by is visible on the object.
+func (c *OpsRequestController) ensureOpsRequestSource(ctx context.Context, req opsapi.Accessor) error {
+	if _, ok := req.GetLabels()[opsapi.LabelOpsRequestSource]; ok {
+		return nil
+	}
+	src := opsapi.GetOpsRequestSource(req)
+	_, err := cu.Patch(ctx, c.kbClient, req, func(obj client.Object) client.Object {
+		labels := obj.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[opsapi.LabelOpsRequestSource] = string(src)
+		obj.SetLabels(labels)
+		return obj
+	})
+	return err
+}
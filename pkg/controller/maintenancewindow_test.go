@@ -0,0 +1,227 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMaintenanceHoldFor(t *testing.T) {
+	// Thursday, 2026-01-01 10:00 UTC
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	window := func(name, start string, kinds ...string) opsapi.MaintenanceWindow {
+		w := opsapi.MaintenanceWindow{}
+		w.Name = name
+		w.Spec.TimeZone = "UTC"
+		w.Spec.Windows = []opsapi.MaintenanceTimeWindow{{Start: start, Duration: metav1.Duration{Duration: time.Hour}}}
+		if kinds != nil {
+			w.Spec.DatabaseSelector = &opsapi.OpsDatabaseSelector{Kinds: kinds}
+		}
+		return w
+	}
+	request := func(typ opsapi.PostgresOpsRequestType) opsapi.Accessor {
+		req := &opsapi.PostgresOpsRequest{}
+		req.Spec.Type = typ
+		return req
+	}
+	restart := request(opsapi.PostgresOpsRequestTypeRestart)
+
+	tests := []struct {
+		name    string
+		windows []opsapi.MaintenanceWindow
+		req     opsapi.Accessor
+		held    []string
+		next    string
+	}{
+		{
+			name: "no window",
+			req:  restart,
+		},
+		{
+			name:    "closed cluster wide window",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00")},
+			req:     restart,
+			held:    []string{"nightly"},
+			next:    "2026-01-02T02:00:00Z",
+		},
+		{
+			name:    "open cluster wide window",
+			windows: []opsapi.MaintenanceWindow{window("morning", "09:30")},
+			req:     restart,
+		},
+		{
+			name:    "selecting window overrides the cluster wide one",
+			windows: []opsapi.MaintenanceWindow{window("morning", "09:30"), window("postgres", "22:00", "Postgres")},
+			req:     restart,
+			held:    []string{"postgres"},
+			next:    "2026-01-01T22:00:00Z",
+		},
+		{
+			name:    "window of another kind is ignored",
+			windows: []opsapi.MaintenanceWindow{window("mysql", "22:00", "MySQL")},
+			req:     restart,
+		},
+		{
+			name:    "one open window is enough",
+			windows: []opsapi.MaintenanceWindow{window("evening", "22:00", "Postgres"), window("morning", "09:30", "Postgres")},
+			req:     restart,
+		},
+		{
+			name:    "earliest next slot",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00", "Postgres"), window("evening", "22:00", "Postgres")},
+			req:     restart,
+			held:    []string{"nightly", "evening"},
+			next:    "2026-01-01T22:00:00Z",
+		},
+		{
+			name:    "types not held",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00")},
+			req:     request(opsapi.PostgresOpsRequestTypeHorizontalScaling),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hold, err := maintenanceHoldFor(tt.windows, "Postgres", nil, nil, tt.req, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.held == nil {
+				if hold != nil {
+					t.Fatalf("maintenanceHoldFor() = %s, want no hold", hold.message())
+				}
+				return
+			}
+			if hold == nil {
+				t.Fatalf("maintenanceHoldFor() = no hold, want %v", tt.held)
+			}
+			if len(hold.windows) != len(tt.held) {
+				t.Errorf("held by %v, want %v", hold.windows, tt.held)
+			}
+			if hold.next == nil || hold.next.UTC().Format(time.RFC3339) != tt.next {
+				t.Errorf("next = %v, want %s", hold.next, tt.next)
+			}
+			if d := hold.requeueAfter(now); d <= 0 || d > maxMaintenanceRequeue {
+				t.Errorf("requeueAfter() = %s", d)
+			}
+		})
+	}
+}
+
+func TestMaintenanceCloseFor(t *testing.T) {
+	// Thursday, 2026-01-01 10:00 UTC
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	window := func(name, start string, duration time.Duration, onClose opsapi.MaintenanceWindowClosePolicy) opsapi.MaintenanceWindow {
+		w := opsapi.MaintenanceWindow{}
+		w.Name = name
+		w.Spec.TimeZone = "UTC"
+		w.Spec.Windows = []opsapi.MaintenanceTimeWindow{{Start: start, Duration: metav1.Duration{Duration: duration}}}
+		w.Spec.OnWindowClose = onClose
+		return w
+	}
+	restart := &opsapi.PostgresOpsRequest{}
+	restart.Spec.Type = opsapi.PostgresOpsRequestTypeRestart
+	scale := &opsapi.PostgresOpsRequest{}
+	scale.Spec.Type = opsapi.PostgresOpsRequestTypeHorizontalScaling
+
+	tests := []struct {
+		name    string
+		windows []opsapi.MaintenanceWindow
+		req     opsapi.Accessor
+		closeAt string
+		policy  opsapi.MaintenanceWindowClosePolicy
+	}{
+		{
+			name: "no window",
+			req:  restart,
+		},
+		{
+			name:    "open window",
+			windows: []opsapi.MaintenanceWindow{window("morning", "09:30", time.Hour, opsapi.MaintenanceWindowClosePolicyCancel)},
+			req:     restart,
+			closeAt: "2026-01-01T10:30:00Z",
+		},
+		{
+			name: "latest close of the open windows",
+			windows: []opsapi.MaintenanceWindow{
+				window("morning", "09:30", time.Hour, opsapi.MaintenanceWindowClosePolicyHold),
+				window("long", "09:00", 3*time.Hour, opsapi.MaintenanceWindowClosePolicyHold),
+				window("nightly", "02:00", time.Hour, opsapi.MaintenanceWindowClosePolicyCancel),
+			},
+			req:     restart,
+			closeAt: "2026-01-01T12:00:00Z",
+		},
+		{
+			name:    "closed window holds by default",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00", time.Hour, "")},
+			req:     restart,
+			policy:  opsapi.MaintenanceWindowClosePolicyHold,
+		},
+		{
+			name:    "closed window holds",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00", time.Hour, opsapi.MaintenanceWindowClosePolicyHold)},
+			req:     restart,
+			policy:  opsapi.MaintenanceWindowClosePolicyHold,
+		},
+		{
+			name:    "closed window cancels",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00", time.Hour, opsapi.MaintenanceWindowClosePolicyCancel)},
+			req:     restart,
+			policy:  opsapi.MaintenanceWindowClosePolicyCancel,
+		},
+		{
+			name: "cancel wins over hold",
+			windows: []opsapi.MaintenanceWindow{
+				window("nightly", "02:00", time.Hour, opsapi.MaintenanceWindowClosePolicyHold),
+				window("evening", "22:00", time.Hour, opsapi.MaintenanceWindowClosePolicyCancel),
+			},
+			req:    restart,
+			policy: opsapi.MaintenanceWindowClosePolicyCancel,
+		},
+		{
+			name:    "types not held keep running",
+			windows: []opsapi.MaintenanceWindow{window("nightly", "02:00", time.Hour, opsapi.MaintenanceWindowClosePolicyCancel)},
+			req:     scale,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			closeAt, hold, err := maintenanceCloseFor(tt.windows, "Postgres", nil, nil, tt.req, now)
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch {
+			case tt.closeAt == "" && closeAt != nil:
+				t.Errorf("closeAt = %s, want none", closeAt.UTC().Format(time.RFC3339))
+			case tt.closeAt != "" && (closeAt == nil || closeAt.UTC().Format(time.RFC3339) != tt.closeAt):
+				t.Errorf("closeAt = %v, want %s", closeAt, tt.closeAt)
+			}
+			switch {
+			case tt.policy == "" && hold != nil:
+				t.Errorf("maintenanceCloseFor() = %s, want no hold", hold.message())
+			case tt.policy != "" && hold == nil:
+				t.Errorf("maintenanceCloseFor() = no hold, want policy %s", tt.policy)
+			case tt.policy != "" && hold.policy != tt.policy:
+				t.Errorf("policy = %s, want %s", hold.policy, tt.policy)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	cu "kmodules.xyz/client-go/client"
+	cutil "kmodules.xyz/client-go/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// approvalRequeue is how often an ops request waiting for approval is checked again.
+const approvalRequeue = 30 * time.Second
+
+// approvalGate is the result of checking an ops request against the OpsApprovalPolicies.
+type approvalGate struct {
+	// phase is WaitingForApproval, Approved or Denied.
+	phase opsapi.OpsRequestPhase
+	// records are the decisions of the approvers, with the policies that permitted them.
+	records []opsapi.OpsApprovalRecord
+	// approvals are the OpsApprovals of the records, in the same order.
+	approvals []opsapi.OpsApproval
+	// waiting are the policies that have not approved the ops request yet.
+	waiting []string
+	// denial is the record that denied the ops request.
+	denial *opsapi.OpsApprovalRecord
+}
+
+func (g *approvalGate) message() string {
+	switch g.phase {
+	case opsapi.OpsRequestDenied:
+		return fmt.Sprintf("denied by %s in OpsApproval %s", g.denial.Approver.Username, g.denial.Name)
+	case opsapi.OpsRequestPhaseWaitingForApproval:
+		return fmt.Sprintf("waiting for approval under OpsApprovalPolicy %s", strings.Join(g.waiting, ", "))
+	}
+	return fmt.Sprintf("approved in %d OpsApprovals", len(g.records))
+}
+
+// approvalGateFor checks an ops request against the OpsApprovalPolicies that select its database and
+// apply to it, with the OpsApprovals in its namespace. It returns nil if no policy applies. The ops
+// request is approved once every applicable policy approved it, and denied by a single denial under
+// any of them.
+func approvalGateFor(policies []opsapi.OpsApprovalPolicy, kind string, nsLabels, dbLabels map[string]string, opsKind string, req opsapi.Accessor, approvals []opsapi.OpsApproval) (*approvalGate, error) {
+	var applicable []opsapi.OpsApprovalPolicy
+	for _, p := range policies {
+		ok, err := p.Selects(kind, nsLabels, dbLabels)
+		if err != nil {
+			return nil, err
+		}
+		if ok && p.AppliesTo(req) {
+			applicable = append(applicable, p)
+		}
+	}
+	if len(applicable) == 0 {
+		return nil, nil
+	}
+
+	gate := &approvalGate{}
+	for _, a := range approvals {
+		if a.Spec.OpsRequestRef.Kind != opsKind || a.Spec.OpsRequestRef.Name != req.GetName() || a.Spec.Approver == nil {
+			continue
+		}
+		r := a.Record()
+		r.Policies = nil
+		for _, p := range applicable {
+			if p.IsApprover(r.Approver) {
+				r.Policies = append(r.Policies, p.Name)
+			}
+		}
+		if len(r.Policies) == 0 {
+			continue
+		}
+		gate.records = append(gate.records, r)
+		gate.approvals = append(gate.approvals, a)
+	}
+	sort.Sort(recordsByTime{gate})
+
+	gate.phase = opsapi.OpsRequestApproved
+	for _, p := range applicable {
+		switch p.ApprovalPhase(gate.records) {
+		case opsapi.OpsRequestDenied:
+			for i := range gate.records {
+				if gate.records[i].Decision == opsapi.OpsApprovalDecisionDeny && p.IsApprover(gate.records[i].Approver) {
+					gate.phase = opsapi.OpsRequestDenied
+					gate.denial = &gate.records[i]
+					return gate, nil
+				}
+			}
+		case opsapi.OpsRequestPhaseWaitingForApproval:
+			gate.phase = opsapi.OpsRequestPhaseWaitingForApproval
+			gate.waiting = append(gate.waiting, p.Name)
+		}
+	}
+	return gate, nil
+}
+
+// recordsByTime orders the records of a gate, and their OpsApprovals, by the time of the decision.
+type recordsByTime struct{ *approvalGate }
+
+func (g recordsByTime) Len() int { return len(g.records) }
+
+func (g recordsByTime) Less(i, j int) bool {
+	if !g.records[i].Time.Equal(&g.records[j].Time) {
+		return g.records[i].Time.Before(&g.records[j].Time)
+	}
+	return g.records[i].Name < g.records[j].Name
+}
+
+func (g recordsByTime) Swap(i, j int) {
+	g.records[i], g.records[j] = g.records[j], g.records[i]
+	g.approvals[i], g.approvals[j] = g.approvals[j], g.approvals[i]
+}
+
+// checkApprovalPolicies checks the ops request against the OpsApprovalPolicies of the cluster.
+func (c *OpsRequestController) checkApprovalPolicies(ctx context.Context, req opsapi.Accessor) (*approvalGate, error) {
+	var policies opsapi.OpsApprovalPolicyList
+	if err := c.kbClient.List(ctx, &policies); err != nil {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+	nsLabels, dbLabels, found, err := c.databaseLabels(ctx, req)
+	if err != nil || !found {
+		return nil, err
+	}
+	var approvals opsapi.OpsApprovalList
+	if err := c.kbClient.List(ctx, &approvals, client.InNamespace(req.GetNamespace())); err != nil {
+		return nil, err
+	}
+	return approvalGateFor(policies.Items, c.dbKind(), nsLabels, dbLabels, c.kind, req, approvals.Items)
+}
+
+// recordApprovals adds the decisions to status.approvals of the ops request, moves it to the phase of
+// the gate, and marks the OpsApprovals as recorded.
+func (c *OpsRequestController) recordApprovals(ctx context.Context, req opsapi.Accessor, gate *approvalGate) error {
+	condType := opsapi.ApprovalsSatisfied
+	switch gate.phase {
+	case opsapi.OpsRequestDenied:
+		condType = opsapi.DeniedByApprover
+	case opsapi.OpsRequestPhaseWaitingForApproval:
+		condType = opsapi.ApprovalRequired
+	}
+	_, err := cu.PatchStatus(ctx, c.kbClient, req, func(obj client.Object) client.Object {
+		ret := obj.(opsapi.Accessor)
+		sts := ret.GetStatus()
+		sts.Phase = gate.phase
+		sts.Approvals = gate.records
+		sts.Conditions = cutil.SetCondition(sts.Conditions, cutil.NewCondition(condType, gate.message(), req.GetObjectMeta().Generation))
+		ret.SetStatus(sts)
+		return ret
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := range gate.approvals {
+		a := &gate.approvals[i]
+		if a.Status.Recorded {
+			continue
+		}
+		policies := gate.records[i].Policies
+		_, err = cu.PatchStatus(ctx, c.kbClient, a, func(obj client.Object) client.Object {
+			ret := obj.(*opsapi.OpsApproval)
+			ret.Status.ObservedGeneration = ret.Generation
+			ret.Status.Policies = policies
+			ret.Status.Recorded = true
+			ret.Status.Conditions = cutil.SetCondition(ret.Status.Conditions, cutil.NewCondition(opsapi.ApprovalRecorded,
+				fmt.Sprintf("recorded for %s %s/%s", c.kind, req.GetNamespace(), req.GetName()), ret.Generation))
+			return ret
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,183 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	rbac "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApprovalGateFor(t *testing.T) {
+	now := time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC)
+	policy := func(name string, minApprovals int32, kinds []string, users ...string) opsapi.OpsApprovalPolicy {
+		p := opsapi.OpsApprovalPolicy{}
+		p.Name = name
+		p.Spec.MinApprovals = minApprovals
+		for _, u := range users {
+			p.Spec.Approvers = append(p.Spec.Approvers, rbac.Subject{Kind: rbac.UserKind, Name: u})
+		}
+		if kinds != nil {
+			p.Spec.DatabaseSelector = &opsapi.OpsDatabaseSelector{Kinds: kinds}
+		}
+		return p
+	}
+	approval := func(name, opsName, user string, decision opsapi.OpsApprovalDecision, minute int) opsapi.OpsApproval {
+		a := opsapi.OpsApproval{}
+		a.Name = name
+		a.CreationTimestamp = metav1.NewTime(now.Add(time.Duration(minute) * time.Minute))
+		a.Spec.OpsRequestRef.Kind = "PostgresOpsRequest"
+		a.Spec.OpsRequestRef.Name = opsName
+		a.Spec.Approver = &opsapi.OpsApprover{Username: user}
+		a.Spec.Decision = decision
+		return a
+	}
+	req := &opsapi.PostgresOpsRequest{}
+	req.Name = "restart"
+	req.Spec.Type = opsapi.PostgresOpsRequestTypeRestart
+
+	prod := policy("prod", 2, nil, "alice", "bob")
+	dba := policy("dba", 1, []string{"Postgres"}, "carol")
+
+	tests := []struct {
+		name       string
+		policies   []opsapi.OpsApprovalPolicy
+		approvals  []opsapi.OpsApproval
+		phase      opsapi.OpsRequestPhase
+		records    []string
+		policiesOf map[string][]string
+		waiting    []string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:     "policy of another kind",
+			policies: []opsapi.OpsApprovalPolicy{policy("mysql", 1, []string{"MySQL"}, "alice")},
+		},
+		{
+			name:     "no approvals yet",
+			policies: []opsapi.OpsApprovalPolicy{prod},
+			phase:    opsapi.OpsRequestPhaseWaitingForApproval,
+			waiting:  []string{"prod"},
+		},
+		{
+			name:     "approvals of other ops requests and non approvers are not recorded",
+			policies: []opsapi.OpsApprovalPolicy{prod},
+			approvals: []opsapi.OpsApproval{
+				approval("a1", "restart", "alice", opsapi.OpsApprovalDecisionApprove, 1),
+				approval("a2", "scale", "bob", opsapi.OpsApprovalDecisionApprove, 2),
+				approval("a3", "restart", "mallory", opsapi.OpsApprovalDecisionDeny, 3),
+			},
+			phase:      opsapi.OpsRequestPhaseWaitingForApproval,
+			records:    []string{"a1"},
+			policiesOf: map[string][]string{"a1": {"prod"}},
+			waiting:    []string{"prod"},
+		},
+		{
+			name:     "every policy must approve",
+			policies: []opsapi.OpsApprovalPolicy{prod, dba},
+			approvals: []opsapi.OpsApproval{
+				approval("a2", "restart", "bob", opsapi.OpsApprovalDecisionApprove, 2),
+				approval("a1", "restart", "alice", opsapi.OpsApprovalDecisionApprove, 1),
+			},
+			phase:      opsapi.OpsRequestPhaseWaitingForApproval,
+			records:    []string{"a1", "a2"},
+			policiesOf: map[string][]string{"a1": {"prod"}, "a2": {"prod"}},
+			waiting:    []string{"dba"},
+		},
+		{
+			name:     "approved",
+			policies: []opsapi.OpsApprovalPolicy{prod, dba},
+			approvals: []opsapi.OpsApproval{
+				approval("a1", "restart", "alice", opsapi.OpsApprovalDecisionApprove, 1),
+				approval("a2", "restart", "bob", opsapi.OpsApprovalDecisionApprove, 2),
+				approval("a3", "restart", "carol", opsapi.OpsApprovalDecisionApprove, 3),
+			},
+			phase:      opsapi.OpsRequestApproved,
+			records:    []string{"a1", "a2", "a3"},
+			policiesOf: map[string][]string{"a1": {"prod"}, "a2": {"prod"}, "a3": {"dba"}},
+		},
+		{
+			name:     "approver of both policies",
+			policies: []opsapi.OpsApprovalPolicy{prod, policy("dba", 1, nil, "alice")},
+			approvals: []opsapi.OpsApproval{
+				approval("a1", "restart", "alice", opsapi.OpsApprovalDecisionApprove, 1),
+			},
+			phase:      opsapi.OpsRequestPhaseWaitingForApproval,
+			records:    []string{"a1"},
+			policiesOf: map[string][]string{"a1": {"prod", "dba"}},
+			waiting:    []string{"prod"},
+		},
+		{
+			name:     "denied under one policy",
+			policies: []opsapi.OpsApprovalPolicy{prod, dba},
+			approvals: []opsapi.OpsApproval{
+				approval("a1", "restart", "alice", opsapi.OpsApprovalDecisionApprove, 1),
+				approval("a2", "restart", "bob", opsapi.OpsApprovalDecisionApprove, 2),
+				approval("a3", "restart", "carol", opsapi.OpsApprovalDecisionDeny, 3),
+			},
+			phase:      opsapi.OpsRequestDenied,
+			records:    []string{"a1", "a2", "a3"},
+			policiesOf: map[string][]string{"a1": {"prod"}, "a2": {"prod"}, "a3": {"dba"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gate, err := approvalGateFor(tt.policies, "Postgres", nil, nil, "PostgresOpsRequest", req, tt.approvals)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.phase == "" {
+				if gate != nil {
+					t.Fatalf("approvalGateFor() = %s, want no gate", gate.message())
+				}
+				return
+			}
+			if gate == nil {
+				t.Fatalf("approvalGateFor() = no gate, want %s", tt.phase)
+			}
+			if gate.phase != tt.phase {
+				t.Errorf("phase = %s, want %s", gate.phase, tt.phase)
+			}
+			var records []string
+			for i, r := range gate.records {
+				records = append(records, r.Name)
+				if gate.approvals[i].Name != r.Name {
+					t.Errorf("approvals[%d] = %s, want %s", i, gate.approvals[i].Name, r.Name)
+				}
+				if !reflect.DeepEqual(r.Policies, tt.policiesOf[r.Name]) {
+					t.Errorf("policies of %s = %v, want %v", r.Name, r.Policies, tt.policiesOf[r.Name])
+				}
+			}
+			if !reflect.DeepEqual(records, tt.records) {
+				t.Errorf("records = %v, want %v", records, tt.records)
+			}
+			if !reflect.DeepEqual(gate.waiting, tt.waiting) {
+				t.Errorf("waiting = %v, want %v", gate.waiting, tt.waiting)
+			}
+			if tt.phase == opsapi.OpsRequestDenied && (gate.denial == nil || gate.denial.Decision != opsapi.OpsApprovalDecisionDeny) {
+				t.Errorf("denial = %v", gate.denial)
+			}
+		})
+	}
+}
@@ -0,0 +1,428 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	cutil "kmodules.xyz/client-go/conditions"
+	meta_util "kmodules.xyz/client-go/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// concurrencyMux serializes the OpsConcurrencyPolicy checks of the ops request controllers of all kinds,
+// so that two of them never take the last free slot of a limit at the same time.
+var concurrencyMux sync.Mutex
+
+// concurrencySnapshotTTL is how long the running and queued ops requests of all kinds, once listed, are
+// reused by the checks that follow, e.g. by those of the ops requests reconciled in the same batch.
+const concurrencySnapshotTTL = 10 * time.Second
+
+// concurrencySnapshot holds the running and queued ops requests of all kinds, and the loader that read
+// their databases. It is guarded by concurrencyMux.
+type concurrencySnapshot struct {
+	taken   time.Time
+	loader  *concurrencyLoader
+	entries []*concurrencyEntry
+}
+
+var snapshot *concurrencySnapshot
+
+// record replaces the entry of an ops request with the outcome of its check, so that the checks until the
+// next listing count it as running or queued.
+func (s *concurrencySnapshot) record(entry *concurrencyEntry) {
+	for i, o := range s.entries {
+		if sameOpsRequest(o, entry) {
+			s.entries[i] = entry
+			return
+		}
+	}
+	s.entries = append(s.entries, entry)
+}
+
+func sameOpsRequest(a, b *concurrencyEntry) bool {
+	return a.kind == b.kind && a.req.GetNamespace() == b.req.GetNamespace() && a.req.GetName() == b.req.GetName()
+}
+
+// concurrencyEntry is an ops request of any kind, as counted by the OpsConcurrencyPolicies.
+type concurrencyEntry struct {
+	kind     string // database kind, e.g. Postgres
+	req      opsapi.Accessor
+	running  bool
+	queued   bool
+	nsLabels map[string]string
+	dbLabels map[string]string
+	// groups returns the groups the ops request is counted in for a limit.
+	groups func(l opsapi.OpsConcurrencyLimit) (sets.Set[string], error)
+}
+
+// concurrencyQueue is the result of checking an ops request against the OpsConcurrencyPolicies.
+type concurrencyQueue struct {
+	position int
+	policy   string
+	group    string
+	running  int
+	max      int32
+}
+
+func (q *concurrencyQueue) message() string {
+	where := "the cluster"
+	if q.group != "" {
+		where = q.group
+	}
+	return fmt.Sprintf("position %d in the queue of OpsConcurrencyPolicy %s: %d of %d ops requests are running in %s",
+		q.position, q.policy, q.running, q.max, where)
+}
+
+// queuePosition returns where the ops request stands in the queue of the limits it is over, or nil if
+// it fits into every limit. Running ops requests and the queued ones created before it count against a
+// limit; the worst position over all limits and groups is returned.
+func queuePosition(policies []opsapi.OpsConcurrencyPolicy, entry *concurrencyEntry, others []*concurrencyEntry) (*concurrencyQueue, error) {
+	var worst *concurrencyQueue
+	for _, p := range policies {
+		ok, err := p.Selects(entry.kind, entry.nsLabels, entry.dbLabels)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			continue
+		}
+		for _, l := range p.Spec.Limits {
+			if !l.CountsOpsType(entry.req.GetRequestType()) {
+				continue
+			}
+			groups, err := groupsOf(entry, l)
+			if err != nil {
+				return nil, err
+			}
+			for _, g := range sets.List(groups) {
+				var running, ahead int
+				for _, o := range others {
+					if !l.CountsOpsType(o.req.GetRequestType()) {
+						continue
+					}
+					if !o.running && !(o.queued && queuedBefore(o.req, entry.req)) {
+						continue
+					}
+					selected, err := p.Selects(o.kind, o.nsLabels, o.dbLabels)
+					if err != nil {
+						return nil, err
+					}
+					if !selected {
+						continue
+					}
+					og, err := groupsOf(o, l)
+					if err != nil {
+						return nil, err
+					}
+					if !og.Has(g) {
+						continue
+					}
+					if o.running {
+						running++
+					} else {
+						ahead++
+					}
+				}
+				if pos := running + ahead - int(l.MaxConcurrent) + 1; pos > 0 && (worst == nil || pos > worst.position) {
+					worst = &concurrencyQueue{
+						position: pos,
+						policy:   p.Name,
+						group:    groupName(l, g),
+						running:  running,
+						max:      l.MaxConcurrent,
+					}
+				}
+			}
+		}
+	}
+	return worst, nil
+}
+
+// groupsOf returns the groups an ops request is counted in for a limit. An ops request whose database has
+// no node pool or StorageClass yet, e.g. with unscheduled pods, is counted in a group of its own, "".
+func groupsOf(e *concurrencyEntry, l opsapi.OpsConcurrencyLimit) (sets.Set[string], error) {
+	groups, err := e.groups(l)
+	if err != nil || groups.Len() > 0 {
+		return groups, err
+	}
+	return sets.New(""), nil
+}
+
+func isQueuedByConcurrencyPolicy(req opsapi.Accessor) bool {
+	return req.GetStatus().Phase == pendingPhase(req.GetStatus()) &&
+		cutil.IsConditionTrue(req.GetStatus().Conditions, opsapi.QueuedByConcurrencyPolicy)
+}
+
+// queuedBefore orders the queue by creation time, then by namespace and name.
+func queuedBefore(a, b opsapi.Accessor) bool {
+	ta, tb := a.GetCreationTimestamp(), b.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
+
+func groupName(l opsapi.OpsConcurrencyLimit, g string) string {
+	switch l.GetScope() {
+	case opsapi.OpsConcurrencyScopeNamespace:
+		return "namespace " + g
+	case opsapi.OpsConcurrencyScopeNodePool:
+		if g == "" {
+			return "databases without a node pool"
+		}
+		return "node pool " + g
+	case opsapi.OpsConcurrencyScopeStorageClass:
+		if g == "" {
+			return "databases without a StorageClass"
+		}
+		return "StorageClass " + g
+	}
+	return ""
+}
+
+// checkConcurrencyPolicies checks the ops request against the given OpsConcurrencyPolicies, counting the
+// running and queued ops requests of every kind. It must be called with concurrencyMux held.
+func (c *OpsRequestController) checkConcurrencyPolicies(ctx context.Context, req opsapi.Accessor, policies []opsapi.OpsConcurrencyPolicy) (*concurrencyQueue, error) {
+	s, err := c.concurrencySnapshot(ctx, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	entry, err := s.loader.entry(ctx, c.dbKind(), req)
+	if err != nil || entry == nil {
+		return nil, err
+	}
+
+	others := make([]*concurrencyEntry, 0, len(s.entries))
+	for _, o := range s.entries {
+		if !sameOpsRequest(o, entry) {
+			others = append(others, o)
+		}
+	}
+	queue, err := queuePosition(policies, entry, others)
+	if err != nil {
+		return nil, err
+	}
+	entry.running = queue == nil
+	entry.queued = queue != nil
+	s.record(entry)
+	return queue, nil
+}
+
+// concurrencySnapshot returns the running and queued ops requests of all kinds, listed again only once the
+// previous snapshot is older than concurrencySnapshotTTL. Until then, the outcomes of the checks are
+// recorded in it; ops requests that completed in the meantime are still counted as running.
+func (c *OpsRequestController) concurrencySnapshot(ctx context.Context, now time.Time) (*concurrencySnapshot, error) {
+	if snapshot != nil && now.Sub(snapshot.taken) < concurrencySnapshotTTL {
+		return snapshot, nil
+	}
+
+	s := &concurrencySnapshot{
+		taken:  now,
+		loader: &concurrencyLoader{kc: c.kbClient},
+	}
+	for gvk := range c.scheme.AllKnownTypes() {
+		if gvk.GroupVersion() != opsapi.SchemeGroupVersion || !strings.HasSuffix(gvk.Kind, "OpsRequest") {
+			continue
+		}
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk)
+		if err := c.kbClient.List(ctx, list); err != nil {
+			return nil, err
+		}
+		for i := range list.Items {
+			ops, err := c.unstructuredToOpsAccessor(&list.Items[i])
+			if err != nil {
+				return nil, err
+			}
+			running := ops.GetStatus().Phase == opsapi.OpsRequestPhaseProgressing
+			queued := isQueuedByConcurrencyPolicy(ops)
+			if !running && !queued {
+				continue
+			}
+			o, err := s.loader.entry(ctx, strings.TrimSuffix(gvk.Kind, "OpsRequest"), ops)
+			if err != nil {
+				return nil, err
+			}
+			if o != nil {
+				o.running, o.queued = running, queued
+				s.entries = append(s.entries, o)
+			}
+		}
+	}
+	snapshot = s
+	return s, nil
+}
+
+// concurrencyLoader reads the databases, namespaces, pods, nodes and PVCs needed to count ops
+// requests, each at most once per snapshot.
+type concurrencyLoader struct {
+	kc         client.Client
+	namespaces map[string]map[string]string
+	mappings   map[string]*meta.RESTMapping
+	pods       map[string][]core.Pod
+	nodes      map[string]map[string]string
+	pvcs       map[string][]core.PersistentVolumeClaim
+}
+
+// entry returns the concurrency entry of an ops request, or nil if its database is gone.
+func (l *concurrencyLoader) entry(ctx context.Context, kind string, req opsapi.Accessor) (*concurrencyEntry, error) {
+	mapping, err := l.mapping(kind)
+	if err != nil {
+		return nil, err
+	}
+	var db unstructured.Unstructured
+	db.SetGroupVersionKind(mapping.GroupVersionKind)
+	if err := l.kc.Get(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetDBRefName()}, &db); err != nil {
+		return nil, client.IgnoreNotFound(err)
+	}
+	nsLabels, err := l.namespaceLabels(ctx, req.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	selector := client.MatchingLabels{
+		meta_util.NameLabelKey:      fmt.Sprintf("%s.%s", mapping.Resource.Resource, kubedb.GroupName),
+		meta_util.InstanceLabelKey:  db.GetName(),
+		meta_util.ManagedByLabelKey: kubedb.GroupName,
+	}
+	key := req.GetNamespace() + "/" + kind + "/" + db.GetName()
+
+	return &concurrencyEntry{
+		kind:     kind,
+		req:      req,
+		nsLabels: nsLabels,
+		dbLabels: db.GetLabels(),
+		groups: func(lim opsapi.OpsConcurrencyLimit) (sets.Set[string], error) {
+			switch lim.GetScope() {
+			case opsapi.OpsConcurrencyScopeNamespace:
+				return sets.New(req.GetNamespace()), nil
+			case opsapi.OpsConcurrencyScopeNodePool:
+				return l.nodePools(ctx, key, req.GetNamespace(), selector, lim.NodePoolLabel)
+			case opsapi.OpsConcurrencyScopeStorageClass:
+				return l.storageClasses(ctx, key, req.GetNamespace(), selector)
+			}
+			return sets.New(""), nil
+		},
+	}, nil
+}
+
+func (l *concurrencyLoader) mapping(kind string) (*meta.RESTMapping, error) {
+	if m, ok := l.mappings[kind]; ok {
+		return m, nil
+	}
+	m, err := l.kc.RESTMapper().RESTMapping(schema.GroupKind{Group: kubedb.GroupName, Kind: kind})
+	if err != nil {
+		return nil, err
+	}
+	if l.mappings == nil {
+		l.mappings = map[string]*meta.RESTMapping{}
+	}
+	l.mappings[kind] = m
+	return m, nil
+}
+
+func (l *concurrencyLoader) namespaceLabels(ctx context.Context, name string) (map[string]string, error) {
+	if ls, ok := l.namespaces[name]; ok {
+		return ls, nil
+	}
+	var ns core.Namespace
+	if err := l.kc.Get(ctx, types.NamespacedName{Name: name}, &ns); err != nil {
+		return nil, err
+	}
+	if l.namespaces == nil {
+		l.namespaces = map[string]map[string]string{}
+	}
+	l.namespaces[name] = ns.Labels
+	return ns.Labels, nil
+}
+
+// nodePools returns the node pools the database pods are scheduled on.
+func (l *concurrencyLoader) nodePools(ctx context.Context, key, namespace string, selector client.MatchingLabels, label string) (sets.Set[string], error) {
+	pods, ok := l.pods[key]
+	if !ok {
+		var list core.PodList
+		if err := l.kc.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+			return nil, err
+		}
+		if l.pods == nil {
+			l.pods = map[string][]core.Pod{}
+		}
+		pods = list.Items
+		l.pods[key] = pods
+	}
+	pools := sets.New[string]()
+	for _, pod := range pods {
+		if pod.Spec.NodeName == "" {
+			continue
+		}
+		nodeLabels, ok := l.nodes[pod.Spec.NodeName]
+		if !ok {
+			var node core.Node
+			if err := l.kc.Get(ctx, types.NamespacedName{Name: pod.Spec.NodeName}, &node); client.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			if l.nodes == nil {
+				l.nodes = map[string]map[string]string{}
+			}
+			nodeLabels = node.Labels
+			l.nodes[pod.Spec.NodeName] = nodeLabels
+		}
+		if pool, ok := nodeLabels[label]; ok {
+			pools.Insert(pool)
+		}
+	}
+	return pools, nil
+}
+
+// storageClasses returns the StorageClasses of the database PVCs.
+func (l *concurrencyLoader) storageClasses(ctx context.Context, key, namespace string, selector client.MatchingLabels) (sets.Set[string], error) {
+	pvcs, ok := l.pvcs[key]
+	if !ok {
+		var list core.PersistentVolumeClaimList
+		if err := l.kc.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+			return nil, err
+		}
+		if l.pvcs == nil {
+			l.pvcs = map[string][]core.PersistentVolumeClaim{}
+		}
+		pvcs = list.Items
+		l.pvcs[key] = pvcs
+	}
+	classes := sets.New[string]()
+	for _, pvc := range pvcs {
+		if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName != "" {
+			classes.Insert(*pvc.Spec.StorageClassName)
+		}
+	}
+	return classes, nil
+}
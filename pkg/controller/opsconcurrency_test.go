@@ -0,0 +1,183 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+var queueStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func makeEntry(name, namespace, typ string, age int, running, queued bool, pools ...string) *concurrencyEntry {
+	req := &opsapi.PostgresOpsRequest{}
+	req.Name = name
+	req.Namespace = namespace
+	req.CreationTimestamp = metav1.NewTime(queueStart.Add(time.Duration(age) * time.Minute))
+	req.Spec.Type = opsapi.PostgresOpsRequestType(typ)
+	req.Status.Phase = opsapi.OpsRequestPhasePending
+	if running {
+		req.Status.Phase = opsapi.OpsRequestPhaseProgressing
+	}
+	if queued {
+		req.Status.Conditions = []kmapi.Condition{{Type: opsapi.QueuedByConcurrencyPolicy, Status: metav1.ConditionTrue}}
+	}
+	return &concurrencyEntry{
+		kind:    "Postgres",
+		req:     req,
+		running: running,
+		queued:  queued,
+		groups: func(l opsapi.OpsConcurrencyLimit) (sets.Set[string], error) {
+			switch l.GetScope() {
+			case opsapi.OpsConcurrencyScopeNamespace:
+				return sets.New(namespace), nil
+			case opsapi.OpsConcurrencyScopeNodePool:
+				return sets.New(pools...), nil
+			}
+			return sets.New(""), nil
+		},
+	}
+}
+
+func makePolicy(limits ...opsapi.OpsConcurrencyLimit) []opsapi.OpsConcurrencyPolicy {
+	p := opsapi.OpsConcurrencyPolicy{}
+	p.Name = "limits"
+	p.Spec.Limits = limits
+	return []opsapi.OpsConcurrencyPolicy{p}
+}
+
+func TestQueuePosition(t *testing.T) {
+	updates := opsapi.OpsConcurrencyLimit{OpsTypes: []string{"UpdateVersion"}, MaxConcurrent: 2}
+	perNamespace := opsapi.OpsConcurrencyLimit{Scope: opsapi.OpsConcurrencyScopeNamespace, MaxConcurrent: 1}
+	perPool := opsapi.OpsConcurrencyLimit{Scope: opsapi.OpsConcurrencyScopeNodePool, NodePoolLabel: "pool", MaxConcurrent: 1}
+
+	tests := []struct {
+		name     string
+		policies []opsapi.OpsConcurrencyPolicy
+		entry    *concurrencyEntry
+		others   []*concurrencyEntry
+		want     int
+	}{
+		{
+			name:     "under the limit",
+			policies: makePolicy(updates),
+			entry:    makeEntry("a", "demo", "UpdateVersion", 5, false, false),
+			others:   []*concurrencyEntry{makeEntry("b", "demo", "UpdateVersion", 1, true, false)},
+			want:     0,
+		},
+		{
+			name:     "other types are not counted",
+			policies: makePolicy(updates),
+			entry:    makeEntry("a", "demo", "UpdateVersion", 5, false, false),
+			others: []*concurrencyEntry{
+				makeEntry("b", "demo", "UpdateVersion", 1, true, false),
+				makeEntry("c", "demo", "Restart", 2, true, false),
+			},
+			want: 0,
+		},
+		{
+			name:     "queued behind the running and the earlier queued ones",
+			policies: makePolicy(updates),
+			entry:    makeEntry("a", "demo", "UpdateVersion", 5, false, false),
+			others: []*concurrencyEntry{
+				makeEntry("b", "demo", "UpdateVersion", 1, true, false),
+				makeEntry("c", "demo", "UpdateVersion", 2, true, false),
+				makeEntry("d", "demo", "UpdateVersion", 3, false, true),
+				makeEntry("e", "demo", "UpdateVersion", 9, false, true),
+			},
+			want: 2,
+		},
+		{
+			name:     "per namespace",
+			policies: makePolicy(perNamespace),
+			entry:    makeEntry("a", "demo", "Restart", 5, false, false),
+			others:   []*concurrencyEntry{makeEntry("b", "prod", "Restart", 1, true, false)},
+			want:     0,
+		},
+		{
+			name:     "per node pool, the busiest pool counts",
+			policies: makePolicy(perPool),
+			entry:    makeEntry("a", "demo", "Restart", 5, false, false, "x", "y"),
+			others: []*concurrencyEntry{
+				makeEntry("b", "prod", "Restart", 1, true, false, "y"),
+				makeEntry("c", "prod", "Restart", 2, false, true, "y"),
+			},
+			want: 2,
+		},
+		{
+			name:     "per node pool, unscheduled databases share a group",
+			policies: makePolicy(perPool),
+			entry:    makeEntry("a", "demo", "Restart", 5, false, false),
+			others: []*concurrencyEntry{
+				makeEntry("b", "prod", "Restart", 1, true, false),
+				makeEntry("c", "prod", "Restart", 2, true, false, "x"),
+			},
+			want: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := queuePosition(tt.policies, tt.entry, tt.others)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := 0
+			if q != nil {
+				got = q.position
+			}
+			if got != tt.want {
+				t.Errorf("queuePosition() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueuePositionInvalidSelector(t *testing.T) {
+	policies := makePolicy(opsapi.OpsConcurrencyLimit{MaxConcurrent: 1})
+	policies[0].Spec.DatabaseSelector = &opsapi.OpsDatabaseSelector{
+		Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tier", Operator: "Bogus"}}},
+	}
+	entry := makeEntry("a", "demo", "Restart", 5, false, false)
+	if _, err := queuePosition(policies, entry, nil); err == nil {
+		t.Error("queuePosition() = nil error, want the selector error")
+	}
+}
+
+func TestConcurrencySnapshotRecord(t *testing.T) {
+	s := &concurrencySnapshot{entries: []*concurrencyEntry{
+		makeEntry("a", "demo", "Restart", 1, false, true),
+		makeEntry("b", "demo", "Restart", 2, true, false),
+	}}
+	s.record(makeEntry("a", "demo", "Restart", 1, true, false))
+	s.record(makeEntry("c", "demo", "Restart", 3, false, true))
+
+	if len(s.entries) != 3 {
+		t.Fatalf("len(entries) = %d, want 3", len(s.entries))
+	}
+	if !s.entries[0].running || s.entries[0].queued {
+		t.Errorf("entry a = running %v, queued %v, want running", s.entries[0].running, s.entries[0].queued)
+	}
+	if s.entries[2].req.GetName() != "c" || !s.entries[2].queued {
+		t.Errorf("entry c is not recorded as queued")
+	}
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+	"kubedb.dev/apimachinery/pkg/lib"
 
 	kerr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -147,7 +148,7 @@ func (c *OpsRequestController) IsCompleted(key, conditionType string) bool {
 
 	return cutil.IsConditionTrue(ops.GetStatus().Conditions, conditionType) || cutil.IsConditionTrue(ops.GetStatus().Conditions, opsapi.Successful) ||
 		ops.GetStatus().Phase == opsapi.OpsRequestPhaseSuccessful || ops.GetStatus().Phase == opsapi.OpsRequestPhaseFailed ||
-		ops.GetStatus().Phase == opsapi.OpsRequestPhaseSkipped
+		ops.GetStatus().Phase == opsapi.OpsRequestPhaseSkipped || ops.GetStatus().Phase == opsapi.OpsRequestDenied
 }
 
 func (c *OpsRequestController) getOpsObjFromKey(key string) (opsapi.Accessor, error) {
@@ -208,8 +209,7 @@ type ProgressingController struct {
 
 // Safely transition a OpsRequest from Pending → Progressing, ensuring that at most one OpsRequest targeting the same database is in Progressing phase at any time
 func (c *OpsRequestController) UpdateOpsPhaseProgressing(req opsapi.Accessor, typ, msg string) (time.Duration, error) { // requeueTime as first param. if duration is zero, then don't requeue.
-	dbKind := strings.TrimSuffix(c.kind, "OpsRequest")
-	key := fmt.Sprintf("%s/%s/%s", dbKind, req.GetNamespace(), req.GetDBRefName())
+	key := fmt.Sprintf("%s/%s/%s", c.dbKind(), req.GetNamespace(), req.GetDBRefName())
 	p := c.getProgressTracker(key)
 
 	canLock := p.TryLock()
@@ -243,11 +243,83 @@ func (c *OpsRequestController) UpdateOpsPhaseProgressing(req opsapi.Accessor, ty
 		}
 	}
 
+	if err := c.ensureOpsRequestSource(context.TODO(), req); err != nil {
+		return 0, err
+	}
+	// the impact is informational, so an ops request is not held back when it can not be estimated
+	if err := lib.RecordImpact(context.TODO(), c.kbClient, req); err != nil {
+		klog.Warning(fmt.Sprintf("failed to record the impact of %s %s/%s: %v", c.kind, req.GetNamespace(), req.GetName(), err))
+	}
+	if !cutil.IsConditionTrue(req.GetStatus().Conditions, opsapi.ApprovalsSatisfied) {
+		gate, err := c.checkApprovalPolicies(context.TODO(), req)
+		if err != nil {
+			return 0, err
+		}
+		if gate != nil {
+			klog.Info(fmt.Sprintf("%s %s/%s is %s: %s", c.kind, req.GetNamespace(), req.GetName(), gate.phase, gate.message()))
+			if err := c.recordApprovals(context.TODO(), req, gate); err != nil {
+				return 0, err
+			}
+			switch gate.phase {
+			case opsapi.OpsRequestDenied:
+				return 0, nil
+			case opsapi.OpsRequestPhaseWaitingForApproval:
+				return approvalRequeue, nil
+			}
+		}
+	}
+	now := time.Now()
+	hold, err := c.checkMaintenanceWindows(context.TODO(), req, now)
+	if err != nil {
+		return 0, err
+	}
+	if hold != nil {
+		klog.Info(fmt.Sprintf("%s %s/%s is held: %s", c.kind, req.GetNamespace(), req.GetName(), hold.message()))
+		_, err = cu.PatchStatus(context.TODO(), c.kbClient, req, func(obj client.Object) client.Object {
+			ret := obj.(opsapi.Accessor)
+			sts := ret.GetStatus()
+			sts.Phase = pendingPhase(sts)
+			sts.Conditions = cutil.SetCondition(sts.Conditions, cutil.NewCondition(opsapi.WaitingForMaintenanceWindow, hold.message(), req.GetObjectMeta().Generation))
+			ret.SetStatus(sts)
+			return ret
+		})
+		return hold.requeueAfter(now), err
+	}
+
+	var policies opsapi.OpsConcurrencyPolicyList
+	if err := c.kbClient.List(context.TODO(), &policies); err != nil {
+		return 0, err
+	}
+	if len(policies.Items) > 0 {
+		// the OpsConcurrencyPolicies are checked under a lock shared by all kinds, held until this ops request
+		// is seen as Progressing, so that the next check counts it
+		concurrencyMux.Lock()
+		defer concurrencyMux.Unlock()
+		queue, err := c.checkConcurrencyPolicies(context.TODO(), req, policies.Items)
+		if err != nil {
+			return 0, err
+		}
+		if queue != nil {
+			klog.Info(fmt.Sprintf("%s %s/%s is queued: %s", c.kind, req.GetNamespace(), req.GetName(), queue.message()))
+			_, err = cu.PatchStatus(context.TODO(), c.kbClient, req, func(obj client.Object) client.Object {
+				ret := obj.(opsapi.Accessor)
+				sts := ret.GetStatus()
+				sts.Phase = pendingPhase(sts)
+				sts.Conditions = cutil.SetCondition(sts.Conditions, cutil.NewCondition(opsapi.QueuedByConcurrencyPolicy, queue.message(), req.GetObjectMeta().Generation))
+				ret.SetStatus(sts)
+				return ret
+			})
+			return 30 * time.Second, err
+		}
+	}
+
 	_, err = cu.PatchStatus(context.TODO(), c.kbClient, req, func(obj client.Object) client.Object {
 		ret := obj.(opsapi.Accessor)
 		sts := ret.GetStatus()
 		sts.Phase = opsapi.OpsRequestPhaseProgressing
 		sts.ObservedGeneration = ret.GetObjectMeta().Generation
+		sts.Conditions = cutil.RemoveCondition(sts.Conditions, opsapi.WaitingForMaintenanceWindow)
+		sts.Conditions = cutil.RemoveCondition(sts.Conditions, opsapi.QueuedByConcurrencyPolicy)
 		sts.Conditions = cutil.SetCondition(sts.Conditions, cutil.NewCondition(typ, msg, req.GetObjectMeta().Generation))
 		ret.SetStatus(sts)
 		return ret
@@ -293,6 +365,20 @@ func (c *OpsRequestController) UpdateOpsPhaseProgressing(req opsapi.Accessor, ty
 	}
 }
 
+// pendingPhase is the phase of an ops request that waits to start. An approved ops request stays
+// Approved, so that it is not sent through the approval gate again.
+func pendingPhase(sts opsapi.OpsRequestStatus) opsapi.OpsRequestPhase {
+	if sts.Phase == opsapi.OpsRequestApproved {
+		return opsapi.OpsRequestApproved
+	}
+	return opsapi.OpsRequestPhasePending
+}
+
+// dbKind returns the kind of the databases of the ops requests, e.g. Postgres.
+func (c *OpsRequestController) dbKind() string {
+	return strings.TrimSuffix(c.kind, "OpsRequest")
+}
+
 func (c *OpsRequestController) getProgressTracker(key string) *ProgressingController {
 	c.progressMux.Lock()
 	defer c.progressMux.Unlock()
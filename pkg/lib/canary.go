@@ -0,0 +1,98 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"fmt"
+	"time"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kmapi "kmodules.xyz/client-go/api/v1"
+)
+
+const defaultCanaryBakeTime = 5 * time.Minute
+
+// CanaryPod picks the replica a canary rollout starts with: the first pod of the RestartOrder, i.e. the
+// secondary with the highest ordinal. The primary is picked only if there is no other pod.
+func CanaryPod(pods []core.Pod) (string, error) {
+	if len(pods) == 0 {
+		return "", fmt.Errorf("no pod found for the canary")
+	}
+	return RestartOrder(pods)[0], nil
+}
+
+// CanaryHealthCheck returns the health check of the canary: the one of the strategy, or the health
+// checker of the database when unset.
+func CanaryHealthCheck(canary *opsapi.CanaryRolloutStrategy, dbHealthCheck kmapi.HealthCheckSpec) kmapi.HealthCheckSpec {
+	if canary != nil && canary.HealthCheck != nil {
+		return *canary.HealthCheck
+	}
+	return dbHealthCheck
+}
+
+// CanaryCheck is the result of checking the canary.
+type CanaryCheck struct {
+	// Healthy is false if the canary failed the health check FailureThreshold times in a row.
+	Healthy bool
+	// ReplicationLag is the replication lag of the canary, nil if it is the primary or the lag is unknown.
+	ReplicationLag *time.Duration
+}
+
+// EvaluateCanary decides the phase of a canary from the last check. The canary keeps Baking until the
+// bake time has passed since status.BakeStartTime; an unhealthy canary fails right away. At the end of
+// the bake time the replication lag must be within MaxReplicationLag.
+func EvaluateCanary(canary *opsapi.CanaryRolloutStrategy, status opsapi.CanaryStatus, check CanaryCheck, now time.Time) (opsapi.CanaryPhase, string) {
+	if !check.Healthy {
+		return opsapi.CanaryPhaseFailed, fmt.Sprintf("canary %s failed the health check", status.Pod)
+	}
+	bakeTime := defaultCanaryBakeTime
+	if canary != nil && canary.BakeTime != nil {
+		bakeTime = canary.BakeTime.Duration
+	}
+	if status.BakeStartTime == nil {
+		return opsapi.CanaryPhaseBaking, fmt.Sprintf("canary %s is baking for %s", status.Pod, bakeTime)
+	}
+	if left := status.BakeStartTime.Add(bakeTime).Sub(now); left > 0 {
+		return opsapi.CanaryPhaseBaking, fmt.Sprintf("canary %s is baking, %s left", status.Pod, left.Round(time.Second))
+	}
+	if canary != nil && canary.MaxReplicationLag != nil && check.ReplicationLag != nil && *check.ReplicationLag > canary.MaxReplicationLag.Duration {
+		return opsapi.CanaryPhaseFailed, fmt.Sprintf("canary %s is %s behind the primary, more than %s",
+			status.Pod, check.ReplicationLag.Round(time.Millisecond), canary.MaxReplicationLag.Duration)
+	}
+	return opsapi.CanaryPhasePassed, fmt.Sprintf("canary %s passed the checks", status.Pod)
+}
+
+// CanaryFailureCondition returns the condition type an ops request gets when its canary fails.
+func CanaryFailureCondition(canary *opsapi.CanaryRolloutStrategy) string {
+	if canary != nil && canary.OnFailure == opsapi.CanaryFailurePolicyRevert {
+		return opsapi.RevertedByCanary
+	}
+	return opsapi.PausedByCanary
+}
+
+// NewCanaryStatus returns the status of a canary that started baking at now.
+func NewCanaryStatus(pod string, now time.Time) *opsapi.CanaryStatus {
+	t := metav1.NewTime(now)
+	return &opsapi.CanaryStatus{
+		Pod:           pod,
+		Phase:         opsapi.CanaryPhaseBaking,
+		BakeStartTime: &t,
+	}
+}
@@ -0,0 +1,88 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"testing"
+	"time"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestCanaryPod(t *testing.T) {
+	pod := func(name string, primary bool) core.Pod {
+		p := core.Pod{}
+		p.Name = name
+		if primary {
+			p.Labels = map[string]string{kubedb.LabelRole: kubedb.DatabasePodPrimary}
+		}
+		return p
+	}
+	tests := []struct {
+		name string
+		pods []core.Pod
+		want string
+	}{
+		{name: "standalone", pods: []core.Pod{pod("db-0", true)}, want: "db-0"},
+		{name: "highest secondary", pods: []core.Pod{pod("db-0", false), pod("db-2", true), pod("db-10", false)}, want: "db-10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CanaryPod(tt.pods)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("CanaryPod() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateCanary(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	canary := &opsapi.CanaryRolloutStrategy{
+		BakeTime:          &metav1.Duration{Duration: 10 * time.Minute},
+		MaxReplicationLag: &metav1.Duration{Duration: 5 * time.Second},
+	}
+	status := *NewCanaryStatus("db-1", start)
+
+	tests := []struct {
+		name  string
+		check CanaryCheck
+		now   time.Time
+		want  opsapi.CanaryPhase
+	}{
+		{name: "baking", check: CanaryCheck{Healthy: true}, now: start.Add(time.Minute), want: opsapi.CanaryPhaseBaking},
+		{name: "unhealthy while baking", check: CanaryCheck{Healthy: false}, now: start.Add(time.Minute), want: opsapi.CanaryPhaseFailed},
+		{name: "passed", check: CanaryCheck{Healthy: true, ReplicationLag: ptr.To(time.Second)}, now: start.Add(11 * time.Minute), want: opsapi.CanaryPhasePassed},
+		{name: "lagging", check: CanaryCheck{Healthy: true, ReplicationLag: ptr.To(time.Minute)}, now: start.Add(11 * time.Minute), want: opsapi.CanaryPhaseFailed},
+		{name: "lag unknown", check: CanaryCheck{Healthy: true}, now: start.Add(11 * time.Minute), want: opsapi.CanaryPhasePassed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, msg := EvaluateCanary(canary, status, tt.check, tt.now); got != tt.want {
+				t.Errorf("EvaluateCanary() = %s (%s), want %s", got, msg, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	cu "kmodules.xyz/client-go/client"
+	meta_util "kmodules.xyz/client-go/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// restartingTypes are the ops request types that restart every pod of the database.
+var restartingTypes = sets.New[string](
+	"UpdateVersion",
+	"VerticalScaling",
+	"Restart",
+	"Reconfigure",
+	"ReconfigureTLS",
+	"RotateAuth",
+	"StorageMigration",
+	"Rollback",
+)
+
+// failoverTypes are the ops request types that move the primary role by design.
+var failoverTypes = sets.New[string](
+	"Switchover",
+	"ForceFailOver",
+)
+
+// EstimateImpact estimates what an ops request will do to its database: which pods restart and in what
+// order, whether the primary role moves, how much data is resized or copied and which backups are paused.
+func EstimateImpact(ctx context.Context, kc client.Client, req opsapi.Accessor) (*opsapi.OpsRequestImpact, error) {
+	gvk, err := apiutil.GVKForObject(req, kc.Scheme())
+	if err != nil {
+		return nil, err
+	}
+	kind := strings.TrimSuffix(gvk.Kind, "OpsRequest")
+	mapping, err := kc.RESTMapper().RESTMapping(schema.GroupKind{Group: kubedb.GroupName, Kind: kind})
+	if err != nil {
+		return nil, err
+	}
+	var db unstructured.Unstructured
+	db.SetGroupVersionKind(mapping.GroupVersionKind)
+	if err := kc.Get(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetDBRefName()}, &db); err != nil {
+		return nil, err
+	}
+	selector := client.MatchingLabels{
+		meta_util.NameLabelKey:      fmt.Sprintf("%s.%s", mapping.Resource.Resource, kubedb.GroupName),
+		meta_util.InstanceLabelKey:  db.GetName(),
+		meta_util.ManagedByLabelKey: kubedb.GroupName,
+	}
+
+	typ := req.GetRequestType()
+	now := metav1.Now()
+	result := &opsapi.OpsRequestImpact{
+		EstimateTime: &now,
+	}
+
+	if restartsPods(req) {
+		var pods core.PodList
+		if err := kc.List(ctx, &pods, client.InNamespace(req.GetNamespace()), selector); err != nil {
+			return nil, err
+		}
+		result.RestartOrder = RestartOrder(pods.Items)
+		// the primary restarts last, after its role is handed over to an already restarted pod
+		result.Failover = len(pods.Items) > 1 && hasPrimary(pods.Items)
+	}
+	if failoverTypes.Has(typ) {
+		result.Failover = true
+	}
+
+	if typ == "VolumeExpansion" || typ == "StorageMigration" {
+		var pvcs core.PersistentVolumeClaimList
+		if err := kc.List(ctx, &pvcs, client.InNamespace(req.GetNamespace()), selector); err != nil {
+			return nil, err
+		}
+		size := resource.Quantity{}
+		for _, pvc := range pvcs.Items {
+			if q, ok := pvc.Status.Capacity[core.ResourceStorage]; ok {
+				size.Add(q)
+			} else if q, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok {
+				size.Add(q)
+			}
+		}
+		result.Volumes = int32(len(pvcs.Items))
+		result.DataSize = &size
+	}
+
+	result.PausedBackups, err = ActiveBackupConfigurations(kc, metav1.ObjectMeta{Namespace: db.GetNamespace(), Name: db.GetName()}, kind)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ImpactWarnings formats an estimate as admission warnings.
+func ImpactWarnings(typ string, impact *opsapi.OpsRequestImpact) []string {
+	if impact == nil {
+		return nil
+	}
+	var warnings []string
+	if len(impact.RestartOrder) > 0 {
+		warnings = append(warnings, fmt.Sprintf("restarts pods %s in this order", strings.Join(impact.RestartOrder, ", ")))
+	}
+	if impact.Failover {
+		warnings = append(warnings, "the primary role is expected to fail over to another pod")
+	}
+	if impact.DataSize != nil && impact.Volumes > 0 {
+		verb := "resizes"
+		if typ == "StorageMigration" {
+			verb = "copies"
+		}
+		warnings = append(warnings, fmt.Sprintf("%s %d volumes of %s in total", verb, impact.Volumes, impact.DataSize.String()))
+	}
+	if len(impact.PausedBackups) > 0 {
+		names := make([]string, 0, len(impact.PausedBackups))
+		for _, b := range impact.PausedBackups {
+			names = append(names, b.Namespace+"/"+b.Name)
+		}
+		warnings = append(warnings, fmt.Sprintf("pauses BackupConfigurations %s while running", strings.Join(names, ", ")))
+	}
+	return warnings
+}
+
+// RecordImpact estimates the impact of an ops request and stores it in the status, unless it is already there.
+func RecordImpact(ctx context.Context, kc client.Client, req opsapi.Accessor) error {
+	if req.GetStatus().Impact != nil {
+		return nil
+	}
+	impact, err := EstimateImpact(ctx, kc, req)
+	if err != nil {
+		return err
+	}
+	_, err = cu.PatchStatus(ctx, kc, req, func(obj client.Object) client.Object {
+		in := obj.(opsapi.Accessor)
+		status := in.GetStatus()
+		status.Impact = impact
+		in.SetStatus(status)
+		return in
+	})
+	return err
+}
+
+// restartsPods reports whether an ops request restarts the pods of the database. VerticalScaling in InPlace
+// mode and VolumeExpansion in Online mode keep the pods running.
+func restartsPods(req opsapi.Accessor) bool {
+	switch req.GetRequestType() {
+	case "VerticalScaling", "VolumeExpansion":
+		return !opsapi.KeepsPodsRunning(req)
+	}
+	return restartingTypes.Has(req.GetRequestType())
+}
+
+// RestartOrder orders the pods the way the ops manager restarts them: the secondaries first, from the
+// highest ordinal down, then the primary.
+func RestartOrder(pods []core.Pod) []string {
+	pods = append([]core.Pod(nil), pods...)
+	sort.Slice(pods, func(i, j int) bool {
+		pi, pj := isPrimary(pods[i]), isPrimary(pods[j])
+		if pi != pj {
+			return pj
+		}
+		oi, oj := ordinal(pods[i].Name), ordinal(pods[j].Name)
+		if oi != oj {
+			return oi > oj
+		}
+		return pods[i].Name < pods[j].Name
+	})
+	names := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	return names
+}
+
+func hasPrimary(pods []core.Pod) bool {
+	for _, pod := range pods {
+		if isPrimary(pod) {
+			return true
+		}
+	}
+	return false
+}
+
+func isPrimary(pod core.Pod) bool {
+	return pod.Labels[kubedb.LabelRole] == kubedb.DatabasePodPrimary
+}
+
+func ordinal(name string) int {
+	i := strings.LastIndex(name, "-")
+	if i < 0 {
+		return -1
+	}
+	n, err := strconv.Atoi(name[i+1:])
+	if err != nil {
+		return -1
+	}
+	return n
+}
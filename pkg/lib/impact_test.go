@@ -0,0 +1,100 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"reflect"
+	"testing"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+)
+
+func makePod(name string, primary bool) core.Pod {
+	pod := core.Pod{}
+	pod.Name = name
+	if primary {
+		pod.Labels = map[string]string{kubedb.LabelRole: kubedb.DatabasePodPrimary}
+	}
+	return pod
+}
+
+func TestRestartOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		pods []core.Pod
+		want []string
+	}{
+		{
+			name: "standalone",
+			pods: []core.Pod{makePod("db-0", false)},
+			want: []string{"db-0"},
+		},
+		{
+			name: "secondaries from the highest ordinal down, primary last",
+			pods: []core.Pod{makePod("db-0", false), makePod("db-1", true), makePod("db-2", false)},
+			want: []string{"db-2", "db-0", "db-1"},
+		},
+		{
+			name: "ordinals compare as numbers",
+			pods: []core.Pod{makePod("db-2", false), makePod("db-10", false), makePod("db-0", true)},
+			want: []string{"db-10", "db-2", "db-0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := RestartOrder(tt.pods); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("RestartOrder() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRestarts(t *testing.T) {
+	request := func(typ opsapi.MariaDBOpsRequestType, vsMode opsapi.VerticalScalingMode, veMode opsapi.VolumeExpansionMode) opsapi.Accessor {
+		req := &opsapi.MariaDBOpsRequest{}
+		req.Spec.Type = typ
+		if vsMode != "" {
+			req.Spec.VerticalScaling = &opsapi.MariaDBVerticalScalingSpec{Mode: vsMode}
+		}
+		if veMode != "" {
+			req.Spec.VolumeExpansion = &opsapi.MariaDBVolumeExpansionSpec{Mode: veMode}
+		}
+		return req
+	}
+	tests := []struct {
+		name string
+		req  opsapi.Accessor
+		want bool
+	}{
+		{name: "restart", req: request(opsapi.MariaDBOpsRequestTypeRestart, "", ""), want: true},
+		{name: "horizontal scaling", req: request(opsapi.MariaDBOpsRequestTypeHorizontalScaling, "", ""), want: false},
+		{name: "vertical scaling by restart", req: request(opsapi.MariaDBOpsRequestTypeVerticalScaling, opsapi.VerticalScalingModeRestart, ""), want: true},
+		{name: "vertical scaling in place", req: request(opsapi.MariaDBOpsRequestTypeVerticalScaling, opsapi.VerticalScalingModeInPlace, ""), want: false},
+		{name: "offline volume expansion", req: request(opsapi.MariaDBOpsRequestTypeVolumeExpansion, "", opsapi.VolumeExpansionModeOffline), want: true},
+		{name: "online volume expansion", req: request(opsapi.MariaDBOpsRequestTypeVolumeExpansion, "", opsapi.VolumeExpansionModeOnline), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := restartsPods(tt.req); got != tt.want {
+				t.Errorf("restartsPods(%s) = %v, want %v", tt.req.GetRequestType(), got, tt.want)
+			}
+		})
+	}
+}
@@ -128,6 +128,34 @@ func resumeKubeStashBackupConfiguration(KBClient client.Client, pausedBackups []
 	return opsConditions, nil
 }
 
+// ActiveBackupConfigurations returns the KubeStash BackupConfigurations of a database that are not paused,
+// i.e. the ones an ops request pauses while it runs.
+func ActiveBackupConfigurations(KBClient client.Client, dbObjMeta metav1.ObjectMeta, kind string) ([]kmapi.TypedObjectReference, error) {
+	if !kubeStashOperatorExist(KBClient) {
+		return nil, nil
+	}
+	backupConfigList, err := getBackupConfigList(KBClient)
+	if err != nil {
+		return nil, err
+	}
+
+	var backups []kmapi.TypedObjectReference
+	for _, config := range backupConfigList.Items {
+		if config.Spec.Target == nil {
+			continue
+		}
+		if matchesTarget(*config.Spec.Target, dbObjMeta, kind, config.Namespace) && !config.Spec.Paused {
+			backups = append(backups, kmapi.TypedObjectReference{
+				APIGroup:  coreapi.GroupVersion.Group,
+				Kind:      coreapi.ResourceKindBackupConfiguration,
+				Name:      config.Name,
+				Namespace: config.Namespace,
+			})
+		}
+	}
+	return backups, nil
+}
+
 func modifyBackupConfiguration(KBClient client.Client, config *coreapi.BackupConfiguration, paused bool) error {
 	_, err := kmc.CreateOrPatch(
 		context.Background(),
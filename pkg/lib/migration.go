@@ -53,6 +53,12 @@ func GetDatabasePVCName(pvcTemplate string, podName string) string {
 	return meta_util.NameWithSuffix(pvcTemplate, podName)
 }
 
+// GetEphemeralVolumePVCName returns the name of the PVC kubernetes creates for a generic ephemeral volume of a pod,
+// e.g. the Memcached data volume. It is the source of CreateEphemeralVolumeMigratorJob.
+func GetEphemeralVolumePVCName(podName string, volumeName string) string {
+	return meta_util.NameWithSuffix(podName, volumeName)
+}
+
 func GetMigratorPVCName(podName string) string {
 	return meta_util.NameWithSuffix(migratorPVCTemplate, podName)
 }
@@ -154,6 +160,19 @@ func CreatePVCMounterPod(client kubernetes.Interface, dbPod *core.Pod, objMeta m
 }
 
 func CreateDataMigratorJob(client kubernetes.Interface, jobMeta metav1.ObjectMeta, dbPod *core.Pod, pvcTemplate string) error {
+	return createMigratorJob(client, jobMeta, dbPod, GetDatabasePVCName(pvcTemplate, dbPod.Name))
+}
+
+// CreateEphemeralVolumeMigratorJob copies the data of a generic ephemeral volume of a database pod into the
+// migrator PVC. The PVC of an ephemeral volume is owned by the pod and deleted with it, so the data is copied
+// out before the pod is recreated with the volume claim template on the new storage class, and copied back
+// into the new PVC of the recreated pod afterwards.
+func CreateEphemeralVolumeMigratorJob(client kubernetes.Interface, jobMeta metav1.ObjectMeta, dbPod *core.Pod, volumeName string) error {
+	return createMigratorJob(client, jobMeta, dbPod, GetEphemeralVolumePVCName(dbPod.Name, volumeName))
+}
+
+// createMigratorJob creates the job that copies the source PVC of a database pod into its migrator PVC.
+func createMigratorJob(client kubernetes.Interface, jobMeta metav1.ObjectMeta, dbPod *core.Pod, sourcePVC string) error {
 	job := batchv1.Job{
 		ObjectMeta: jobMeta,
 		Spec: batchv1.JobSpec{
@@ -203,7 +222,7 @@ func CreateDataMigratorJob(client kubernetes.Interface, jobMeta metav1.ObjectMet
 							Name: migratorJobSourceVolumeName,
 							VolumeSource: core.VolumeSource{
 								PersistentVolumeClaim: &core.PersistentVolumeClaimVolumeSource{
-									ClaimName: GetDatabasePVCName(pvcTemplate, dbPod.Name),
+									ClaimName: sourcePVC,
 								},
 							},
 						},
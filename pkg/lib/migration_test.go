@@ -0,0 +1,78 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"context"
+	"testing"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+
+	core "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestMigratorJobVolumes(t *testing.T) {
+	pod := &core.Pod{}
+	pod.Namespace = "demo"
+	pod.Name = "mc-0"
+
+	tests := []struct {
+		name   string
+		create func(*fake.Clientset, metav1.ObjectMeta) error
+		source string
+	}{
+		{
+			name: "database PVC",
+			create: func(kc *fake.Clientset, meta metav1.ObjectMeta) error {
+				return CreateDataMigratorJob(kc, meta, pod, "data")
+			},
+			source: "data-mc-0",
+		},
+		{
+			name: "generic ephemeral volume",
+			create: func(kc *fake.Clientset, meta metav1.ObjectMeta) error {
+				return CreateEphemeralVolumeMigratorJob(kc, meta, pod, kubedb.MemcachedDataVolumeName)
+			},
+			source: "mc-0-data",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc := fake.NewSimpleClientset()
+			meta := metav1.ObjectMeta{Namespace: pod.Namespace, Name: GetStorageMigratorJobName(pod.Name)}
+			if err := tt.create(kc, meta); err != nil {
+				t.Fatal(err)
+			}
+			job, err := kc.BatchV1().Jobs(meta.Namespace).Get(context.TODO(), meta.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			claims := map[string]string{}
+			for _, v := range job.Spec.Template.Spec.Volumes {
+				claims[v.Name] = v.PersistentVolumeClaim.ClaimName
+			}
+			if claims[migratorJobSourceVolumeName] != tt.source {
+				t.Errorf("source PVC = %s, want %s", claims[migratorJobSourceVolumeName], tt.source)
+			}
+			if claims[migratorJobDestinationVolumeName] != GetMigratorPVCName(pod.Name) {
+				t.Errorf("destination PVC = %s, want %s", claims[migratorJobDestinationVolumeName], GetMigratorPVCName(pod.Name))
+			}
+		})
+	}
+}
@@ -0,0 +1,685 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	kutil "kmodules.xyz/client-go"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	cu "kmodules.xyz/client-go/client"
+	cutil "kmodules.xyz/client-go/conditions"
+	meta_util "kmodules.xyz/client-go/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type MergeFunc func(pendingOps []opsapi.Accessor) (any, error)
+
+type ConvertFunc func(u *unstructured.Unstructured) (opsapi.Accessor, error)
+
+// CompatibleFunc reports whether a pending ops request can be merged with the oldest pending one.
+type CompatibleFunc func(oldest, req opsapi.Accessor) bool
+
+// MergeSpec describes how the pending ops requests of one type are merged into one ops request.
+type MergeSpec struct {
+	// Type is the ops request type, e.g. Reconfigure.
+	Type string
+	// Field is the spec field of the merged ops request that gets the merged value, e.g. configuration.
+	Field string
+	// NameSuffix names the merged ops request <db>-<NameSuffix>-<timestamp>.
+	NameSuffix string
+	// Reason is the reason of the MergedFromOps condition and of the Skipped condition of the originals.
+	Reason string
+	// Subject starts the message of the Skipped condition of the originals, e.g. Configuration.
+	Subject string
+	// Merge merges the pending ops requests, oldest first, into the value of Field.
+	Merge MergeFunc
+	// Compatible filters the pending ops requests that are merged with the oldest one. The others
+	// stay Pending and run after the merged ops request. Every pending ops request is merged when nil.
+	Compatible CompatibleFunc
+}
+
+func (s MergeSpec) mergedOpsSubStr() string {
+	return "-" + s.NameSuffix + "-"
+}
+
+// IsMergedOps returns true if the ops request was created by merging others.
+func (s MergeSpec) IsMergedOps(name string) bool {
+	return strings.Contains(name, s.mergedOpsSubStr())
+}
+
+// OpsMerger merges the pending ops requests of one type for a database into one merged ops request,
+// so that they are applied in a single restart cycle. The originals are marked Skipped and listed in
+// the MergedFromOps condition of the merged ops request. A caller that merges a type other than
+// Reconfigure passes its MergeSpec to NewSkipper too, so that the skipper leaves the type to the merger.
+type OpsMerger struct {
+	kbClient   client.Client
+	kind       string
+	spec       MergeSpec
+	opsReqList []client.Object
+	currentOps opsapi.Accessor
+	convertFn  ConvertFunc
+	log        interface {
+		Info(msg string, keysAndValues ...any)
+	}
+}
+
+func NewOpsMerger(kbClient client.Client, kind string, curOps client.Object, spec MergeSpec, convertFn ConvertFunc, log interface {
+	Info(msg string, keysAndValues ...any)
+},
+) (*OpsMerger, error) {
+	ret := &OpsMerger{
+		kbClient:   kbClient,
+		kind:       kind,
+		spec:       spec,
+		currentOps: curOps.(opsapi.Accessor),
+		convertFn:  convertFn,
+		log:        log,
+	}
+	err := ret.populateList()
+	return ret, err
+}
+
+const (
+	OriginalOpsSkipped = "OriginalOpsSkipped"
+	MergedFromOps      = "MergedFromOps"
+
+	ConfigurationMerged   = "ConfigurationMerged"
+	VerticalScalingMerged = "VerticalScalingMerged"
+	VolumeExpansionMerged = "VolumeExpansionMerged"
+)
+
+const (
+	ContinueGeneral  = iota // 0
+	MergeNeeded             // 1
+	RequeueNeeded           // 2
+	RequeueNotNeeded        // 3
+)
+
+// VerticalScalingMergeSpec merges pending VerticalScaling requests with the same mode. Resources of
+// different containers or node roles are combined; the newer request wins for the same one.
+func VerticalScalingMergeSpec() MergeSpec {
+	return MergeSpec{
+		Type:       "VerticalScaling",
+		Field:      "verticalScaling",
+		NameSuffix: "vscale-merged",
+		Reason:     VerticalScalingMerged,
+		Subject:    "Vertical scaling",
+		Merge:      MergeSpecField("verticalScaling"),
+		Compatible: allCompatible(SameSpecFieldMode("verticalScaling"), SameCommonSpec),
+	}
+}
+
+// VolumeExpansionMergeSpec merges pending VolumeExpansion requests with the same mode. Volumes of
+// different topology nodes are combined; the newer request wins for the same one.
+func VolumeExpansionMergeSpec() MergeSpec {
+	return MergeSpec{
+		Type:       "VolumeExpansion",
+		Field:      "volumeExpansion",
+		NameSuffix: "volexp-merged",
+		Reason:     VolumeExpansionMerged,
+		Subject:    "Volume expansion",
+		Merge:      MergeSpecField("volumeExpansion"),
+		Compatible: allCompatible(SameSpecFieldMode("volumeExpansion"), SameCommonSpec),
+	}
+}
+
+// MergeSpecField returns a MergeFunc that deep merges spec.<field> of the pending ops requests, oldest
+// first. Objects are merged key by key and lists of named objects name by name; anything else is
+// replaced by the newer value.
+func MergeSpecField(field string) MergeFunc {
+	return func(pendingOps []opsapi.Accessor) (any, error) {
+		merged := map[string]any{}
+		for _, req := range pendingOps {
+			val, err := specField(req, field)
+			if err != nil {
+				return nil, err
+			}
+			merged = mergeValues(merged, val).(map[string]any)
+		}
+		return merged, nil
+	}
+}
+
+// SameSpecFieldMode returns a CompatibleFunc that accepts the ops requests with the same spec.<field>.mode,
+// e.g. only Online volume expansions are merged together.
+func SameSpecFieldMode(field string) CompatibleFunc {
+	return func(oldest, req opsapi.Accessor) bool {
+		a, err := specField(oldest, field)
+		if err != nil {
+			return false
+		}
+		b, err := specField(req, field)
+		if err != nil {
+			return false
+		}
+		return a["mode"] == b["mode"]
+	}
+}
+
+// commonSpecFields are the spec fields shared by the ops request types, which the merged ops request takes
+// from the oldest pending one.
+var commonSpecFields = []string{"timeout", "apply", "rollout"}
+
+// SameCommonSpec is a CompatibleFunc that accepts the ops requests with the same spec.timeout, spec.apply
+// and spec.rollout, so that merging never changes how an ops request is rolled out.
+func SameCommonSpec(oldest, req opsapi.Accessor) bool {
+	a, err := commonSpec(oldest)
+	if err != nil {
+		return false
+	}
+	b, err := commonSpec(req)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func allCompatible(fns ...CompatibleFunc) CompatibleFunc {
+	return func(oldest, req opsapi.Accessor) bool {
+		for _, fn := range fns {
+			if !fn(oldest, req) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// commonSpec returns the commonSpecFields set in the spec of the ops request.
+func commonSpec(req opsapi.Accessor) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(req)
+	if err != nil {
+		return nil, err
+	}
+	spec, _, err := unstructured.NestedMap(u, "spec")
+	if err != nil {
+		return nil, err
+	}
+	out := map[string]any{}
+	for _, f := range commonSpecFields {
+		if v, ok := spec[f]; ok {
+			out[f] = v
+		}
+	}
+	return out, nil
+}
+
+func specField(req opsapi.Accessor, field string) (map[string]any, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(req)
+	if err != nil {
+		return nil, err
+	}
+	val, _, err := unstructured.NestedMap(u, "spec", field)
+	if err != nil {
+		return nil, err
+	}
+	if val == nil {
+		val = map[string]any{}
+	}
+	return val, nil
+}
+
+func mergeValues(old, cur any) any {
+	switch c := cur.(type) {
+	case map[string]any:
+		o, ok := old.(map[string]any)
+		if !ok {
+			return c
+		}
+		out := make(map[string]any, len(o)+len(c))
+		for k, v := range o {
+			out[k] = v
+		}
+		for k, v := range c {
+			out[k] = mergeValues(o[k], v)
+		}
+		return out
+	case []any:
+		o, ok := old.([]any)
+		if !ok || !namedItems(o) || !namedItems(c) {
+			return c
+		}
+		out := append([]any{}, o...)
+		for _, item := range c {
+			name := item.(map[string]any)["name"]
+			i := 0
+			for ; i < len(out); i++ {
+				if reflect.DeepEqual(out[i].(map[string]any)["name"], name) {
+					break
+				}
+			}
+			if i < len(out) {
+				out[i] = mergeValues(out[i], item)
+			} else {
+				out = append(out, item)
+			}
+		}
+		return out
+	}
+	return cur
+}
+
+// namedItems returns true if every item is an object with a name, like the read replicas of Postgres.
+func namedItems(items []any) bool {
+	for _, item := range items {
+		m, ok := item.(map[string]any)
+		if !ok {
+			return false
+		}
+		if _, ok := m["name"]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *OpsMerger) populateList() error {
+	unsList := &unstructured.UnstructuredList{}
+	unsList.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "ops.kubedb.com",
+		Version: "v1alpha1",
+		Kind:    m.kind + "List",
+	})
+
+	err := m.kbClient.List(
+		context.TODO(), unsList,
+		client.InNamespace(m.currentOps.GetNamespace()),
+	)
+	if err != nil {
+		return err
+	}
+
+	var (
+		lst      []client.Object
+		accessor opsapi.Accessor
+	)
+	for _, request := range unsList.Items {
+		accessor, err = m.convertFn(&request)
+		if err != nil {
+			return err
+		}
+		lst = append(lst, accessor)
+	}
+	m.opsReqList = lst
+	return nil
+}
+
+func (m *OpsMerger) Run() (int, error) {
+	inProgress := m.CheckIfAnyOpsRequestIsProgressing()
+	if inProgress {
+		return RequeueNeeded, nil
+	}
+	skip, pendingOps := m.FindPendingOpsToMerge()
+	if skip != MergeNeeded {
+		return skip, nil
+	}
+
+	merged, err := m.spec.Merge(pendingOps)
+	if err != nil {
+		return RequeueNeeded, fmt.Errorf("failed to merge %s ops requests: %w", m.spec.Type, err)
+	}
+
+	u, err := m.GetMergedOpsRequest(pendingOps[0], merged)
+	if err != nil {
+		return RequeueNeeded, err
+	}
+
+	mergedOps, err := m.convertFn(u)
+	if err != nil {
+		return RequeueNeeded, err
+	}
+
+	err = m.EnsureMergedOpsRequest(mergedOps, pendingOps)
+	if err != nil {
+		return RequeueNeeded, err
+	}
+	return RequeueNotNeeded, nil
+}
+
+func (m *OpsMerger) CheckIfAnyOpsRequestIsProgressing() bool {
+	if m.currentOps.GetRequestType() != m.spec.Type {
+		return false
+	}
+	for _, o := range m.opsReqList {
+		req := o.(opsapi.Accessor)
+
+		// Only consider ops for the same database
+		if req.GetDBRefName() != m.currentOps.GetDBRefName() {
+			continue
+		}
+
+		// If any ops request is Progressing (other than current), requeue current request
+		if req.GetStatus().Phase == opsapi.OpsRequestPhaseProgressing && m.currentOps.GetName() != req.GetName() {
+			m.log.Info(fmt.Sprintf("A ops request %s/%s is already progressing for database %s",
+				req.GetObjectMeta().Namespace, req.GetObjectMeta().Name, req.GetDBRefName()))
+			return true
+		}
+	}
+	return false
+}
+
+/*
+FindPendingOpsToMerge
+- Check if OriginalOpsSkipped condition is present.
+- If any ops request of the type is Progressing (other than current), requeue current request
+- Collect the pending ops requests compatible with the oldest one
+- Avoid parallel processing by checking if current ops is already part of an existing merge
+- markAsSkipped is the current ops is already merged into another ops.
+*/
+func (m *OpsMerger) FindPendingOpsToMerge() (int, []opsapi.Accessor) {
+	// Only process ops requests of the merged type
+	if m.currentOps.GetRequestType() != m.spec.Type {
+		return ContinueGeneral, nil
+	}
+
+	// If it is already Progressing, let it continue
+	if m.currentOps.GetStatus().Phase == opsapi.OpsRequestPhaseProgressing {
+		return ContinueGeneral, nil
+	}
+
+	// If current ops is a merged ops request, check if original ops have been skipped
+	// Merged ops requests have names like: <dbname>-<NameSuffix>-<timestamp>
+	meta := m.currentOps.GetObjectMeta()
+	if m.spec.IsMergedOps(meta.GetName()) {
+		// Check if the "OriginalOpsSkipped" condition is true
+		if !m.areOriginalOpsSkipped(m.currentOps.GetStatus()) {
+			m.log.Info(fmt.Sprintf("Merged ops request %s/%s waiting for original ops to be skipped, requeuing",
+				meta.GetNamespace(), meta.GetName()))
+			return RequeueNeeded, nil // Requeue until original ops are skipped
+		}
+		// Original ops are skipped, proceed with reconciliation
+		m.log.Info(fmt.Sprintf("Merged ops request %s/%s ready to proceed, original ops have been skipped",
+			meta.GetNamespace(), meta.GetName()))
+		return ContinueGeneral, nil
+	}
+
+	// Collect all pending ops requests of the type for the same database
+	var pendingOps []opsapi.Accessor
+	for _, o := range m.opsReqList {
+		req := o.(opsapi.Accessor)
+
+		// Only consider ops for the same database
+		if req.GetDBRefName() != m.currentOps.GetDBRefName() {
+			continue
+		}
+
+		if req.GetRequestType() != m.spec.Type {
+			continue
+		}
+
+		// If any ops request of the type is Progressing (other than current), requeue current request
+		// TODO: we got this list a few moments ago. may be we don't have the updated status...
+		if req.GetStatus().Phase == opsapi.OpsRequestPhaseProgressing {
+			m.log.Info(fmt.Sprintf("%s ops request %s/%s is already progressing for database %s, requeuing current request %s/%s",
+				m.spec.Type, req.GetObjectMeta().Namespace, req.GetObjectMeta().Name, req.GetDBRefName(), meta.GetNamespace(), meta.GetName()))
+			return RequeueNeeded, nil
+		}
+
+		// Collect only Pending or empty ("") phase ops requests
+		// Ignore Successful/Failed/Skipped
+		if req.GetStatus().Phase == opsapi.OpsRequestPhasePending || req.GetStatus().Phase == "" {
+			pendingOps = append(pendingOps, req)
+		}
+	}
+
+	// If only one or none, no need to merge
+	if len(pendingOps) <= 1 {
+		return ContinueGeneral, nil
+	}
+
+	// Check if current ops is already part of an existing merge
+	// This prevents parallel processing from creating duplicate merges
+	if alreadyMerged, mergedOpsName := m.isAlreadyMerged(m.currentOps, pendingOps); alreadyMerged {
+		m.log.Info(fmt.Sprintf("Ops request %s/%s is already merged into %s, skipping",
+			meta.GetNamespace(), meta.GetName(), mergedOpsName))
+
+		// Mark current ops as Skipped since it's already merged
+		if err := m.markAsSkippedForMergedOps(m.currentOps, metav1.ObjectMeta{
+			Name:      mergedOpsName,
+			Namespace: meta.GetNamespace(),
+		}); err != nil {
+			klog.Errorf("failed to mark already merged ops request %s/%s as skipped: %v", meta.GetNamespace(), meta.GetName(), err)
+		}
+		return RequeueNotNeeded, nil // Skip this ops as it's already part of a merge
+	}
+
+	// Sort by creation timestamp (oldest first), then by name (lexicographically smaller first)
+	sort.Slice(pendingOps, func(i, j int) bool {
+		x := pendingOps[i].GetObjectMeta().CreationTimestamp
+		y := pendingOps[j].GetObjectMeta()
+
+		if m.spec.IsMergedOps(pendingOps[i].GetName()) == m.spec.IsMergedOps(pendingOps[j].GetName()) {
+			if !x.Equal(&y.CreationTimestamp) {
+				return x.Before(&y.CreationTimestamp)
+			}
+			return strings.Compare(pendingOps[i].GetObjectMeta().Name, pendingOps[j].GetObjectMeta().Name) < 0
+		}
+		return m.spec.IsMergedOps(pendingOps[i].GetName())
+	})
+
+	// Only the ops requests compatible with the oldest one are merged; the others wait for their turn
+	if m.spec.Compatible != nil {
+		compatible := pendingOps[:1]
+		for _, req := range pendingOps[1:] {
+			if m.spec.Compatible(pendingOps[0], req) {
+				compatible = append(compatible, req)
+			}
+		}
+		pendingOps = compatible
+		if len(pendingOps) <= 1 {
+			return ContinueGeneral, nil
+		}
+	}
+
+	m.log.Info(fmt.Sprintf("Found %d pending %s ops requests to merge", len(pendingOps), m.spec.Type))
+	return MergeNeeded, pendingOps
+}
+
+func (m *OpsMerger) areOriginalOpsSkipped(opsStatus opsapi.OpsRequestStatus) bool {
+	return cutil.IsConditionTrue(opsStatus.Conditions, OriginalOpsSkipped)
+}
+
+func (m *OpsMerger) isAlreadyMerged(currentReq opsapi.Accessor, pendingOps []opsapi.Accessor) (bool, string) {
+	for _, req := range pendingOps {
+		// Only check ops requests that is merged ops
+		if !m.spec.IsMergedOps(req.GetObjectMeta().Name) {
+			continue
+		}
+
+		// Check if current ops name is in the "MergedFromOps" condition message
+		for _, cond := range req.GetStatus().Conditions {
+			if cond.Type == MergedFromOps && cond.Status == metav1.ConditionTrue {
+				// The message contains comma-separated list of original ops names
+				opsNames := strings.SplitSeq(cond.Message, ", ")
+				for name := range opsNames {
+					if strings.TrimSpace(name) == currentReq.GetObjectMeta().Name {
+						return true, req.GetObjectMeta().Name
+					}
+				}
+			}
+		}
+	}
+	return false, ""
+}
+
+func (m *OpsMerger) markAsSkippedForMergedOps(ops opsapi.Accessor, mergedOpsMeta metav1.ObjectMeta) error {
+	msg := fmt.Sprintf("%s merged into newly created ops request %s/%s", m.spec.Subject, mergedOpsMeta.Namespace, mergedOpsMeta.Name)
+
+	_, err := cu.PatchStatus(context.TODO(), m.kbClient, ops, func(obj client.Object) client.Object {
+		ret := obj.(opsapi.Accessor)
+		sts := ret.GetStatus()
+		sts.Phase = opsapi.OpsRequestPhaseSkipped
+		sts.ObservedGeneration = ret.GetObjectMeta().Generation
+		sts.Conditions = cutil.SetCondition(sts.Conditions, kmapi.Condition{
+			Type:               kmapi.ConditionType(opsapi.OpsRequestPhaseSkipped),
+			Reason:             m.spec.Reason,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ret.GetObjectMeta().Generation,
+			LastTransitionTime: metav1.Now(),
+			Message:            msg,
+		})
+		ret.SetStatus(sts)
+		return ret
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark ops request %s/%s as skipped: %w", ops.GetObjectMeta().Namespace, ops.GetObjectMeta().Name, err)
+	}
+
+	m.log.Info(fmt.Sprintf("%s/%s skipped: %s", ops.GetObjectMeta().Namespace, ops.GetObjectMeta().Name, msg))
+	return nil
+}
+
+// GetMergedOpsRequest builds the merged ops request from the oldest pending one and the merged value of
+// spec.<Field>. The commonSpecFields are copied from the oldest pending one.
+func (m *OpsMerger) GetMergedOpsRequest(firstPendingOps opsapi.Accessor, merged any) (*unstructured.Unstructured, error) {
+	// values merged by MergeSpecField are unstructured already
+	val, ok := merged.(map[string]any)
+	if !ok {
+		var err error
+		val, err = runtime.DefaultUnstructuredConverter.ToUnstructured(merged)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	common, err := commonSpec(firstPendingOps)
+	if err != nil {
+		return nil, err
+	}
+
+	spec := map[string]any{
+		"type": m.spec.Type,
+		"databaseRef": map[string]any{
+			"name": firstPendingOps.GetDBRefName(),
+		},
+		m.spec.Field: val,
+	}
+	for f, v := range common {
+		spec[f] = v
+	}
+
+	mergedOpsName := meta_util.NameWithSuffix(firstPendingOps.GetDBRefName(), fmt.Sprintf("%s-%d", m.spec.NameSuffix, time.Now().UnixMilli()))
+	obj := map[string]any{
+		"apiVersion": "ops.kubedb.com/v1alpha1",
+		"kind":       m.kind,
+		"metadata": map[string]any{
+			"name":      mergedOpsName,
+			"namespace": firstPendingOps.GetObjectMeta().Namespace,
+			"labels":    firstPendingOps.GetObjectMeta().Labels,
+		},
+		"spec": spec,
+	}
+	return &unstructured.Unstructured{Object: obj}, nil
+}
+
+func (m *OpsMerger) EnsureMergedOpsRequest(mergedOpsRequest opsapi.Accessor, pendingOps []opsapi.Accessor) error {
+	verb, err := cu.CreateOrPatch(context.TODO(), m.kbClient, mergedOpsRequest, func(obj client.Object, _ bool) client.Object {
+		return mergedOpsRequest
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create merged ops request %s/%s: %w", mergedOpsRequest.GetObjectMeta().Namespace, mergedOpsRequest.GetObjectMeta().Name, err)
+	}
+
+	// Prepare the list of original ops names for the "MergedFromOps" condition
+	var opsNames []string
+	for _, ops := range pendingOps {
+		opsNames = append(opsNames, ops.GetObjectMeta().Name)
+	}
+
+	mergedFromOpsMessage := strings.Join(opsNames, ", ")
+	if verb == kutil.VerbCreated {
+		m.log.Info(fmt.Sprintf("Created merged %s ops request %s/%s from %d pending requests",
+			m.spec.Type, mergedOpsRequest.GetObjectMeta().Namespace, mergedOpsRequest.GetObjectMeta().Name, len(opsNames)))
+	}
+
+	if err := m.updateConditionForMergedOps(mergedOpsRequest, mergedFromOpsMessage); err != nil {
+		return fmt.Errorf("failed to record merged-from condition for %s/%s: %w", mergedOpsRequest.GetObjectMeta().Namespace, mergedOpsRequest.GetObjectMeta().Name, err)
+	}
+
+	for _, op := range pendingOps {
+		err = m.markAsSkippedForMergedOps(op, mergedOpsRequest.GetObjectMeta())
+		if err != nil {
+			return err
+		}
+	}
+
+	// Mark the merged ops request with "OriginalOpsSkipped" condition after skipping is done
+	// This allows the merged ops to proceed with reconciliation
+	if err := m.markOriginalOpsAsSkipped(mergedOpsRequest); err != nil {
+		return fmt.Errorf("failed to mark original ops as skipped for merged ops %s/%s: %w", mergedOpsRequest.GetObjectMeta().Namespace, mergedOpsRequest.GetObjectMeta().Name, err)
+	}
+
+	m.log.Info(fmt.Sprintf("Marked merged ops request %s/%s as ready to proceed (original ops skipped)", mergedOpsRequest.GetObjectMeta().Namespace, mergedOpsRequest.GetObjectMeta().Name))
+	return nil
+}
+
+func (m *OpsMerger) updateConditionForMergedOps(mergedOps opsapi.Accessor, mergedFromOpsMessage string) error {
+	_, err := cu.PatchStatus(context.TODO(), m.kbClient, mergedOps, func(obj client.Object) client.Object {
+		ret := obj.(opsapi.Accessor)
+		sts := ret.GetStatus()
+		sts.ObservedGeneration = ret.GetGeneration()
+		sts.Conditions = cutil.SetCondition(sts.Conditions, kmapi.Condition{
+			Type:               MergedFromOps,
+			Reason:             m.spec.Reason,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ret.GetGeneration(),
+			LastTransitionTime: metav1.Now(),
+			Message:            mergedFromOpsMessage,
+		})
+		ret.SetStatus(sts)
+		return ret
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set MergedFromOps condition for %s/%s: %w", mergedOps.GetObjectMeta().Namespace, mergedOps.GetObjectMeta().Name, err)
+	}
+	return nil
+}
+
+func (m *OpsMerger) markOriginalOpsAsSkipped(mergedOps opsapi.Accessor) error {
+	_, err := cu.PatchStatus(context.TODO(), m.kbClient, mergedOps, func(obj client.Object) client.Object {
+		ret := obj.(opsapi.Accessor)
+		sts := ret.GetStatus()
+		sts.ObservedGeneration = ret.GetGeneration()
+		sts.Conditions = cutil.SetCondition(sts.Conditions, kmapi.Condition{
+			Type:               OriginalOpsSkipped,
+			Reason:             OriginalOpsSkipped,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: ret.GetGeneration(),
+			LastTransitionTime: metav1.Now(),
+			Message:            "All original ops requests have been marked as Skipped",
+		})
+		ret.SetStatus(sts)
+		return ret
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark original ops as skipped for merged ops %s/%s: %w", mergedOps.GetObjectMeta().Namespace, mergedOps.GetObjectMeta().Name, err)
+	}
+	return nil
+}
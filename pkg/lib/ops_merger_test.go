@@ -0,0 +1,134 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lib
+
+import (
+	"reflect"
+	"testing"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+)
+
+func makeVerticalScaling(spec opsapi.PostgresVerticalScalingSpec) opsapi.Accessor {
+	req := &opsapi.PostgresOpsRequest{}
+	req.Spec.Type = opsapi.PostgresOpsRequestTypeVerticalScaling
+	req.Spec.VerticalScaling = &spec
+	return req
+}
+
+func cpu(q string) *opsapi.PodResources {
+	return &opsapi.PodResources{Resources: core.ResourceRequirements{
+		Requests: core.ResourceList{core.ResourceCPU: resource.MustParse(q)},
+	}}
+}
+
+func TestMergeSpecField(t *testing.T) {
+	pending := []opsapi.Accessor{
+		makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{
+			Postgres:     cpu("500m"),
+			ReadReplicas: []opsapi.ReadReplicaResources{{Name: "r1", Postgres: cpu("250m")}},
+		}),
+		makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{
+			Arbiter:      cpu("100m"),
+			ReadReplicas: []opsapi.ReadReplicaResources{{Name: "r2", Postgres: cpu("250m")}},
+		}),
+		makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{
+			Postgres: cpu("1"),
+		}),
+	}
+	merged, err := MergeSpecField("verticalScaling")(pending)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"postgres": map[string]any{"resources": map[string]any{"requests": map[string]any{"cpu": "1"}}},
+		"arbiter":  map[string]any{"resources": map[string]any{"requests": map[string]any{"cpu": "100m"}}},
+		"readReplicas": []any{
+			map[string]any{"name": "r1", "postgres": map[string]any{"resources": map[string]any{"requests": map[string]any{"cpu": "250m"}}}},
+			map[string]any{"name": "r2", "postgres": map[string]any{"resources": map[string]any{"requests": map[string]any{"cpu": "250m"}}}},
+		},
+	}
+	if !reflect.DeepEqual(merged, want) {
+		t.Errorf("MergeSpecField() = %v, want %v", merged, want)
+	}
+}
+
+func TestSameSpecFieldMode(t *testing.T) {
+	online := &opsapi.PostgresOpsRequest{}
+	online.Spec.VolumeExpansion = &opsapi.PostgresVolumeExpansionSpec{Mode: opsapi.VolumeExpansionModeOnline, Postgres: ptr.To(resource.MustParse("2Gi"))}
+	offline := &opsapi.PostgresOpsRequest{}
+	offline.Spec.VolumeExpansion = &opsapi.PostgresVolumeExpansionSpec{Mode: opsapi.VolumeExpansionModeOffline, Arbiter: ptr.To(resource.MustParse("1Gi"))}
+
+	compatible := SameSpecFieldMode("volumeExpansion")
+	if !compatible(online, online.DeepCopy()) {
+		t.Errorf("expected the same modes to be compatible")
+	}
+	if compatible(online, offline) {
+		t.Errorf("expected Online and Offline to be incompatible")
+	}
+}
+
+func TestSameCommonSpec(t *testing.T) {
+	canary := makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{Postgres: cpu("1")})
+	canary.(*opsapi.PostgresOpsRequest).Spec.Rollout = &opsapi.RolloutStrategy{Canary: &opsapi.CanaryRolloutStrategy{}}
+	plain := makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{Postgres: cpu("500m")})
+
+	if !SameCommonSpec(plain, makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{Arbiter: cpu("100m")})) {
+		t.Errorf("expected requests without timeout, apply and rollout to be compatible")
+	}
+	if SameCommonSpec(plain, canary) {
+		t.Errorf("expected a canary and a plain rollout to be incompatible")
+	}
+	if VerticalScalingMergeSpec().Compatible(plain, canary) {
+		t.Errorf("expected VerticalScalingMergeSpec to keep a canary rollout out of a plain merge")
+	}
+}
+
+func TestGetMergedOpsRequestCopiesCommonSpec(t *testing.T) {
+	oldest := makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{Postgres: cpu("500m")})
+	oldest.(*opsapi.PostgresOpsRequest).Spec.DatabaseRef.Name = "pg"
+	oldest.(*opsapi.PostgresOpsRequest).Spec.Rollout = &opsapi.RolloutStrategy{Canary: &opsapi.CanaryRolloutStrategy{}}
+	oldest.(*opsapi.PostgresOpsRequest).Spec.Apply = opsapi.ApplyOptionAlways
+
+	m := &OpsMerger{kind: opsapi.ResourceKindPostgresOpsRequest, spec: VerticalScalingMergeSpec()}
+	u, err := m.GetMergedOpsRequest(oldest, map[string]any{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := u.Object["spec"].(map[string]any)["rollout"].(map[string]any)["canary"]; !ok {
+		t.Errorf("expected spec.rollout.canary to be copied, got spec %v", u.Object["spec"])
+	}
+	if got := u.Object["spec"].(map[string]any)["apply"]; got != string(opsapi.ApplyOptionAlways) {
+		t.Errorf("spec.apply = %v, want %s", got, opsapi.ApplyOptionAlways)
+	}
+}
+
+func TestSkipperExcludesRegisteredMergeSpecs(t *testing.T) {
+	req := makeVerticalScaling(opsapi.PostgresVerticalScalingSpec{})
+	plain := NewSkipper(nil, opsapi.ResourceKindPostgresOpsRequest, req, nil)
+	if plain.isExcluded("VerticalScaling") || !plain.isExcluded("Reconfigure") {
+		t.Errorf("expected only Reconfigure to be excluded by default")
+	}
+	merging := NewSkipper(nil, opsapi.ResourceKindPostgresOpsRequest, req, nil, VerticalScalingMergeSpec())
+	if !merging.isExcluded("VerticalScaling") || merging.isExcluded("VolumeExpansion") {
+		t.Errorf("expected only the registered VerticalScaling to be excluded besides Reconfigure")
+	}
+}
@@ -38,15 +38,22 @@ type skipper struct {
 	kind       string
 	opsReqList []client.Object
 	currentOps client.Object
+	merged     []string
 }
 
-func NewSkipper(kbClient client.Client, kind string, curOps client.Object, opsReqList []client.Object) skipper {
-	return skipper{
+// NewSkipper returns a skipper for the current ops request. The types of the given merge specs are left to
+// the OpsMergers the caller runs for them, like the types in ExcludedFromSkipperLogic.
+func NewSkipper(kbClient client.Client, kind string, curOps client.Object, opsReqList []client.Object, mergeSpecs ...MergeSpec) skipper {
+	s := skipper{
 		kbClient:   kbClient,
 		kind:       kind,
 		opsReqList: opsReqList,
 		currentOps: curOps,
 	}
+	for _, spec := range mergeSpecs {
+		s.merged = append(s.merged, spec.Type)
+	}
+	return s
 }
 
 /*
@@ -57,7 +64,9 @@ The idea behind skipping opsRequests :
 2) If there are multiple opsReqs of different `Type` (like 3 'VerticalScaling', 2 `UpdateVersion`) in Pending state,
    After skipping in the previous step, there will be exactly one opsReq of each type in Pending
    And now, as they are different types, We want to reconcile the oldest one first.
-3) Reconfigure ops requests are excluded from this skipper logic and are handled by ReconfigureMerger instead.
+3) Reconfigure ops requests, and the types of the merge specs given to NewSkipper (e.g. VerticalScaling and
+   VolumeExpansion), are excluded from this skipper logic. They are merged by an OpsMerger instead, so that the
+   intent of every pending request is kept.
 */
 
 func (s *skipper) SkipOpsReq() (bool, error) {
@@ -66,9 +75,9 @@ func (s *skipper) SkipOpsReq() (bool, error) {
 		return false, nil
 	}
 
-	// Skip the skipper logic for Reconfigure ops requests - they are handled by ReconfigureMerger
+	// Skip the skipper logic for the ops requests handled by an OpsMerger
 	currentOpsType := fmt.Sprintf("%v", s.currentOps.(opsapi.Accessor).GetRequestType())
-	if isInExcludeList(currentOpsType) {
+	if s.isExcluded(currentOpsType) {
 		return false, nil
 	}
 
@@ -89,7 +98,7 @@ func (s *skipper) SkipOpsReq() (bool, error) {
 		// r.Status.Phase can be "", if it has not been reconciled yet.
 		if r.GetStatus().Phase == opsapi.OpsRequestPhasePending || r.GetStatus().Phase == "" {
 			opsType := fmt.Sprintf("%v", r.GetRequestType()) // r.GetRequestType().(string) causes panic
-			if isInExcludeList(opsType) {
+			if s.isExcluded(opsType) {
 				continue
 			}
 			// populate the map
@@ -111,6 +120,11 @@ func isInExcludeList(s string) bool {
 	return slices.Contains(ExcludedFromSkipperLogic, s)
 }
 
+// isExcluded returns true if the ops request type is merged by an OpsMerger instead of skipped.
+func (s *skipper) isExcluded(opsType string) bool {
+	return isInExcludeList(opsType) || slices.Contains(s.merged, opsType)
+}
+
 func (s *skipper) getOldestOpsAfterSkipping(opsMap map[string][]client.Object) (metav1.ObjectMeta, error) {
 	oldestOps := metav1.ObjectMeta{
 		CreationTimestamp: metav1.Now(), // set dummy time for later comparison
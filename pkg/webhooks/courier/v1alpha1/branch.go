@@ -0,0 +1,356 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	courierapi "kubedb.dev/apimachinery/apis/courier/v1alpha1"
+	"kubedb.dev/apimachinery/apis/kubedb"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	meta_util "kmodules.xyz/client-go/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupBranchWebhookWithManager registers the webhook for Branch in the manager.
+func SetupBranchWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&courierapi.Branch{}).
+		WithValidator(&BranchCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type BranchCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var branchLog = logf.Log.WithName("branch-resource")
+
+var _ webhook.CustomValidator = &BranchCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w BranchCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	b, ok := obj.(*courierapi.Branch)
+	if !ok {
+		return nil, fmt.Errorf("expected a Branch object but got %T", obj)
+	}
+	branchLog.Info("validate create", "name", b.Name)
+
+	// the API server sets creationTimestamp after admission; a TTL is counted from now
+	b = b.DeepCopy()
+	if b.CreationTimestamp.IsZero() {
+		b.CreationTimestamp = metav1.Now()
+	}
+
+	allErr := w.validateExpiry(b, true)
+	allErr = append(allErr, w.validateArchiver(b)...)
+	allErr = append(allErr, w.validateMasking(b)...)
+	allErr = append(allErr, w.validateSchemaDiff(b)...)
+	if len(allErr) == 0 {
+		allErr = append(allErr, w.validateQuotas(ctx, b)...)
+	}
+	if len(allErr) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: courierapi.SchemeGroupVersion.Group, Kind: courierapi.ResourceKindBranch}, b.Name, allErr)
+	}
+	return expiryWarnings(b), nil
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w BranchCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	b, ok := newObj.(*courierapi.Branch)
+	if !ok {
+		return nil, fmt.Errorf("expected a Branch object but got %T", newObj)
+	}
+	branchLog.Info("validate update", "name", b.Name)
+
+	old, ok := oldObj.(*courierapi.Branch)
+	if !ok {
+		return nil, fmt.Errorf("expected a Branch object but got %T", oldObj)
+	}
+	// an unchanged absolute expiry may be in the past; the operator deletes the branch
+	checkFuture := old.Spec.Expiry == nil || old.Spec.Expiry.ExpiresAt == nil ||
+		b.Spec.Expiry == nil || b.Spec.Expiry.ExpiresAt == nil ||
+		!old.Spec.Expiry.ExpiresAt.Equal(b.Spec.Expiry.ExpiresAt)
+
+	allErr := w.validateSourceUpdate(old, b)
+	allErr = append(allErr, w.validateExpiry(b, checkFuture)...)
+	allErr = append(allErr, w.validateArchiver(b)...)
+	allErr = append(allErr, w.validateMasking(b)...)
+	allErr = append(allErr, w.validateSchemaDiff(b)...)
+	if len(allErr) > 0 {
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: courierapi.SchemeGroupVersion.Group, Kind: courierapi.ResourceKindBranch}, b.Name, allErr)
+	}
+	return expiryWarnings(b), nil
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (w BranchCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateSourceUpdate rejects a change of the source: the branch storage was cloned from it, and the
+// quotas were checked against it at creation.
+func (w BranchCustomWebhook) validateSourceUpdate(old, b *courierapi.Branch) field.ErrorList {
+	if equality.Semantic.DeepEqual(old.Spec.Source, b.Spec.Source) {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(field.NewPath("spec").Child("source"), "source cannot be changed once the branch is created")}
+}
+
+func (w BranchCustomWebhook) validateExpiry(b *courierapi.Branch, checkFuture bool) field.ErrorList {
+	var allErr field.ErrorList
+	e := b.Spec.Expiry
+	if e == nil {
+		return nil
+	}
+	path := field.NewPath("spec").Child("expiry")
+	switch {
+	case e.TTL == nil && e.ExpiresAt == nil:
+		allErr = append(allErr, field.Required(path, "one of ttl or expiresAt must be set"))
+	case e.TTL != nil && e.ExpiresAt != nil:
+		allErr = append(allErr, field.Forbidden(path.Child("expiresAt"), "ttl and expiresAt are mutually exclusive"))
+	case e.TTL != nil && e.TTL.Duration <= 0:
+		allErr = append(allErr, field.Invalid(path.Child("ttl"), e.TTL.Duration.String(), "must be positive"))
+	case e.ExpiresAt != nil && checkFuture && !e.ExpiresAt.After(time.Now()):
+		allErr = append(allErr, field.Invalid(path.Child("expiresAt"), e.ExpiresAt.UTC().Format(time.RFC3339), "must be in the future"))
+	}
+	if e.WarningPeriod != nil && e.WarningPeriod.Duration < 0 {
+		allErr = append(allErr, field.Invalid(path.Child("warningPeriod"), e.WarningPeriod.Duration.String(), "must not be negative"))
+	}
+	return allErr
+}
+
+// validateArchiver checks a branch restored from archiver backups. Such a branch never snapshots the
+// source volumes, so a VolumeSnapshotClass makes no sense for it.
+func (w BranchCustomWebhook) validateArchiver(b *courierapi.Branch) field.ErrorList {
+	var allErr field.ErrorList
+	a := b.Spec.Source.Archiver
+	if a == nil {
+		return nil
+	}
+	path := field.NewPath("spec").Child("source").Child("archiver")
+	if b.Spec.VolumeSnapshotClassName != "" {
+		allErr = append(allErr, field.Forbidden(field.NewPath("spec").Child("volumeSnapshotClassName"),
+			"a branch restored from archiver backups does not snapshot the source volumes"))
+	}
+	if a.FullDBRepository == nil || a.FullDBRepository.Name == "" {
+		allErr = append(allErr, field.Required(path.Child("fullDBRepository"), "the repository of the full database backups must be set"))
+	}
+	if a.ManifestRepository == nil || a.ManifestRepository.Name == "" {
+		allErr = append(allErr, field.Required(path.Child("manifestRepository"), "the repository of the manifest backups must be set"))
+	}
+	if a.EncryptionSecret != nil && a.EncryptionSecret.Name == "" {
+		allErr = append(allErr, field.Required(path.Child("encryptionSecret").Child("name"), ""))
+	}
+	if t := a.RecoveryTimestamp; t != nil {
+		switch {
+		case t.IsZero():
+			allErr = append(allErr, field.Invalid(path.Child("recoveryTimestamp"), t.String(), "must be a RFC3339 timestamp"))
+		case t.After(time.Now()):
+			allErr = append(allErr, field.Invalid(path.Child("recoveryTimestamp"), t.UTC().Format(time.RFC3339), "must not be in the future"))
+		}
+	}
+	return allErr
+}
+
+func (w BranchCustomWebhook) validateMasking(b *courierapi.Branch) field.ErrorList {
+	var allErr field.ErrorList
+	m := b.Spec.Masking
+	if m == nil {
+		return nil
+	}
+	path := field.NewPath("spec").Child("masking")
+	if !b.MaskingSupported() {
+		allErr = append(allErr, field.NotSupported(path, b.Spec.Source.DatabaseRef.Kind, []string{"Postgres", "MySQL", "MariaDB", "MongoDB"}))
+	}
+	if m.SaltSecretRef != nil && (m.SaltSecretRef.Name == "" || m.SaltSecretRef.Key == "") {
+		allErr = append(allErr, field.Required(path.Child("saltSecretRef"), "name and key must be set"))
+	}
+	if len(m.Rules) == 0 {
+		allErr = append(allErr, field.Required(path.Child("rules"), "at least one rule must be set"))
+	}
+	for i, rule := range m.Rules {
+		rulePath := path.Child("rules").Index(i)
+		if rule.Table == "" {
+			allErr = append(allErr, field.Required(rulePath.Child("table"), ""))
+		}
+		if len(rule.Columns) == 0 && rule.RowFilter == "" {
+			allErr = append(allErr, field.Required(rulePath, "one of columns or rowFilter must be set"))
+		}
+		columns := map[string]bool{}
+		for j, col := range rule.Columns {
+			colPath := rulePath.Child("columns").Index(j)
+			if col.Column == "" {
+				allErr = append(allErr, field.Required(colPath.Child("column"), ""))
+			} else if columns[col.Column] {
+				allErr = append(allErr, field.Duplicate(colPath.Child("column"), col.Column))
+			}
+			columns[col.Column] = true
+
+			switch {
+			case col.Strategy == courierapi.BranchMaskStrategyFaker && col.FakerType == "":
+				allErr = append(allErr, field.Required(colPath.Child("fakerType"), "required for the Faker strategy"))
+			case col.Strategy != courierapi.BranchMaskStrategyFaker && col.FakerType != "":
+				allErr = append(allErr, field.Forbidden(colPath.Child("fakerType"), "only allowed for the Faker strategy"))
+			}
+			if col.Redact != nil {
+				if col.Strategy != courierapi.BranchMaskStrategyRedact {
+					allErr = append(allErr, field.Forbidden(colPath.Child("redact"), "only allowed for the Redact strategy"))
+				}
+				if col.Redact.KeepPrefix < 0 {
+					allErr = append(allErr, field.Invalid(colPath.Child("redact", "keepPrefix"), col.Redact.KeepPrefix, "must not be negative"))
+				}
+				if col.Redact.KeepSuffix < 0 {
+					allErr = append(allErr, field.Invalid(colPath.Child("redact", "keepSuffix"), col.Redact.KeepSuffix, "must not be negative"))
+				}
+			}
+		}
+	}
+	return allErr
+}
+
+func (w BranchCustomWebhook) validateSchemaDiff(b *courierapi.Branch) field.ErrorList {
+	var allErr field.ErrorList
+	if b.Spec.SchemaDiff == nil {
+		return nil
+	}
+	path := field.NewPath("spec").Child("schemaDiff")
+	if !b.SchemaDiffSupported() {
+		allErr = append(allErr, field.NotSupported(path, b.Spec.Source.DatabaseRef.Kind, []string{"Postgres", "MySQL", "MariaDB"}))
+	}
+	if b.Spec.SchemaDiff.RequestID == "" {
+		allErr = append(allErr, field.Required(path.Child("requestID"), "a schema diff is computed once per requestID"))
+	}
+	return allErr
+}
+
+// validateQuotas rejects b if it would take its namespace, or its source Database, over any BranchQuota.
+// The namespace quotas are in the Branch's namespace, the source quotas in the source's namespace.
+func (w BranchCustomWebhook) validateQuotas(ctx context.Context, b *courierapi.Branch) field.ErrorList {
+	var allErr field.ErrorList
+	path := field.NewPath("metadata").Child("namespace")
+
+	var quotas []courierapi.BranchQuota
+	for _, ns := range sets.List(sets.New(b.Namespace, b.SourceNamespace())) {
+		var list courierapi.BranchQuotaList
+		if err := w.DefaultClient.List(ctx, &list, client.InNamespace(ns)); err != nil {
+			return append(allErr, field.InternalError(path, err))
+		}
+		for _, q := range list.Items {
+			if q.Selects(b) {
+				quotas = append(quotas, q)
+			}
+		}
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	// the Branches of a source are counted in every namespace
+	var branches courierapi.BranchList
+	if err := w.DefaultClient.List(ctx, &branches); err != nil {
+		return append(allErr, field.InternalError(path, err))
+	}
+
+	var estimate *resource.Quantity
+	for _, q := range quotas {
+		var count int32
+		var storage resource.Quantity
+		for i := range branches.Items {
+			existing := &branches.Items[i]
+			if (existing.Namespace == b.Namespace && existing.Name == b.Name) || !q.Selects(existing) {
+				continue
+			}
+			count++
+			if existing.Status.ClonedStorage != nil {
+				storage.Add(*existing.Status.ClonedStorage)
+			}
+		}
+
+		if q.Spec.MaxBranches != nil && count+1 > *q.Spec.MaxBranches {
+			allErr = append(allErr, field.Forbidden(path,
+				fmt.Sprintf("BranchQuota %s/%s allows at most %d branches, %d in use", q.Namespace, q.Name, *q.Spec.MaxBranches, count)))
+		}
+
+		if q.Spec.MaxClonedStorage != nil {
+			if estimate == nil {
+				est, err := w.estimateClonedStorage(ctx, b)
+				if err != nil {
+					return append(allErr, field.InternalError(path, err))
+				}
+				estimate = &est
+			}
+			storage.Add(*estimate)
+			if storage.Cmp(*q.Spec.MaxClonedStorage) > 0 {
+				allErr = append(allErr, field.Forbidden(path,
+					fmt.Sprintf("BranchQuota %s/%s allows at most %s of cloned storage, branch needs about %s on top of the used storage",
+						q.Namespace, q.Name, q.Spec.MaxClonedStorage.String(), estimate.String())))
+			}
+		}
+	}
+	return allErr
+}
+
+// estimateClonedStorage sums the storage requests of the source Database PVCs a branch is cloned from.
+func (w BranchCustomWebhook) estimateClonedStorage(ctx context.Context, b *courierapi.Branch) (resource.Quantity, error) {
+	var total resource.Quantity
+	ref := b.Spec.Source.DatabaseRef
+	mapping, err := w.DefaultClient.RESTMapper().RESTMapping(schema.GroupKind{Group: kubedb.GroupName, Kind: ref.Kind})
+	if err != nil {
+		return total, err
+	}
+	var pvcs core.PersistentVolumeClaimList
+	err = w.DefaultClient.List(ctx, &pvcs, client.InNamespace(b.SourceNamespace()), client.MatchingLabels{
+		meta_util.NameLabelKey:      fmt.Sprintf("%s.%s", mapping.Resource.Resource, kubedb.GroupName),
+		meta_util.InstanceLabelKey:  ref.Name,
+		meta_util.ManagedByLabelKey: kubedb.GroupName,
+	})
+	if err != nil {
+		return total, err
+	}
+	for _, pvc := range pvcs.Items {
+		if q, ok := pvc.Spec.Resources.Requests[core.ResourceStorage]; ok {
+			total.Add(q)
+		}
+	}
+	return total, nil
+}
+
+func expiryWarnings(b *courierapi.Branch) admission.Warnings {
+	if !b.IsExpiringSoon(time.Now()) {
+		return nil
+	}
+	return admission.Warnings{
+		fmt.Sprintf("Branch %s/%s expires at %s and will be deleted with deletionPolicy %s",
+			b.Namespace, b.Name, b.ExpiryTime().UTC().Format(time.RFC3339), b.Spec.DeletionPolicy),
+	}
+}
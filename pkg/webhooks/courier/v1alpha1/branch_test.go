@@ -0,0 +1,539 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	courierapi "kubedb.dev/apimachinery/apis/courier/v1alpha1"
+	"kubedb.dev/apimachinery/apis/kubedb"
+	dbapi "kubedb.dev/apimachinery/apis/kubedb/v1"
+
+	core "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	kmapi "kmodules.xyz/client-go/api/v1"
+	meta_util "kmodules.xyz/client-go/meta"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// branchClient is a minimal client.Client mock serving the List calls of the Branch webhook.
+// All other methods panic.
+type branchClient struct {
+	client.Client
+	quotas   []courierapi.BranchQuota
+	branches []courierapi.Branch
+	pvcs     []core.PersistentVolumeClaim
+}
+
+func (c *branchClient) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	lo := &client.ListOptions{}
+	lo.ApplyOptions(opts)
+	selected := func(obj metav1.Object) bool {
+		if lo.Namespace != "" && obj.GetNamespace() != lo.Namespace {
+			return false
+		}
+		return lo.LabelSelector == nil || lo.LabelSelector.Matches(labels.Set(obj.GetLabels()))
+	}
+
+	switch l := list.(type) {
+	case *courierapi.BranchQuotaList:
+		for _, q := range c.quotas {
+			if selected(&q) {
+				l.Items = append(l.Items, q)
+			}
+		}
+	case *courierapi.BranchList:
+		for _, b := range c.branches {
+			if selected(&b) {
+				l.Items = append(l.Items, b)
+			}
+		}
+	case *core.PersistentVolumeClaimList:
+		for _, pvc := range c.pvcs {
+			if selected(&pvc) {
+				l.Items = append(l.Items, pvc)
+			}
+		}
+	default:
+		return fmt.Errorf("unexpected list %T", list)
+	}
+	return nil
+}
+
+func (c *branchClient) RESTMapper() meta.RESTMapper {
+	m := meta.NewDefaultRESTMapper([]schema.GroupVersion{dbapi.SchemeGroupVersion})
+	m.AddSpecific(dbapi.SchemeGroupVersion.WithKind(dbapi.ResourceKindPostgres),
+		dbapi.SchemeGroupVersion.WithResource(dbapi.ResourcePluralPostgres),
+		dbapi.SchemeGroupVersion.WithResource(dbapi.ResourceSingularPostgres), meta.RESTScopeNamespace)
+	m.AddSpecific(dbapi.SchemeGroupVersion.WithKind(dbapi.ResourceKindMySQL),
+		dbapi.SchemeGroupVersion.WithResource(dbapi.ResourcePluralMySQL),
+		dbapi.SchemeGroupVersion.WithResource(dbapi.ResourceSingularMySQL), meta.RESTScopeNamespace)
+	return m
+}
+
+func makeBranch(ns, name, srcNs, srcKind, srcName string) courierapi.Branch {
+	b := courierapi.Branch{}
+	b.Namespace = ns
+	b.Name = name
+	b.Spec.Source.Namespace = srcNs
+	b.Spec.Source.DatabaseRef = core.TypedLocalObjectReference{Kind: srcKind, Name: srcName}
+	return b
+}
+
+func makeBranchQuota(ns, name string, src *core.TypedLocalObjectReference, maxBranches int32, maxStorage string) courierapi.BranchQuota {
+	q := courierapi.BranchQuota{}
+	q.Namespace = ns
+	q.Name = name
+	q.Spec.Source = src
+	if maxBranches > 0 {
+		q.Spec.MaxBranches = &maxBranches
+	}
+	if maxStorage != "" {
+		s := resource.MustParse(maxStorage)
+		q.Spec.MaxClonedStorage = &s
+	}
+	return q
+}
+
+func makeSourcePVC(ns, name, resourcePlural, instance, size string) core.PersistentVolumeClaim {
+	pvc := core.PersistentVolumeClaim{}
+	pvc.Namespace = ns
+	pvc.Name = name
+	pvc.Labels = map[string]string{
+		meta_util.NameLabelKey:      fmt.Sprintf("%s.%s", resourcePlural, kubedb.GroupName),
+		meta_util.InstanceLabelKey:  instance,
+		meta_util.ManagedByLabelKey: kubedb.GroupName,
+	}
+	pvc.Spec.Resources.Requests = core.ResourceList{core.ResourceStorage: resource.MustParse(size)}
+	return pvc
+}
+
+func withClonedStorage(b courierapi.Branch, size string) courierapi.Branch {
+	s := resource.MustParse(size)
+	b.Status.ClonedStorage = &s
+	return b
+}
+
+func TestBranchValidateQuotas(t *testing.T) {
+	pgSource := &core.TypedLocalObjectReference{Kind: dbapi.ResourceKindPostgres, Name: "pg"}
+
+	tests := []struct {
+		name     string
+		branch   courierapi.Branch
+		quotas   []courierapi.BranchQuota
+		branches []courierapi.Branch
+		pvcs     []core.PersistentVolumeClaim
+		wantErr  string
+	}{
+		{
+			name:   "no quota",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			branches: []courierapi.Branch{
+				makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			},
+		},
+		{
+			name:   "namespace quota counts only its namespace",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("dev", "q", nil, 2, "")},
+			branches: []courierapi.Branch{
+				makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+				makeBranch("qa", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			},
+		},
+		{
+			name:   "namespace quota exceeded",
+			branch: makeBranch("dev", "b2", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("dev", "q", nil, 2, "")},
+			branches: []courierapi.Branch{
+				makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+				makeBranch("dev", "b1", "prod", dbapi.ResourceKindMySQL, "my"),
+			},
+			wantErr: "BranchQuota dev/q allows at most 2 branches, 2 in use",
+		},
+		{
+			name:   "namespace quota of another namespace is ignored",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("qa", "q", nil, 1, "")},
+			branches: []courierapi.Branch{
+				makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			},
+		},
+		{
+			name:   "source quota counts branches across namespaces",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("prod", "q", pgSource, 2, "")},
+			branches: []courierapi.Branch{
+				makeBranch("qa", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+				makeBranch("staging", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			},
+			wantErr: "BranchQuota prod/q allows at most 2 branches, 2 in use",
+		},
+		{
+			name:   "source quota skips branches of other sources",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("prod", "q", pgSource, 2, "")},
+			branches: []courierapi.Branch{
+				makeBranch("qa", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+				makeBranch("qa", "b1", "prod", dbapi.ResourceKindMySQL, "pg"),
+				makeBranch("qa", "b2", "staging", dbapi.ResourceKindPostgres, "pg"),
+				makeBranch("prod", "b3", "", dbapi.ResourceKindPostgres, "other"),
+			},
+		},
+		{
+			name:   "source in the branch namespace by default",
+			branch: makeBranch("prod", "b1", "", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("prod", "q", pgSource, 1, "")},
+			branches: []courierapi.Branch{
+				makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			},
+			wantErr: "BranchQuota prod/q allows at most 1 branches, 1 in use",
+		},
+		{
+			name:   "updating branch is not counted twice",
+			branch: makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("prod", "q", pgSource, 1, "")},
+			branches: []courierapi.Branch{
+				makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"),
+			},
+		},
+		{
+			name:   "cloned storage within quota",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("dev", "q", nil, 0, "30Gi")},
+			branches: []courierapi.Branch{
+				withClonedStorage(makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"), "10Gi"),
+			},
+			pvcs: []core.PersistentVolumeClaim{
+				makeSourcePVC("prod", "data-pg-0", dbapi.ResourcePluralPostgres, "pg", "10Gi"),
+				makeSourcePVC("prod", "data-pg-1", dbapi.ResourcePluralPostgres, "pg", "10Gi"),
+			},
+		},
+		{
+			name:   "cloned storage exceeded",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("dev", "q", nil, 0, "25Gi")},
+			branches: []courierapi.Branch{
+				withClonedStorage(makeBranch("dev", "b0", "prod", dbapi.ResourceKindPostgres, "pg"), "10Gi"),
+			},
+			pvcs: []core.PersistentVolumeClaim{
+				makeSourcePVC("prod", "data-pg-0", dbapi.ResourcePluralPostgres, "pg", "10Gi"),
+				makeSourcePVC("prod", "data-pg-1", dbapi.ResourcePluralPostgres, "pg", "10Gi"),
+			},
+			wantErr: "allows at most 25Gi of cloned storage, branch needs about 20Gi",
+		},
+		{
+			name:   "source PVCs of another kind with the same name are not counted",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "db"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("dev", "q", nil, 0, "15Gi")},
+			pvcs: []core.PersistentVolumeClaim{
+				makeSourcePVC("prod", "data-db-0", dbapi.ResourcePluralPostgres, "db", "10Gi"),
+				makeSourcePVC("prod", "data-db-mysql-0", dbapi.ResourcePluralMySQL, "db", "10Gi"),
+			},
+		},
+		{
+			name:   "source PVCs in another namespace are not counted",
+			branch: makeBranch("dev", "b1", "prod", dbapi.ResourceKindPostgres, "pg"),
+			quotas: []courierapi.BranchQuota{makeBranchQuota("dev", "q", nil, 0, "15Gi")},
+			pvcs: []core.PersistentVolumeClaim{
+				makeSourcePVC("prod", "data-pg-0", dbapi.ResourcePluralPostgres, "pg", "10Gi"),
+				makeSourcePVC("dev", "data-pg-0", dbapi.ResourcePluralPostgres, "pg", "10Gi"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := BranchCustomWebhook{DefaultClient: &branchClient{quotas: tt.quotas, branches: tt.branches, pvcs: tt.pvcs}}
+			errs := w.validateQuotas(context.TODO(), &tt.branch)
+			checkFieldErrors(t, errs, tt.wantErr)
+		})
+	}
+}
+
+func TestBranchEstimateClonedStorage(t *testing.T) {
+	w := BranchCustomWebhook{DefaultClient: &branchClient{pvcs: []core.PersistentVolumeClaim{
+		makeSourcePVC("prod", "data-db-0", dbapi.ResourcePluralPostgres, "db", "10Gi"),
+		makeSourcePVC("prod", "data-db-1", dbapi.ResourcePluralPostgres, "db", "5Gi"),
+		makeSourcePVC("prod", "data-db-mysql-0", dbapi.ResourcePluralMySQL, "db", "100Gi"),
+		makeSourcePVC("prod", "data-other-0", dbapi.ResourcePluralPostgres, "other", "100Gi"),
+	}}}
+
+	tests := []struct {
+		kind string
+		want string
+	}{
+		{kind: dbapi.ResourceKindPostgres, want: "15Gi"},
+		{kind: dbapi.ResourceKindMySQL, want: "100Gi"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.kind, func(t *testing.T) {
+			b := makeBranch("dev", "b", "prod", tt.kind, "db")
+			got, err := w.estimateClonedStorage(context.TODO(), &b)
+			if err != nil {
+				t.Fatalf("estimateClonedStorage() error = %v", err)
+			}
+			if want := resource.MustParse(tt.want); got.Cmp(want) != 0 {
+				t.Errorf("estimateClonedStorage() = %s, want %s", got.String(), tt.want)
+			}
+		})
+	}
+
+	b := makeBranch("dev", "b", "prod", "Unknown", "db")
+	if _, err := w.estimateClonedStorage(context.TODO(), &b); err == nil {
+		t.Errorf("estimateClonedStorage() of an unknown kind must fail")
+	}
+}
+
+func TestBranchValidateSourceUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		update  func(b *courierapi.Branch)
+		wantErr string
+	}{
+		{name: "unchanged", update: func(b *courierapi.Branch) {}},
+		{name: "other field", update: func(b *courierapi.Branch) { b.Spec.Expiry = &courierapi.BranchExpiry{} }},
+		{
+			name:    "database",
+			update:  func(b *courierapi.Branch) { b.Spec.Source.DatabaseRef.Name = "pg-big" },
+			wantErr: "source cannot be changed",
+		},
+		{
+			name:    "namespace",
+			update:  func(b *courierapi.Branch) { b.Spec.Source.Namespace = "prod" },
+			wantErr: "source cannot be changed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			old := makeBranch("dev", "b", "", dbapi.ResourceKindPostgres, "pg")
+			b := *old.DeepCopy()
+			tt.update(&b)
+			checkFieldErrors(t, BranchCustomWebhook{}.validateSourceUpdate(&old, &b), tt.wantErr)
+		})
+	}
+}
+
+func TestBranchValidateExpiry(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+
+	tests := []struct {
+		name        string
+		expiry      *courierapi.BranchExpiry
+		checkFuture bool
+		wantErr     string
+	}{
+		{name: "no expiry"},
+		{name: "ttl", expiry: &courierapi.BranchExpiry{TTL: &metav1.Duration{Duration: time.Hour}}},
+		{name: "expiresAt", expiry: &courierapi.BranchExpiry{ExpiresAt: &future}, checkFuture: true},
+		{name: "empty", expiry: &courierapi.BranchExpiry{}, wantErr: "one of ttl or expiresAt must be set"},
+		{
+			name:    "ttl and expiresAt",
+			expiry:  &courierapi.BranchExpiry{TTL: &metav1.Duration{Duration: time.Hour}, ExpiresAt: &future},
+			wantErr: "ttl and expiresAt are mutually exclusive",
+		},
+		{name: "zero ttl", expiry: &courierapi.BranchExpiry{TTL: &metav1.Duration{}}, wantErr: "must be positive"},
+		{name: "past expiresAt", expiry: &courierapi.BranchExpiry{ExpiresAt: &past}, checkFuture: true, wantErr: "must be in the future"},
+		{name: "unchanged past expiresAt", expiry: &courierapi.BranchExpiry{ExpiresAt: &past}},
+		{
+			name: "negative warning period",
+			expiry: &courierapi.BranchExpiry{
+				TTL:           &metav1.Duration{Duration: time.Hour},
+				WarningPeriod: &metav1.Duration{Duration: -time.Minute},
+			},
+			wantErr: "must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := makeBranch("dev", "b", "", dbapi.ResourceKindPostgres, "pg")
+			b.Spec.Expiry = tt.expiry
+			checkFieldErrors(t, BranchCustomWebhook{}.validateExpiry(&b, tt.checkFuture), tt.wantErr)
+		})
+	}
+}
+
+func TestBranchValidateArchiver(t *testing.T) {
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	archiver := func() *courierapi.BranchArchiverSource {
+		return &courierapi.BranchArchiverSource{
+			FullDBRepository:   &kmapi.ObjectReference{Name: "full"},
+			ManifestRepository: &kmapi.ObjectReference{Name: "manifest"},
+		}
+	}
+
+	tests := []struct {
+		name          string
+		archiver      func(a *courierapi.BranchArchiverSource)
+		snapshotClass string
+		wantErr       string
+	}{
+		{name: "latest point"},
+		{name: "point in time", archiver: func(a *courierapi.BranchArchiverSource) { a.RecoveryTimestamp = &past }},
+		{name: "snapshot class", snapshotClass: "csi", wantErr: "does not snapshot the source volumes"},
+		{name: "no full repository", archiver: func(a *courierapi.BranchArchiverSource) { a.FullDBRepository = nil }, wantErr: "full database backups must be set"},
+		{name: "no manifest repository", archiver: func(a *courierapi.BranchArchiverSource) { a.ManifestRepository.Name = "" }, wantErr: "manifest backups must be set"},
+		{name: "unnamed encryption secret", archiver: func(a *courierapi.BranchArchiverSource) { a.EncryptionSecret = &kmapi.ObjectReference{} }, wantErr: "encryptionSecret.name"},
+		{name: "future point in time", archiver: func(a *courierapi.BranchArchiverSource) { a.RecoveryTimestamp = &future }, wantErr: "must not be in the future"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := makeBranch("dev", "b", "", dbapi.ResourceKindPostgres, "pg")
+			b.Spec.Source.Archiver = archiver()
+			if tt.archiver != nil {
+				tt.archiver(b.Spec.Source.Archiver)
+			}
+			b.Spec.VolumeSnapshotClassName = tt.snapshotClass
+			checkFieldErrors(t, BranchCustomWebhook{}.validateArchiver(&b), tt.wantErr)
+		})
+	}
+}
+
+func TestBranchValidateMasking(t *testing.T) {
+	column := func(name string, strategy courierapi.BranchMaskStrategy) courierapi.BranchColumnMask {
+		return courierapi.BranchColumnMask{Column: name, Strategy: strategy}
+	}
+
+	tests := []struct {
+		name    string
+		kind    string
+		masking *courierapi.BranchMaskingPolicy
+		wantErr string
+	}{
+		{name: "no masking", kind: dbapi.ResourceKindPostgres},
+		{
+			name: "valid",
+			kind: dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{
+				Table: "users",
+				Columns: []courierapi.BranchColumnMask{
+					column("email", courierapi.BranchMaskStrategyHash),
+					{Column: "name", Strategy: courierapi.BranchMaskStrategyFaker, FakerType: courierapi.BranchFakerTypeName},
+					{Column: "phone", Strategy: courierapi.BranchMaskStrategyRedact, Redact: &courierapi.BranchRedactOptions{KeepSuffix: 4}},
+				},
+			}}},
+		},
+		{
+			name:    "unsupported kind",
+			kind:    dbapi.ResourceKindRedis,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{Table: "t", RowFilter: "true"}}},
+			wantErr: "Unsupported value",
+		},
+		{name: "no rules", kind: dbapi.ResourceKindPostgres, masking: &courierapi.BranchMaskingPolicy{}, wantErr: "at least one rule must be set"},
+		{
+			name:    "no table",
+			kind:    dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{RowFilter: "true"}}},
+			wantErr: "spec.masking.rules[0].table",
+		},
+		{
+			name:    "neither columns nor rowFilter",
+			kind:    dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{Table: "t"}}},
+			wantErr: "one of columns or rowFilter must be set",
+		},
+		{
+			name: "duplicate column",
+			kind: dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{
+				Table:   "t",
+				Columns: []courierapi.BranchColumnMask{column("c", courierapi.BranchMaskStrategyHash), column("c", courierapi.BranchMaskStrategyNull)},
+			}}},
+			wantErr: "Duplicate value",
+		},
+		{
+			name: "faker without type",
+			kind: dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{
+				Table: "t", Columns: []courierapi.BranchColumnMask{column("c", courierapi.BranchMaskStrategyFaker)},
+			}}},
+			wantErr: "required for the Faker strategy",
+		},
+		{
+			name: "redact options without redact",
+			kind: dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{
+				Table: "t", Columns: []courierapi.BranchColumnMask{{Column: "c", Strategy: courierapi.BranchMaskStrategyHash, Redact: &courierapi.BranchRedactOptions{}}},
+			}}},
+			wantErr: "only allowed for the Redact strategy",
+		},
+		{
+			name: "negative keepPrefix",
+			kind: dbapi.ResourceKindPostgres,
+			masking: &courierapi.BranchMaskingPolicy{Rules: []courierapi.BranchMaskingRule{{
+				Table: "t", Columns: []courierapi.BranchColumnMask{{Column: "c", Strategy: courierapi.BranchMaskStrategyRedact, Redact: &courierapi.BranchRedactOptions{KeepPrefix: -1}}},
+			}}},
+			wantErr: "must not be negative",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := makeBranch("dev", "b", "", tt.kind, "db")
+			b.Spec.Masking = tt.masking
+			checkFieldErrors(t, BranchCustomWebhook{}.validateMasking(&b), tt.wantErr)
+		})
+	}
+}
+
+func TestBranchValidateSchemaDiff(t *testing.T) {
+	tests := []struct {
+		name       string
+		kind       string
+		schemaDiff *courierapi.BranchSchemaDiffRequest
+		wantErr    string
+	}{
+		{name: "no schema diff", kind: dbapi.ResourceKindMongoDB},
+		{name: "valid", kind: dbapi.ResourceKindMySQL, schemaDiff: &courierapi.BranchSchemaDiffRequest{RequestID: "1"}},
+		{name: "unsupported kind", kind: dbapi.ResourceKindMongoDB, schemaDiff: &courierapi.BranchSchemaDiffRequest{RequestID: "1"}, wantErr: "Unsupported value"},
+		{name: "no request id", kind: dbapi.ResourceKindPostgres, schemaDiff: &courierapi.BranchSchemaDiffRequest{}, wantErr: "computed once per requestID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := makeBranch("dev", "b", "", tt.kind, "db")
+			b.Spec.SchemaDiff = tt.schemaDiff
+			checkFieldErrors(t, BranchCustomWebhook{}.validateSchemaDiff(&b), tt.wantErr)
+		})
+	}
+}
+
+func checkFieldErrors(t *testing.T, errs field.ErrorList, wantErr string) {
+	t.Helper()
+	if wantErr == "" {
+		if len(errs) > 0 {
+			t.Errorf("unexpected error: %v", errs.ToAggregate())
+		}
+		return
+	}
+	if len(errs) == 0 {
+		t.Errorf("expected error containing %q, got none", wantErr)
+		return
+	}
+	if got := errs.ToAggregate().Error(); !strings.Contains(got, wantErr) {
+		t.Errorf("error = %q, want it to contain %q", got, wantErr)
+	}
+}
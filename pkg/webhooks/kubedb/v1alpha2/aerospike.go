@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 
+	"kubedb.dev/apimachinery/apis/kubedb"
 	olddbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -77,7 +78,37 @@ func (w *AerospikeCustomWebhook) ValidateCreate(ctx context.Context, obj runtime
 		return nil, fmt.Errorf("expected an aerospike object but got %T", obj)
 	}
 	aerospikelog.Info("validate create", "name", ar.Name)
-	return nil, nil
+	allErr := w.ValidateCreateOrUpdate(ar)
+	if len(allErr) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(schema.GroupKind{Group: kubedb.GroupName, Kind: olddbapi.ResourceKindAerospike}, ar.Name, allErr)
+}
+
+func (w *AerospikeCustomWebhook) ValidateCreateOrUpdate(db *olddbapi.Aerospike) field.ErrorList {
+	var allErr field.ErrorList
+
+	if db.Spec.StorageType == "" {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("storageType"),
+			db.Name,
+			"StorageType can not be empty"))
+	} else {
+		if db.Spec.StorageType != olddbapi.StorageTypeDurable && db.Spec.StorageType != olddbapi.StorageTypeEphemeral {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("storageType"),
+				db.Name,
+				"StorageType should be either durable or ephemeral"))
+		}
+	}
+	if db.Spec.StorageType == olddbapi.StorageTypeDurable && db.Spec.Storage == nil {
+		allErr = append(allErr, field.Required(field.NewPath("spec").Child("storage"),
+			"Storage can't be empty when StorageType is durable"))
+	}
+	if db.Spec.StorageType == olddbapi.StorageTypeEphemeral && db.Spec.Storage != nil {
+		allErr = append(allErr, field.Forbidden(field.NewPath("spec").Child("storage"),
+			"Storage can not be set when StorageType is ephemeral"))
+	}
+
+	return allErr
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -87,7 +118,11 @@ func (w *AerospikeCustomWebhook) ValidateUpdate(ctx context.Context, old, newObj
 		return nil, fmt.Errorf("expected an aerospike object but got %T", ar)
 	}
 	aerospikelog.Info("validate update", "name", ar.Name)
-	return nil, nil
+	allErr := w.ValidateCreateOrUpdate(ar)
+	if len(allErr) == 0 {
+		return nil, nil
+	}
+	return nil, apierrors.NewInvalid(schema.GroupKind{Group: kubedb.GroupName, Kind: olddbapi.ResourceKindAerospike}, ar.Name, allErr)
 }
 
 // ValidateDelete implements webhook.Validator so a webhook will be registered for the type
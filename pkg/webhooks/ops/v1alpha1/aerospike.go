@@ -0,0 +1,387 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	catalog "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
+	olddbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+	opsutil "kubedb.dev/apimachinery/pkg/webhooks/ops"
+
+	"github.com/pkg/errors"
+	"gomodules.xyz/x/arrays"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	meta_util "kmodules.xyz/client-go/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupAerospikeOpsRequestWebhookWithManager registers the webhook for AerospikeOpsRequest in the manager.
+func SetupAerospikeOpsRequestWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&opsapi.AerospikeOpsRequest{}).
+		WithValidator(&AerospikeOpsRequestCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type AerospikeOpsRequestCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var aerospikeLog = logf.Log.WithName("aerospike-opsrequest")
+
+var _ webhook.CustomValidator = &AerospikeOpsRequestCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *AerospikeOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	ops, ok := obj.(*opsapi.AerospikeOpsRequest)
+	if !ok {
+		return nil, fmt.Errorf("expected an AerospikeOpsRequest object but got %T", obj)
+	}
+	aerospikeLog.Info("validate create", "name", ops.Name)
+	warnings, err := w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, warnings, err)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *AerospikeOpsRequestCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	ops, ok := newObj.(*opsapi.AerospikeOpsRequest)
+	if !ok {
+		return nil, fmt.Errorf("expected an AerospikeOpsRequest object but got %T", newObj)
+	}
+	aerospikeLog.Info("validate update", "name", ops.Name)
+
+	oldOps, ok := oldObj.(*opsapi.AerospikeOpsRequest)
+	if !ok {
+		return nil, fmt.Errorf("expected an AerospikeOpsRequest object but got %T", oldObj)
+	}
+
+	if err := validateAerospikeOpsRequest(ops, oldOps); err != nil {
+		return nil, err
+	}
+
+	warnings, err := w.validateCreateOrUpdate(ops)
+	if err != nil {
+		return warnings, err
+	}
+
+	if isOpsReqCompleted(ops.Status.Phase) && !isOpsReqCompleted(oldOps.Status.Phase) { // just completed
+		var db olddbapi.Aerospike
+		err := w.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: ops.Spec.DatabaseRef.Name, Namespace: ops.Namespace}, &db)
+		if err != nil {
+			return warnings, err
+		}
+		return warnings, resumeDatabase(w.DefaultClient, &db)
+	}
+	return warnings, nil
+}
+
+func validateAerospikeOpsRequest(req *opsapi.AerospikeOpsRequest, oldReq *opsapi.AerospikeOpsRequest) error {
+	preconditions := meta_util.PreConditionSet{Set: sets.New[string]("spec")}
+	_, err := meta_util.CreateStrategicPatch(oldReq, req, preconditions.PreconditionFunc()...)
+	if err != nil {
+		if mergepatch.IsPreconditionFailed(err) {
+			return fmt.Errorf("%v.%v", err, preconditions.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.AerospikeOpsRequest) (admission.Warnings, error) {
+	if validType, _ := arrays.Contains(opsapi.AerospikeOpsRequestTypeNames(), string(req.Spec.Type)); !validType {
+		return nil, field.Invalid(field.NewPath("spec").Child("type"), req.Name,
+			fmt.Sprintf("defined OpsRequestType %s is not supported, supported types for Aerospike are %s", req.Spec.Type, strings.Join(opsapi.AerospikeOpsRequestTypeNames(), ", ")))
+	}
+	db, err := w.hasDatabaseRef(req)
+	if err != nil {
+		return nil, err
+	}
+	var allErr field.ErrorList
+	var warnings admission.Warnings
+
+	switch opsapi.AerospikeOpsRequestType(req.GetRequestType()) {
+	case opsapi.AerospikeOpsRequestTypeRestart:
+
+	case opsapi.AerospikeOpsRequestTypeHorizontalScaling:
+		warns, err := w.validateAerospikeHorizontalScalingOpsRequest(db, req)
+		warnings = append(warnings, warns...)
+		if err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizontalScaling"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeVerticalScaling:
+		if err := w.validateAerospikeVerticalScalingOpsRequest(req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("verticalScaling"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeVolumeExpansion:
+		if err := w.validateAerospikeVolumeExpansionOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("volumeExpansion"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeUpdateVersion:
+		if err := w.validateAerospikeUpdateVersionOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion"),
+				req.Name,
+				err.Error()))
+		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindAerospikeVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.AerospikeOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.AerospikeOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeReconfigure:
+		if err := w.validateAerospikeReconfigurationOpsRequest(req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("configuration"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeReconfigureTLS:
+		if err := w.validateAerospikeReconfigurationTLSOpsRequest(req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("tls"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeRotateAuth:
+		if err := w.validateAerospikeRotateAuthenticationOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("authentication"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.AerospikeOpsRequestTypeStorageMigration:
+		if err := w.validateAerospikeStorageMigrationOpsRequest(req, db); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("migration"),
+				req.Name,
+				err.Error()))
+		}
+	}
+
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
+	if len(allErr) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(schema.GroupKind{Group: "Aerospikeopsrequests.kubedb.com", Kind: "AerospikeOpsRequest"}, req.Name, allErr)
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) hasDatabaseRef(req *opsapi.AerospikeOpsRequest) (*olddbapi.Aerospike, error) {
+	aerospike := &olddbapi.Aerospike{}
+	if err := w.DefaultClient.Get(context.TODO(), types.NamespacedName{
+		Name:      req.GetDBRefName(),
+		Namespace: req.GetNamespace(),
+	}, aerospike); err != nil {
+		return nil, fmt.Errorf("spec.databaseRef %s/%s, is invalid or not found", req.GetNamespace(), req.GetDBRefName())
+	}
+	return aerospike, nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeVerticalScalingOpsRequest(req *opsapi.AerospikeOpsRequest) error {
+	verticalScalingSpec := req.Spec.VerticalScaling
+	if verticalScalingSpec == nil {
+		return errors.New("spec.verticalScaling nil not supported in VerticalScaling type")
+	}
+
+	if verticalScalingSpec.Aerospike == nil && verticalScalingSpec.Exporter == nil {
+		return errors.New("at least one of spec.verticalScaling.aerospike and spec.verticalScaling.exporter must be specified")
+	}
+	return nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeHorizontalScalingOpsRequest(db *olddbapi.Aerospike, req *opsapi.AerospikeOpsRequest) (admission.Warnings, error) {
+	horizontalScalingSpec := req.Spec.HorizontalScaling
+	if horizontalScalingSpec == nil {
+		return nil, errors.New("spec.horizontalScaling nil not supported in HorizontalScaling type")
+	}
+
+	if horizontalScalingSpec.Replicas == nil {
+		return nil, errors.New("spec.horizontalScaling.replicas can not be empty")
+	}
+
+	if *horizontalScalingSpec.Replicas <= 0 {
+		return nil, errors.New("spec.horizontalScaling.replicas must be positive")
+	}
+
+	if horizontalScalingSpec.MigrationTimeout != nil && horizontalScalingSpec.MigrationTimeout.Duration <= 0 {
+		return nil, errors.New("spec.horizontalScaling.migrationTimeout must be positive")
+	}
+
+	if db.Spec.Mode != olddbapi.AerospikeModeCluster || db.Spec.Cluster == nil {
+		return nil, fmt.Errorf("horizontal scaling is only supported for Aerospike in %s mode", olddbapi.AerospikeModeCluster)
+	}
+
+	if rf := db.Spec.Cluster.ReplicationFactor; rf != nil && *horizontalScalingSpec.Replicas < *rf {
+		return nil, fmt.Errorf("spec.horizontalScaling.replicas %d can not be less than the replication factor %d", *horizontalScalingSpec.Replicas, *rf)
+	}
+
+	var warnings admission.Warnings
+	if replicas := db.Spec.Cluster.Replicas; replicas != nil && *horizontalScalingSpec.Replicas < *replicas {
+		warnings = append(warnings, fmt.Sprintf("scaling down from %d to %d nodes migrates the partitions of each removed node, the cluster has less headroom until the migrations finish",
+			*replicas, *horizontalScalingSpec.Replicas))
+	}
+	return warnings, nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeVolumeExpansionOpsRequest(db *olddbapi.Aerospike, req *opsapi.AerospikeOpsRequest) error {
+	volumeExpansionSpec := req.Spec.VolumeExpansion
+	if volumeExpansionSpec == nil {
+		return errors.New("spec.volumeExpansion nil not supported in VolumeExpansion type")
+	}
+
+	if volumeExpansionSpec.Aerospike == nil {
+		return errors.New("spec.volumeExpansion.aerospike can't be empty")
+	}
+
+	if db.Spec.StorageType == olddbapi.StorageTypeEphemeral {
+		return errors.New("volume expansion is not supported for Aerospike with ephemeral storage")
+	}
+
+	return opsutil.ValidateStorageExpansion(db.Spec.Storage, volumeExpansionSpec.Aerospike, req.Status.Phase, "Aerospike")
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeUpdateVersionOpsRequest(db *olddbapi.Aerospike, req *opsapi.AerospikeOpsRequest) error {
+	updateVersionSpec := req.Spec.UpdateVersion
+	if updateVersionSpec == nil {
+		return errors.New("spec.updateVersion nil not supported in UpdateVersion type")
+	}
+
+	yes, err := IsUpgradable(w.DefaultClient, catalog.ResourceKindAerospikeVersion, db.Spec.Version, updateVersionSpec.TargetVersion)
+	if err != nil {
+		return err
+	}
+	if !yes {
+		return fmt.Errorf("upgrade from version %v to %v is not supported", db.Spec.Version, req.Spec.UpdateVersion.TargetVersion)
+	}
+
+	return nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeReconfigurationOpsRequest(req *opsapi.AerospikeOpsRequest) error {
+	configurationSpec := req.Spec.Configuration
+	if configurationSpec == nil {
+		return errors.New("spec.configuration nil not supported in Reconfigure type")
+	}
+
+	if !configurationSpec.RemoveCustomConfig && configurationSpec.ConfigSecret == nil && len(configurationSpec.ApplyConfig) == 0 {
+		return errors.New("at least one of `RemoveCustomConfig`, `ConfigSecret`, or `ApplyConfig` must be specified")
+	}
+	return nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeReconfigurationTLSOpsRequest(req *opsapi.AerospikeOpsRequest) error {
+	TLSSpec := req.Spec.TLS
+	if TLSSpec == nil {
+		return errors.New("spec.TLS nil not supported in ReconfigureTLS type")
+	}
+	configCount := 0
+	if req.Spec.TLS.Remove {
+		configCount++
+	}
+	if req.Spec.TLS.RotateCertificates {
+		configCount++
+	}
+	if req.Spec.TLS.IssuerRef != nil || req.Spec.TLS.Certificates != nil {
+		configCount++
+	}
+
+	if configCount == 0 {
+		return errors.New("no reconfiguration is provided in TLS spec")
+	}
+
+	if configCount > 1 {
+		return errors.New("more than 1 field have assigned to spec.reconfigureTLS but at a time one is allowed to run one operation")
+	}
+	return nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeStorageMigrationOpsRequest(req *opsapi.AerospikeOpsRequest, db *olddbapi.Aerospike) error {
+	m := req.Spec.Migration
+	if m == nil {
+		return errors.New("spec.migration is required for StorageMigration type")
+	}
+	if m.StorageClassName == nil {
+		return errors.New("spec.migration.storageClassName is required")
+	}
+	if req.Spec.Timeout == nil {
+		return errors.New("spec.timeout is required for Storage Migration ops request, adjust timeout according to the size of your database")
+	}
+	if db.Spec.Storage == nil || db.Spec.Storage.StorageClassName == nil {
+		return fmt.Errorf("db.Spec.Storage.StorageClassName can't be nil in the database yaml")
+	}
+	var newstorage, oldstorage storagev1.StorageClass
+	if err := w.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: *m.StorageClassName}, &newstorage); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Wrap(err, fmt.Sprintf("storage class %s not found", *m.StorageClassName))
+		}
+		return err
+	}
+	if err := w.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: *db.Spec.Storage.StorageClassName}, &oldstorage); err != nil {
+		return err
+	}
+	if *oldstorage.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		if *newstorage.VolumeBindingMode != storagev1.VolumeBindingWaitForFirstConsumer {
+			return fmt.Errorf("volume binding mode should be WaitForFirstConsumer for %s storageClass", newstorage.Name)
+		}
+	}
+	return nil
+}
+
+func (w *AerospikeOpsRequestCustomWebhook) validateAerospikeRotateAuthenticationOpsRequest(db *olddbapi.Aerospike, req *opsapi.AerospikeOpsRequest) error {
+	if db.Spec.DisableAuth {
+		return fmt.Errorf("disableAuth is on, RotateAuth is not applicable")
+	}
+	authSpec := req.Spec.Authentication
+	if authSpec != nil && authSpec.SecretRef != nil {
+		if err := validateAuthSecretRef(context.TODO(), w.DefaultClient, req.Namespace, authSpec.SecretRef); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -67,7 +67,8 @@ func (w *CassandraOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, o
 		return nil, fmt.Errorf("expected an CassandraOpsRequest object but got %T", obj)
 	}
 	cassandraLog.Info("validate create", "name", ops.Name)
-	return w.validateCreateOrUpdate(ops)
+	warnings, err := w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, warnings, err)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -161,6 +162,19 @@ func (w *CassandraOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Ca
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindCassandraVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.CassandraOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.CassandraOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.CassandraOpsRequestTypeReconfigure:
 		if err := w.validateCassandraReconfigurationOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("configuration"),
@@ -187,6 +201,12 @@ func (w *CassandraOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Ca
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return warnings, nil
 	}
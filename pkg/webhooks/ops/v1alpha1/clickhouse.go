@@ -68,7 +68,7 @@ func (w *ClickHouseOpsRequestCustomWebhook) ValidateCreate(ctx context.Context,
 		return nil, fmt.Errorf("expected an ClickHouseOpsRequest object but got %T", obj)
 	}
 	clickhouseLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -131,6 +131,19 @@ func (rv *ClickHouseOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(rv.DefaultClient, req, catalog.ResourceKindClickHouseVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.ClickHouseOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(rv.DefaultClient, req, &opsapi.ClickHouseOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.ClickHouseOpsRequestTypeVolumeExpansion:
 		if err := rv.validateClickHouseVolumeExpansionOpsRequest(db, req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("volumeExpansion"),
@@ -169,6 +182,12 @@ func (rv *ClickHouseOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
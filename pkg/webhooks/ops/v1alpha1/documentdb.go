@@ -68,7 +68,7 @@ func (w *DocumentDBOpsRequestCustomWebhook) ValidateCreate(ctx context.Context,
 		return nil, fmt.Errorf("expected an DocumentDBOpsRequest object but got %T", obj)
 	}
 	documentdbLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -146,6 +146,19 @@ func (w *DocumentDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.D
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindDocumentDBVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.DocumentDBOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.DocumentDBOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.DocumentDBOpsRequestTypeReconfigureTLS:
 		if err := w.validateDocumentDBReconfigureTLSOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("tls"),
@@ -175,6 +188,12 @@ func (w *DocumentDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.D
 	case opsapi.DocumentDBOpsRequestTypeSetRaftKeyPair:
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -67,7 +67,8 @@ func (w *DruidOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj r
 		return nil, fmt.Errorf("expected an DruidOpsRequest object but got %T", obj)
 	}
 	druidLog.Info("validate create", "name", ops.Name)
-	return w.validateCreateOrUpdate(ops)
+	warnings, err := w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, warnings, err)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -146,6 +147,19 @@ func (w *DruidOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.DruidO
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindDruidVersion, druid.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.DruidOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.DruidOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.DruidOpsRequestTypeHorizontalScaling:
 		if err := w.validateDruidHorizontalScalingOpsRequest(req, druid); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizontalScaling"),
@@ -192,6 +206,12 @@ func (w *DruidOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.DruidO
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return warnings, nil
 	}
@@ -69,7 +69,8 @@ func (w *ElasticsearchOpsRequestCustomWebhook) ValidateCreate(ctx context.Contex
 		return nil, fmt.Errorf("expected an ElasticsearchOpsRequest object but got %T", obj)
 	}
 	esLog.Info("validate create", "name", ops.Name)
-	return w.validateCreateOrUpdate(ops)
+	warnings, err := w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, warnings, err)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -167,6 +168,19 @@ func (w *ElasticsearchOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsap
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindElasticsearchVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.ElasticsearchOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.ElasticsearchOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.ElasticsearchOpsRequestTypeReconfigure:
 		if err := w.validateElasticsearchReconfigureOpsRequest(req, db); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("configuration"),
@@ -201,6 +215,12 @@ func (w *ElasticsearchOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsap
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return warnings, nil
 	}
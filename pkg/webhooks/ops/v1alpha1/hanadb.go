@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 
+	catalog "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
 	"kubedb.dev/apimachinery/apis/kubedb"
 	olddbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
 	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
@@ -67,7 +68,7 @@ func (w *HanaDBOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj
 		return nil, fmt.Errorf("expected a HanaDBOpsRequest object but got %T", obj)
 	}
 	hanadbOpsLog.Info("validate create", "name", req.Name)
-	return nil, w.validateCreateOrUpdate(req)
+	return withImpactWarnings(ctx, w.DefaultClient, req, nil, w.validateCreateOrUpdate(req))
 }
 
 func (w *HanaDBOpsRequestCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
@@ -125,6 +126,23 @@ func (w *HanaDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.HanaD
 	var allErr field.ErrorList
 	switch req.Spec.Type {
 	case opsapi.HanaDBOpsRequestTypeRestart:
+	case opsapi.HanaDBOpsRequestTypeUpdateVersion:
+		if err := w.validateHanaDBUpdateVersionOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion"), req.Name, err.Error()))
+		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindHanaDBVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"), req.Name, err.Error()))
+			}
+		}
+	case opsapi.HanaDBOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.HanaDBOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"), req.Name, err.Error()))
+		}
+	case opsapi.HanaDBOpsRequestTypeHorizontalScaling:
+		if err := w.validateHanaDBHorizontalScalingOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizontalScaling"), req.Name, err.Error()))
+		}
 	case opsapi.HanaDBOpsRequestTypeVerticalScaling:
 		if err := w.validateHanaDBVerticalScalingOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("verticalScaling"), req.Name, err.Error()))
@@ -151,6 +169,12 @@ func (w *HanaDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.HanaD
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -168,6 +192,40 @@ func (w *HanaDBOpsRequestCustomWebhook) hasDatabaseRef(req *opsapi.HanaDBOpsRequ
 	return db, nil
 }
 
+func (w *HanaDBOpsRequestCustomWebhook) validateHanaDBUpdateVersionOpsRequest(db *olddbapi.HanaDB, req *opsapi.HanaDBOpsRequest) error {
+	updateVersionSpec := req.Spec.UpdateVersion
+	if updateVersionSpec == nil {
+		return errors.New("spec.updateVersion nil not supported in UpdateVersion type")
+	}
+
+	yes, err := IsUpgradable(w.DefaultClient, catalog.ResourceKindHanaDBVersion, db.Spec.Version, updateVersionSpec.TargetVersion)
+	if err != nil {
+		return err
+	}
+	if !yes {
+		return fmt.Errorf("upgrade from version %v to %v is not supported", db.Spec.Version, updateVersionSpec.TargetVersion)
+	}
+	return nil
+}
+
+func (w *HanaDBOpsRequestCustomWebhook) validateHanaDBHorizontalScalingOpsRequest(db *olddbapi.HanaDB, req *opsapi.HanaDBOpsRequest) error {
+	horizontalScalingSpec := req.Spec.HorizontalScaling
+	if horizontalScalingSpec == nil {
+		return errors.New("spec.horizontalScaling nil not supported in HorizontalScaling type")
+	}
+	if horizontalScalingSpec.Replicas == nil {
+		return errors.New("spec.horizontalScaling.replicas can not be empty")
+	}
+	if !db.IsSystemReplication() {
+		return fmt.Errorf("horizontal scaling is only supported for HanaDB in %s mode", olddbapi.HanaDBModeSystemReplication)
+	}
+	// a system replication needs the primary and at least one secondary
+	if *horizontalScalingSpec.Replicas < 2 {
+		return fmt.Errorf("spec.horizontalScaling.replicas %d can not be less than 2 in %s mode", *horizontalScalingSpec.Replicas, olddbapi.HanaDBModeSystemReplication)
+	}
+	return nil
+}
+
 func (w *HanaDBOpsRequestCustomWebhook) validateHanaDBVerticalScalingOpsRequest(req *opsapi.HanaDBOpsRequest) error {
 	verticalScalingSpec := req.Spec.VerticalScaling
 	if verticalScalingSpec == nil {
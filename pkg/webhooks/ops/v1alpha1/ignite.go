@@ -69,7 +69,7 @@ func (w *IgniteOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj
 		return nil, fmt.Errorf("expected an IgniteOpsRequest object but got %T", obj)
 	}
 	igniteLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -170,6 +170,19 @@ func (rv *IgniteOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Igni
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(rv.DefaultClient, req, catalog.ResourceKindIgniteVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.IgniteOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(rv.DefaultClient, req, &opsapi.IgniteOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.IgniteOpsRequestTypeRotateAuth:
 		if err := rv.validateIgniteRotateAuthenticationOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("authentication"),
@@ -184,6 +197,12 @@ func (rv *IgniteOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Igni
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
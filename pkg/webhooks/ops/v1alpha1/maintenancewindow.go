@@ -0,0 +1,89 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupMaintenanceWindowWebhookWithManager registers the webhook for MaintenanceWindow in the manager.
+func SetupMaintenanceWindowWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&opsapi.MaintenanceWindow{}).
+		WithValidator(&MaintenanceWindowCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type MaintenanceWindowCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var maintenanceWindowLog = logf.Log.WithName("maintenancewindow")
+
+var _ webhook.CustomValidator = &MaintenanceWindowCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *MaintenanceWindowCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	mw, ok := obj.(*opsapi.MaintenanceWindow)
+	if !ok {
+		return nil, fmt.Errorf("expected a MaintenanceWindow object but got %T", obj)
+	}
+	maintenanceWindowLog.Info("validate create", "name", mw.Name)
+	return w.validateCreateOrUpdate(mw)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *MaintenanceWindowCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	mw, ok := newObj.(*opsapi.MaintenanceWindow)
+	if !ok {
+		return nil, fmt.Errorf("expected a MaintenanceWindow object but got %T", newObj)
+	}
+	maintenanceWindowLog.Info("validate update", "name", mw.Name)
+	return w.validateCreateOrUpdate(mw)
+}
+
+func (w *MaintenanceWindowCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *MaintenanceWindowCustomWebhook) validateCreateOrUpdate(mw *opsapi.MaintenanceWindow) (admission.Warnings, error) {
+	if err := mw.ValidateSpecs(); err != nil {
+		allErr := field.ErrorList{field.Invalid(field.NewPath("spec"), mw.Name, err.Error())}
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: opsapi.SchemeGroupVersion.Group, Kind: opsapi.ResourceKindMaintenanceWindow}, mw.Name, allErr)
+	}
+
+	var warnings admission.Warnings
+	for _, t := range mw.Spec.OpsTypes {
+		if t == "VerticalScaling" || t == "VolumeExpansion" {
+			warnings = append(warnings, fmt.Sprintf("%s in InPlace or Online mode is never held by a maintenance window", t))
+		}
+	}
+	return warnings, nil
+}
@@ -69,7 +69,7 @@ func (w *MariaDBOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj
 		return nil, fmt.Errorf("expected an MariaDBOpsRequest object but got %T", obj)
 	}
 	mdLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhooin.Validator so a webhook will be registered for the type
@@ -122,6 +122,12 @@ func (w *MariaDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Mari
 	var allErr field.ErrorList
 	switch opsapi.MariaDBOpsRequestType(req.GetRequestType()) {
 	case opsapi.MariaDBOpsRequestTypeRestart:
+	case opsapi.MariaDBOpsRequestTypeSwitchover:
+		if err := w.validateMariaDBSwitchoverOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("switchover"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.MariaDBOpsRequestTypeVerticalScaling:
 		if err := w.validateMariaDBScalingOpsRequest(db, req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("verticalScaling"),
@@ -146,6 +152,19 @@ func (w *MariaDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Mari
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindMariaDBVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.MariaDBOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.MariaDBOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.MariaDBOpsRequestTypeReconfigureTLS:
 		if err := w.validateMariaDBReconfigurationTLSOpsRequest(db, req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("tls"),
@@ -172,6 +191,12 @@ func (w *MariaDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Mari
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -378,3 +403,10 @@ func (w *MariaDBOpsRequestCustomWebhook) validateMariaDBRotateAuthenticationOpsR
 	}
 	return nil
 }
+
+func (w *MariaDBOpsRequestCustomWebhook) validateMariaDBSwitchoverOpsRequest(db *dbapi.MariaDB, req *opsapi.MariaDBOpsRequest) error {
+	if !db.IsCluster() {
+		return errors.New("switchover is not applicable to a standalone MariaDB")
+	}
+	return validateSwitchoverTarget(w.DefaultClient, req, req.Spec.Switchover, db.OffshootSelectors())
+}
@@ -27,6 +27,7 @@ import (
 
 	"github.com/pkg/errors"
 	"gomodules.xyz/x/arrays"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -65,7 +66,7 @@ func (w *MemcachedOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, o
 		return nil, fmt.Errorf("expected an MemcachedOpsRequest object but got %T", obj)
 	}
 	memcachedLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -127,6 +128,12 @@ func (c *MemcachedOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Me
 	}
 	switch opsapi.MemcachedOpsRequestType(req.GetRequestType()) {
 	case opsapi.MemcachedOpsRequestTypeRestart:
+	case opsapi.MemcachedOpsRequestTypeStorageMigration:
+		if err := c.validateMemcachedStorageMigrationOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("migration"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.MemcachedOpsRequestTypeHorizontalScaling:
 		if err := c.validateMemcachedHorizontalScalingOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizontalScaling"),
@@ -151,6 +158,19 @@ func (c *MemcachedOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Me
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(c.DefaultClient, req, catalog.ResourceKindMemcachedVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.MemcachedOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(c.DefaultClient, req, &opsapi.MemcachedOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 
 	case opsapi.MemcachedOpsRequestTypeReconfigureTLS:
 		if err := c.validateMemcachedReconfigureTLSOpsRequest(req); err != nil {
@@ -166,6 +186,12 @@ func (c *MemcachedOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Me
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -266,3 +292,42 @@ func (w *MemcachedOpsRequestCustomWebhook) validateMemcachedRotateAuthentication
 
 	return nil
 }
+
+// validateMemcachedStorageMigrationOpsRequest only allows migrating a data volume that is a generic
+// ephemeral volume, since the PVC of each pod is then created from spec.dataVolume.ephemeral.volumeClaimTemplate.
+// The data of each pod is carried over to the new storage class through lib.CreateEphemeralVolumeMigratorJob.
+func (w *MemcachedOpsRequestCustomWebhook) validateMemcachedStorageMigrationOpsRequest(db *dbapi.Memcached, req *opsapi.MemcachedOpsRequest) error {
+	m := req.Spec.Migration
+	if m == nil {
+		return errors.New("spec.migration is required for StorageMigration type")
+	}
+	if m.StorageClassName == nil {
+		return errors.New("spec.migration.storageClassName is required")
+	}
+	if req.Spec.Timeout == nil {
+		return errors.New("spec.timeout is required for Storage Migration ops request, adjust timeout according to the size of your database")
+	}
+	if db.Spec.DataVolume == nil || db.Spec.DataVolume.Ephemeral == nil || db.Spec.DataVolume.Ephemeral.VolumeClaimTemplate == nil {
+		return errors.New("storage migration is only supported for Memcached with a generic ephemeral db.Spec.DataVolume")
+	}
+	oldStorageClassName := db.Spec.DataVolume.Ephemeral.VolumeClaimTemplate.Spec.StorageClassName
+	if oldStorageClassName == nil {
+		return fmt.Errorf("db.Spec.DataVolume.Ephemeral.VolumeClaimTemplate.Spec.StorageClassName can't be nil in the database yaml")
+	}
+	var newstorage, oldstorage storagev1.StorageClass
+	if err := w.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: *m.StorageClassName}, &newstorage); err != nil {
+		if apierrors.IsNotFound(err) {
+			return errors.Wrap(err, fmt.Sprintf("storage class %s not found", *m.StorageClassName))
+		}
+		return err
+	}
+	if err := w.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: *oldStorageClassName}, &oldstorage); err != nil {
+		return err
+	}
+	if *oldstorage.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		if *newstorage.VolumeBindingMode != storagev1.VolumeBindingWaitForFirstConsumer {
+			return fmt.Errorf("volume binding mode should be WaitForFirstConsumer for %s storageClass", newstorage.Name)
+		}
+	}
+	return nil
+}
@@ -69,7 +69,7 @@ func (w *MongoDBOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj
 		return nil, fmt.Errorf("expected an MongoDBOpsRequest object but got %T", obj)
 	}
 	mongodbLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -132,6 +132,20 @@ func (w *MongoDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Mong
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err = validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindMongoDBVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	}
+	if req.Spec.Type == opsapi.MongoDBOpsRequestTypeRollback {
+		if err = validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.MongoDBOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	}
 	if req.Spec.Type == opsapi.MongoDBOpsRequestTypeHorizontalScaling {
 		if err = w.validateMongoDBHorizontalScalingOpsRequest(&db, req); err != nil {
@@ -140,6 +154,13 @@ func (w *MongoDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Mong
 				err.Error()))
 		}
 	}
+	if req.Spec.Type == opsapi.MongoDBOpsRequestTypeSwitchover {
+		if err = w.validateMongoDBSwitchoverOpsRequest(&db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("switchover"),
+				req.Name,
+				err.Error()))
+		}
+	}
 	if req.Spec.Type == opsapi.MongoDBOpsRequestTypeHorizons {
 		if err = w.validateMongoDBHorizons(&db, req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizons"),
@@ -163,6 +184,12 @@ func (w *MongoDBOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Mong
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -387,3 +414,14 @@ func validateMongoDBOpsRequest(obj, oldObj runtime.Object) error {
 func IsOpsTypeSupported(supportedTypes []string, curOpsType string) bool {
 	return slices.Contains(supportedTypes, curOpsType)
 }
+
+func (w *MongoDBOpsRequestCustomWebhook) validateMongoDBSwitchoverOpsRequest(db *dbapi.MongoDB, req *opsapi.MongoDBOpsRequest) error {
+	if db.Spec.ReplicaSet == nil && db.Spec.ShardTopology == nil {
+		return errors.New("switchover is not applicable to a standalone MongoDB")
+	}
+	// every shard and the config server is a replica set of its own, the target picks one
+	if db.Spec.ShardTopology != nil && (req.Spec.Switchover == nil || req.Spec.Switchover.Target == nil) {
+		return errors.New("`spec.switchover.target` is required for a sharded MongoDB")
+	}
+	return validateSwitchoverTarget(w.DefaultClient, req, req.Spec.Switchover, db.OffshootSelectors())
+}
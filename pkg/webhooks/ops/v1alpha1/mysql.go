@@ -70,7 +70,7 @@ func (w *MySQLOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj r
 		return nil, fmt.Errorf("expected an MySQLOpsRequest object but got %T", obj)
 	}
 	myLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhooin.Validator so a webhook will be registered for the type
@@ -124,6 +124,13 @@ func (w *MySQLOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.MySQLO
 	switch opsapi.MySQLOpsRequestType(req.GetRequestType()) {
 	case opsapi.MySQLOpsRequestTypeRestart:
 
+	case opsapi.MySQLOpsRequestTypeSwitchover:
+		if err := w.validateMySQLSwitchoverOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("switchover"),
+				req.Name,
+				err.Error()))
+		}
+
 	case opsapi.MySQLOpsRequestTypeVerticalScaling:
 		if err := w.validateMySQLScalingOpsRequest(db, req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("verticalScaling"),
@@ -148,6 +155,19 @@ func (w *MySQLOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.MySQLO
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindMySQLVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.MySQLOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.MySQLOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.MySQLOpsRequestTypeReconfigureTLS:
 		if err := w.validateMySQLReconfigurationTLSOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("tls"),
@@ -181,6 +201,12 @@ func (w *MySQLOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.MySQLO
 
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -381,3 +407,20 @@ func (w *MySQLOpsRequestCustomWebhook) validateMySQLOpsRequest(obj, oldObj runti
 	}
 	return nil
 }
+
+func (w *MySQLOpsRequestCustomWebhook) validateMySQLSwitchoverOpsRequest(db *dbapi.MySQL, req *opsapi.MySQLOpsRequest) error {
+	switch {
+	case db.UsesGroupReplication():
+		if db.Spec.Topology.Group != nil && db.Spec.Topology.Group.Mode != nil && *db.Spec.Topology.Group.Mode == dbapi.MySQLGroupModeMultiPrimary {
+			return fmt.Errorf("switchover is not applicable to group replication in %s mode", dbapi.MySQLGroupModeMultiPrimary)
+		}
+	case db.IsInnoDBCluster():
+		if db.Spec.Topology.InnoDBCluster != nil && db.Spec.Topology.InnoDBCluster.Mode != nil && *db.Spec.Topology.InnoDBCluster.Mode == dbapi.MySQLGroupModeMultiPrimary {
+			return fmt.Errorf("switchover is not applicable to InnoDB cluster in %s mode", dbapi.MySQLGroupModeMultiPrimary)
+		}
+	case db.IsSemiSync():
+	default:
+		return fmt.Errorf("switchover is only supported for MySQL in %s, %s and %s modes", dbapi.MySQLModeGroupReplication, dbapi.MySQLModeInnoDBCluster, dbapi.MySQLModeSemiSync)
+	}
+	return validateSwitchoverTarget(w.DefaultClient, req, req.Spec.Switchover, db.OffshootSelectors())
+}
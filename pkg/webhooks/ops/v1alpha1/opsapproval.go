@@ -0,0 +1,230 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	meta_util "kmodules.xyz/client-go/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupOpsApprovalWebhookWithManager registers the webhook for OpsApproval in the manager.
+func SetupOpsApprovalWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&opsapi.OpsApproval{}).
+		WithValidator(&OpsApprovalCustomWebhook{mgr.GetClient()}).
+		WithDefaulter(&OpsApprovalCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type OpsApprovalCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var opsApprovalLog = logf.Log.WithName("opsapproval")
+
+var _ webhook.CustomDefaulter = &OpsApprovalCustomWebhook{}
+
+// Default sets the approver to the identity creating the OpsApproval.
+func (w *OpsApprovalCustomWebhook) Default(ctx context.Context, obj runtime.Object) error {
+	approval, ok := obj.(*opsapi.OpsApproval)
+	if !ok {
+		return fmt.Errorf("expected an OpsApproval object but got %T", obj)
+	}
+	opsApprovalLog.Info("default", "name", approval.Name)
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if approval.CreationTimestamp.IsZero() {
+		approval.Spec.Approver = &opsapi.OpsApprover{
+			Username: req.UserInfo.Username,
+			UID:      req.UserInfo.UID,
+			Groups:   req.UserInfo.Groups,
+		}
+	}
+	return nil
+}
+
+var _ webhook.CustomValidator = &OpsApprovalCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsApprovalCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	approval, ok := obj.(*opsapi.OpsApproval)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsApproval object but got %T", obj)
+	}
+	opsApprovalLog.Info("validate create", "name", approval.Name)
+
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return nil, w.validateCreate(ctx, approval, req.UserInfo.Username)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsApprovalCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	approval, ok := newObj.(*opsapi.OpsApproval)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsApproval object but got %T", newObj)
+	}
+	opsApprovalLog.Info("validate update", "name", approval.Name)
+
+	oldApproval, ok := oldObj.(*opsapi.OpsApproval)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsApproval object but got %T", oldObj)
+	}
+	return nil, validateOpsApproval(approval, oldApproval)
+}
+
+func (w *OpsApprovalCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOpsApproval rejects spec changes, since a decision is final once recorded.
+func validateOpsApproval(approval *opsapi.OpsApproval, oldApproval *opsapi.OpsApproval) error {
+	preconditions := meta_util.PreConditionSet{Set: sets.New[string]("spec")}
+	_, err := meta_util.CreateStrategicPatch(oldApproval, approval, preconditions.PreconditionFunc()...)
+	if err != nil {
+		if mergepatch.IsPreconditionFailed(err) {
+			return fmt.Errorf("%v.%v", err, preconditions.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+func (w *OpsApprovalCustomWebhook) validateCreate(ctx context.Context, approval *opsapi.OpsApproval, username string) error {
+	gk := schema.GroupKind{Group: opsapi.SchemeGroupVersion.Group, Kind: opsapi.ResourceKindOpsApproval}
+	if err := approval.ValidateSpecs(); err != nil {
+		return apierrors.NewInvalid(gk, approval.Name, field.ErrorList{field.Invalid(field.NewPath("spec").Child("opsRequestRef"), approval.Name, err.Error())})
+	}
+	if approval.Spec.Approver == nil || approval.Spec.Approver.Username != username {
+		return apierrors.NewForbidden(opsapi.SchemeGroupVersion.WithResource(opsapi.ResourcePluralOpsApproval).GroupResource(), approval.Name,
+			fmt.Errorf("spec.approver must be the identity %s creating the OpsApproval", username))
+	}
+
+	req, err := w.getOpsRequest(ctx, approval)
+	if err != nil {
+		return apierrors.NewInvalid(gk, approval.Name, field.ErrorList{field.Invalid(field.NewPath("spec").Child("opsRequestRef"), approval.Name, err.Error())})
+	}
+	switch phase := req.GetStatus().Phase; phase {
+	case "", opsapi.OpsRequestPhasePending, opsapi.OpsRequestPhaseWaitingForApproval:
+	default:
+		return fmt.Errorf("%s %s/%s is in %s phase and can no longer be approved or denied", approval.Spec.OpsRequestRef.Kind, req.GetNamespace(), req.GetName(), phase)
+	}
+
+	policies, err := w.getApplicablePolicies(ctx, approval, req)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return fmt.Errorf("no OpsApprovalPolicy requires approval for %s %s/%s", approval.Spec.OpsRequestRef.Kind, req.GetNamespace(), req.GetName())
+	}
+	// the ops request controller records the policies the approver decides under in status.policies
+	if !slices.ContainsFunc(policies, func(p opsapi.OpsApprovalPolicy) bool { return p.IsApprover(*approval.Spec.Approver) }) {
+		return apierrors.NewForbidden(opsapi.SchemeGroupVersion.WithResource(opsapi.ResourcePluralOpsApproval).GroupResource(), approval.Name,
+			fmt.Errorf("%s is not an approver of the OpsApprovalPolicies applying to %s %s/%s", username, approval.Spec.OpsRequestRef.Kind, req.GetNamespace(), req.GetName()))
+	}
+
+	var approvals opsapi.OpsApprovalList
+	if err := w.DefaultClient.List(ctx, &approvals, client.InNamespace(approval.Namespace)); err != nil {
+		return err
+	}
+	for _, a := range approvals.Items {
+		if a.Spec.OpsRequestRef.Kind == approval.Spec.OpsRequestRef.Kind && a.Spec.OpsRequestRef.Name == approval.Spec.OpsRequestRef.Name &&
+			a.Spec.Approver != nil && a.Spec.Approver.Username == username {
+			return fmt.Errorf("%s has already decided on %s %s/%s in OpsApproval %s", username, approval.Spec.OpsRequestRef.Kind, req.GetNamespace(), req.GetName(), a.Name)
+		}
+	}
+	return nil
+}
+
+func (w *OpsApprovalCustomWebhook) getOpsRequest(ctx context.Context, approval *opsapi.OpsApproval) (opsapi.Accessor, error) {
+	obj, err := w.DefaultClient.Scheme().New(opsapi.SchemeGroupVersion.WithKind(approval.Spec.OpsRequestRef.Kind))
+	if err != nil {
+		return nil, err
+	}
+	req, ok := obj.(opsapi.Accessor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not an ops request", approval.Spec.OpsRequestRef.Kind)
+	}
+	if err := w.DefaultClient.Get(ctx, types.NamespacedName{Namespace: approval.Namespace, Name: approval.Spec.OpsRequestRef.Name}, req); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+// getApplicablePolicies returns the OpsApprovalPolicies that select the database of the ops request
+// and apply to its type and source.
+func (w *OpsApprovalCustomWebhook) getApplicablePolicies(ctx context.Context, approval *opsapi.OpsApproval, req opsapi.Accessor) ([]opsapi.OpsApprovalPolicy, error) {
+	var policies opsapi.OpsApprovalPolicyList
+	if err := w.DefaultClient.List(ctx, &policies); err != nil {
+		return nil, err
+	}
+	if len(policies.Items) == 0 {
+		return nil, nil
+	}
+
+	kind := approval.DatabaseKind()
+	mapping, err := w.DefaultClient.RESTMapper().RESTMapping(schema.GroupKind{Group: kubedb.GroupName, Kind: kind})
+	if err != nil {
+		return nil, err
+	}
+	var db unstructured.Unstructured
+	db.SetGroupVersionKind(mapping.GroupVersionKind)
+	if err := w.DefaultClient.Get(ctx, types.NamespacedName{Namespace: req.GetNamespace(), Name: req.GetDBRefName()}, &db); err != nil {
+		return nil, err
+	}
+	var ns core.Namespace
+	if err := w.DefaultClient.Get(ctx, types.NamespacedName{Name: req.GetNamespace()}, &ns); err != nil {
+		return nil, err
+	}
+
+	var result []opsapi.OpsApprovalPolicy
+	for _, p := range policies.Items {
+		selected, err := p.Selects(kind, ns.Labels, db.GetLabels())
+		if err != nil {
+			return nil, err
+		}
+		if selected && p.AppliesTo(req) {
+			result = append(result, p)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,189 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	dbapi "kubedb.dev/apimachinery/apis/kubedb/v1"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	rbac "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// opsApprovalClient is a minimal client.Client mock for the lookups of the OpsApproval webhook:
+// the PostgresOpsRequest, its Postgres and namespace, and the OpsApprovalPolicies and OpsApprovals.
+type opsApprovalClient struct {
+	client.Client
+	req       *opsapi.PostgresOpsRequest
+	policies  []opsapi.OpsApprovalPolicy
+	approvals []opsapi.OpsApproval
+	calls     int
+}
+
+func (c *opsApprovalClient) Scheme() *runtime.Scheme {
+	c.calls++
+	s := runtime.NewScheme()
+	_ = opsapi.AddToScheme(s)
+	return s
+}
+
+func (c *opsApprovalClient) Get(_ context.Context, key types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+	c.calls++
+	switch o := obj.(type) {
+	case *opsapi.PostgresOpsRequest:
+		if c.req == nil || c.req.Name != key.Name {
+			return apierrors.NewNotFound(opsapi.SchemeGroupVersion.WithResource(opsapi.ResourcePluralPostgresOpsRequest).GroupResource(), key.Name)
+		}
+		*o = *c.req
+	case *unstructured.Unstructured:
+		o.SetNamespace(key.Namespace)
+		o.SetName(key.Name)
+	case *core.Namespace:
+		o.Name = key.Name
+	default:
+		return fmt.Errorf("unexpected object %T", obj)
+	}
+	return nil
+}
+
+func (c *opsApprovalClient) List(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+	c.calls++
+	switch l := list.(type) {
+	case *opsapi.OpsApprovalPolicyList:
+		l.Items = c.policies
+	case *opsapi.OpsApprovalList:
+		l.Items = c.approvals
+	default:
+		return fmt.Errorf("unexpected list %T", list)
+	}
+	return nil
+}
+
+func (c *opsApprovalClient) RESTMapper() meta.RESTMapper {
+	m := meta.NewDefaultRESTMapper([]schema.GroupVersion{dbapi.SchemeGroupVersion})
+	m.AddSpecific(dbapi.SchemeGroupVersion.WithKind(dbapi.ResourceKindPostgres),
+		dbapi.SchemeGroupVersion.WithResource(dbapi.ResourcePluralPostgres),
+		dbapi.SchemeGroupVersion.WithResource(dbapi.ResourceSingularPostgres), meta.RESTScopeNamespace)
+	return m
+}
+
+func makeOpsApproval(name, user string) *opsapi.OpsApproval {
+	a := &opsapi.OpsApproval{}
+	a.Namespace = "demo"
+	a.Name = name
+	a.Spec.OpsRequestRef.Kind = opsapi.ResourceKindPostgresOpsRequest
+	a.Spec.OpsRequestRef.Name = "restart"
+	a.Spec.Decision = opsapi.OpsApprovalDecisionApprove
+	if user != "" {
+		a.Spec.Approver = &opsapi.OpsApprover{Username: user, Groups: []string{"system:authenticated"}}
+	}
+	return a
+}
+
+func TestOpsApprovalValidateCreate(t *testing.T) {
+	req := &opsapi.PostgresOpsRequest{}
+	req.Namespace = "demo"
+	req.Name = "restart"
+	req.Spec.Type = opsapi.PostgresOpsRequestTypeRestart
+	req.Spec.DatabaseRef.Name = "pg"
+	req.Status.Phase = opsapi.OpsRequestPhaseWaitingForApproval
+
+	policy := opsapi.OpsApprovalPolicy{}
+	policy.Name = "prod"
+	policy.Spec.Approvers = []rbac.Subject{{Kind: rbac.UserKind, Name: "alice"}}
+
+	tests := []struct {
+		name          string
+		approval      *opsapi.OpsApproval
+		username      string
+		approvals     []opsapi.OpsApproval
+		forbidden     bool
+		noClientCall  bool
+		wantErrSubstr string
+	}{
+		{
+			name:         "approver not set",
+			approval:     makeOpsApproval("a1", ""),
+			username:     "alice",
+			forbidden:    true,
+			noClientCall: true,
+		},
+		{
+			name:         "approver is not the requesting identity",
+			approval:     makeOpsApproval("a1", "alice"),
+			username:     "mallory",
+			forbidden:    true,
+			noClientCall: true,
+		},
+		{
+			name:      "requesting identity is not an approver of the policy",
+			approval:  makeOpsApproval("a1", "mallory"),
+			username:  "mallory",
+			forbidden: true,
+		},
+		{
+			name:     "approver of the policy",
+			approval: makeOpsApproval("a1", "alice"),
+			username: "alice",
+		},
+		{
+			name:          "approver already decided",
+			approval:      makeOpsApproval("a2", "alice"),
+			username:      "alice",
+			approvals:     []opsapi.OpsApproval{*makeOpsApproval("a1", "alice")},
+			wantErrSubstr: "has already decided",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc := &opsApprovalClient{req: req, policies: []opsapi.OpsApprovalPolicy{policy}, approvals: tt.approvals}
+			w := &OpsApprovalCustomWebhook{DefaultClient: kc}
+			err := w.validateCreate(context.TODO(), tt.approval, tt.username)
+
+			if tt.noClientCall && kc.calls > 0 {
+				t.Errorf("the identity check made %d client calls, want none", kc.calls)
+			}
+			if tt.forbidden {
+				if !apierrors.IsForbidden(err) {
+					t.Fatalf("validateCreate() = %v, want Forbidden", err)
+				}
+				return
+			}
+			if tt.wantErrSubstr == "" {
+				if err != nil {
+					t.Fatalf("validateCreate() = %v, want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErrSubstr) {
+				t.Fatalf("validateCreate() = %v, want error containing %q", err, tt.wantErrSubstr)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	rbac "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupOpsApprovalPolicyWebhookWithManager registers the webhook for OpsApprovalPolicy in the manager.
+func SetupOpsApprovalPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&opsapi.OpsApprovalPolicy{}).
+		WithValidator(&OpsApprovalPolicyCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type OpsApprovalPolicyCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var opsApprovalPolicyLog = logf.Log.WithName("opsapprovalpolicy")
+
+var _ webhook.CustomValidator = &OpsApprovalPolicyCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsApprovalPolicyCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	policy, ok := obj.(*opsapi.OpsApprovalPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsApprovalPolicy object but got %T", obj)
+	}
+	opsApprovalPolicyLog.Info("validate create", "name", policy.Name)
+	return w.validateCreateOrUpdate(policy)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsApprovalPolicyCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	policy, ok := newObj.(*opsapi.OpsApprovalPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsApprovalPolicy object but got %T", newObj)
+	}
+	opsApprovalPolicyLog.Info("validate update", "name", policy.Name)
+	return w.validateCreateOrUpdate(policy)
+}
+
+func (w *OpsApprovalPolicyCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *OpsApprovalPolicyCustomWebhook) validateCreateOrUpdate(policy *opsapi.OpsApprovalPolicy) (admission.Warnings, error) {
+	if err := policy.ValidateSpecs(); err != nil {
+		allErr := field.ErrorList{field.Invalid(field.NewPath("spec"), policy.Name, err.Error())}
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: opsapi.SchemeGroupVersion.Group, Kind: opsapi.ResourceKindOpsApprovalPolicy}, policy.Name, allErr)
+	}
+
+	// groups may have any number of members, so only a policy listing individual approvers can be unsatisfiable
+	individuals := 0
+	for _, s := range policy.Spec.Approvers {
+		if s.Kind == rbac.GroupKind {
+			return nil, nil
+		}
+		individuals++
+	}
+	if int32(individuals) < policy.GetMinApprovals() {
+		return admission.Warnings{fmt.Sprintf("spec.minApprovals %d is more than the %d listed approvers, selected ops requests can never be approved",
+			policy.GetMinApprovals(), individuals)}, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,89 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupOpsConcurrencyPolicyWebhookWithManager registers the webhook for OpsConcurrencyPolicy in the manager.
+func SetupOpsConcurrencyPolicyWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&opsapi.OpsConcurrencyPolicy{}).
+		WithValidator(&OpsConcurrencyPolicyCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type OpsConcurrencyPolicyCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var opsConcurrencyPolicyLog = logf.Log.WithName("opsconcurrencypolicy")
+
+var _ webhook.CustomValidator = &OpsConcurrencyPolicyCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsConcurrencyPolicyCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	p, ok := obj.(*opsapi.OpsConcurrencyPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpsConcurrencyPolicy object but got %T", obj)
+	}
+	opsConcurrencyPolicyLog.Info("validate create", "name", p.Name)
+	return w.validateCreateOrUpdate(p)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsConcurrencyPolicyCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	p, ok := newObj.(*opsapi.OpsConcurrencyPolicy)
+	if !ok {
+		return nil, fmt.Errorf("expected a OpsConcurrencyPolicy object but got %T", newObj)
+	}
+	opsConcurrencyPolicyLog.Info("validate update", "name", p.Name)
+	return w.validateCreateOrUpdate(p)
+}
+
+func (w *OpsConcurrencyPolicyCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (w *OpsConcurrencyPolicyCustomWebhook) validateCreateOrUpdate(p *opsapi.OpsConcurrencyPolicy) (admission.Warnings, error) {
+	if err := p.ValidateSpecs(); err != nil {
+		allErr := field.ErrorList{field.Invalid(field.NewPath("spec"), p.Name, err.Error())}
+		return nil, apierrors.NewInvalid(schema.GroupKind{Group: opsapi.SchemeGroupVersion.Group, Kind: opsapi.ResourceKindOpsConcurrencyPolicy}, p.Name, allErr)
+	}
+
+	var warnings admission.Warnings
+	for i, l := range p.Spec.Limits {
+		if len(l.OpsTypes) == 0 {
+			warnings = append(warnings, fmt.Sprintf("spec.limits[%d] counts ops requests of every type, including the ones that do not restart the database", i))
+		}
+	}
+	return warnings, nil
+}
@@ -0,0 +1,140 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/mergepatch"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	meta_util "kmodules.xyz/client-go/meta"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// SetupOpsPlanWebhookWithManager registers the webhook for OpsPlan in the manager.
+func SetupOpsPlanWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&opsapi.OpsPlan{}).
+		WithValidator(&OpsPlanCustomWebhook{mgr.GetClient()}).
+		Complete()
+}
+
+type OpsPlanCustomWebhook struct {
+	DefaultClient client.Client
+}
+
+// log is for logging in this package.
+var opsPlanLog = logf.Log.WithName("opsplan")
+
+var _ webhook.CustomValidator = &OpsPlanCustomWebhook{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsPlanCustomWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	plan, ok := obj.(*opsapi.OpsPlan)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsPlan object but got %T", obj)
+	}
+	opsPlanLog.Info("validate create", "name", plan.Name)
+	return nil, w.validateCreateOrUpdate(plan)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (w *OpsPlanCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	plan, ok := newObj.(*opsapi.OpsPlan)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsPlan object but got %T", newObj)
+	}
+	opsPlanLog.Info("validate update", "name", plan.Name)
+
+	oldPlan, ok := oldObj.(*opsapi.OpsPlan)
+	if !ok {
+		return nil, fmt.Errorf("expected an OpsPlan object but got %T", oldObj)
+	}
+
+	if err := validateOpsPlan(plan, oldPlan); err != nil {
+		return nil, err
+	}
+	return nil, w.validateCreateOrUpdate(plan)
+}
+
+func (w *OpsPlanCustomWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateOpsPlan rejects spec changes, since steps may already have been created from it.
+func validateOpsPlan(plan *opsapi.OpsPlan, oldPlan *opsapi.OpsPlan) error {
+	preconditions := meta_util.PreConditionSet{Set: sets.New[string]("spec")}
+	_, err := meta_util.CreateStrategicPatch(oldPlan, plan, preconditions.PreconditionFunc()...)
+	if err != nil {
+		if mergepatch.IsPreconditionFailed(err) {
+			return fmt.Errorf("%v.%v", err, preconditions.Error())
+		}
+		return err
+	}
+	return nil
+}
+
+func (w *OpsPlanCustomWebhook) validateCreateOrUpdate(plan *opsapi.OpsPlan) error {
+	var allErr field.ErrorList
+	if err := plan.ValidateSpecs(); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("steps"), plan.Name, err.Error()))
+	}
+
+	if len(allErr) == 0 {
+		for i, step := range plan.Spec.Steps {
+			path := field.NewPath("spec").Child("steps").Index(i)
+			allErr = append(allErr, validateOpsPlanStepRequest(plan, step.Request, path.Child("request"))...)
+			if step.Rollback != nil {
+				allErr = append(allErr, validateOpsPlanStepRequest(plan, *step.Rollback, path.Child("rollback"))...)
+			}
+		}
+	}
+
+	if len(allErr) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(schema.GroupKind{Group: opsapi.SchemeGroupVersion.Group, Kind: opsapi.ResourceKindOpsPlan}, plan.Name, allErr)
+}
+
+func validateOpsPlanStepRequest(plan *opsapi.OpsPlan, raw runtime.RawExtension, path *field.Path) field.ErrorList {
+	var allErr field.ErrorList
+	req, err := opsapi.DecodeOpsRequest(raw)
+	if err != nil {
+		return append(allErr, field.Invalid(path, plan.Name, err.Error()))
+	}
+	if ns := req.GetNamespace(); ns != "" && ns != plan.Namespace {
+		allErr = append(allErr, field.Invalid(path.Child("metadata", "namespace"), ns,
+			fmt.Sprintf("ops requests of an OpsPlan are created in the namespace of the plan %s", plan.Namespace)))
+	}
+	if req.GetDBRefName() == "" {
+		allErr = append(allErr, field.Required(path.Child("spec", "databaseRef", "name"), "databaseRef of the ops request is required"))
+	}
+	if req.GetRequestType() == "" {
+		allErr = append(allErr, field.Required(path.Child("spec", "type"), "type of the ops request is required"))
+	}
+	return allErr
+}
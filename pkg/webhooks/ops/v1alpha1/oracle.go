@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strings"
 
+	catalog "kubedb.dev/apimachinery/apis/catalog/v1alpha1"
 	"kubedb.dev/apimachinery/apis/kubedb"
 	dbapi "kubedb.dev/apimachinery/apis/kubedb/v1alpha2"
 	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
@@ -56,6 +57,9 @@ type OracleOpsRequestCustomWebhook struct {
 
 var oracleOpsReqLog = logf.Log.WithName("oracle-opsrequest")
 
+// oracleMaxDataGuardReplicas is the size limit of a Data Guard configuration: one primary and thirty standbys.
+const oracleMaxDataGuardReplicas = 31
+
 var _ webhook.CustomValidator = &OracleOpsRequestCustomWebhook{}
 
 // ValidateCreate implements webhook.Validator so a webhook will be registered for the type
@@ -65,7 +69,7 @@ func (w *OracleOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj
 		return nil, fmt.Errorf("expected an OracleOpsRequest object but got %T", obj)
 	}
 	oracleOpsReqLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -122,13 +126,38 @@ func (w *OracleOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Oracl
 			fmt.Sprintf("defined OpsRequestType %s is not supported, supported types for Oracle are %s", req.Spec.Type, strings.Join(opsapi.OracleOpsRequestTypeNames(), ", ")))
 	}
 
-	_, err := w.hasDatabaseRef(req)
+	db, err := w.hasDatabaseRef(req)
 	if err != nil {
 		return field.Invalid(field.NewPath("spec").Child("databaseRef"), req.Name, err.Error())
 	}
 
 	var allErr field.ErrorList
 	switch opsapi.OracleOpsRequestType(req.GetRequestType()) {
+	case opsapi.OracleOpsRequestTypeUpdateVersion:
+		if err := w.validateOracleUpdateVersionOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion"),
+				req.Name,
+				err.Error()))
+		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindOracleVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.OracleOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.OracleOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
+	case opsapi.OracleOpsRequestTypeHorizontalScaling:
+		if err := w.validateOracleHorizontalScalingOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizontalScaling"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.OracleOpsRequestTypeReconfigure:
 		if err := w.validateOracleReconfigurationOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("configuration"),
@@ -150,6 +179,12 @@ func (w *OracleOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Oracl
 	case opsapi.OracleOpsRequestTypeRestart:
 
 	}
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -168,6 +203,44 @@ func (w *OracleOpsRequestCustomWebhook) hasDatabaseRef(req *opsapi.OracleOpsRequ
 	return oracle, nil
 }
 
+func (w *OracleOpsRequestCustomWebhook) validateOracleUpdateVersionOpsRequest(db *dbapi.Oracle, req *opsapi.OracleOpsRequest) error {
+	updateVersionSpec := req.Spec.UpdateVersion
+	if updateVersionSpec == nil {
+		return errors.New("spec.updateVersion nil not supported in UpdateVersion type")
+	}
+
+	yes, err := IsUpgradable(w.DefaultClient, catalog.ResourceKindOracleVersion, db.Spec.Version, updateVersionSpec.TargetVersion)
+	if err != nil {
+		return err
+	}
+	if !yes {
+		return fmt.Errorf("upgrade from version %v to %v is not supported", db.Spec.Version, updateVersionSpec.TargetVersion)
+	}
+	return nil
+}
+
+func (w *OracleOpsRequestCustomWebhook) validateOracleHorizontalScalingOpsRequest(db *dbapi.Oracle, req *opsapi.OracleOpsRequest) error {
+	horizontalScalingSpec := req.Spec.HorizontalScaling
+	if horizontalScalingSpec == nil {
+		return errors.New("spec.horizontalScaling nil not supported in HorizontalScaling type")
+	}
+	if horizontalScalingSpec.Replicas == nil {
+		return errors.New("spec.horizontalScaling.replicas can not be empty")
+	}
+	if !db.IsDataGuardEnabled() {
+		return fmt.Errorf("horizontal scaling is only supported for Oracle in %s mode", dbapi.OracleModeDataGuard)
+	}
+	// a Data Guard configuration needs the primary and at least one standby
+	if *horizontalScalingSpec.Replicas < 2 {
+		return fmt.Errorf("spec.horizontalScaling.replicas %d can not be less than 2 in %s mode", *horizontalScalingSpec.Replicas, dbapi.OracleModeDataGuard)
+	}
+	if *horizontalScalingSpec.Replicas > oracleMaxDataGuardReplicas {
+		return fmt.Errorf("spec.horizontalScaling.replicas %d is more than %d, a Data Guard configuration has one primary and at most %d standbys",
+			*horizontalScalingSpec.Replicas, oracleMaxDataGuardReplicas, oracleMaxDataGuardReplicas-1)
+	}
+	return nil
+}
+
 func (w *OracleOpsRequestCustomWebhook) validateOracleReconfigureTLSOpsRequest(req *opsapi.OracleOpsRequest) error {
 	tls := req.Spec.TLS
 	if tls == nil {
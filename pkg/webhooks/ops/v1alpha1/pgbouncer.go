@@ -67,7 +67,7 @@ func (w *PgBouncerOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, o
 	}
 
 	pgbouncerLog.Info("validate create", "name", req.Name)
-	return nil, w.validateCreateOrUpdate(req)
+	return withImpactWarnings(ctx, w.DefaultClient, req, nil, w.validateCreateOrUpdate(req))
 }
 
 func (w *PgBouncerOpsRequestCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
@@ -112,6 +112,10 @@ func (w *PgBouncerOpsRequestCustomWebhook) validateCreateOrUpdate(obj *opsapi.Pg
 		return err
 	}
 
+	if err := validateRolloutStrategy(obj.GetRequestType(), obj.Spec.Rollout); err != nil {
+		return field.Invalid(field.NewPath("spec").Child("rollout"), obj.Name, err.Error())
+	}
+
 	var allErr field.ErrorList
 	switch obj.Spec.Type {
 	case opsapi.PgBouncerOpsRequestTypeHorizontalScaling:
@@ -124,6 +128,19 @@ func (w *PgBouncerOpsRequestCustomWebhook) validateCreateOrUpdate(obj *opsapi.Pg
 				obj.Name,
 				err.Error()))
 		}
+		if obj.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, obj, catalog.ResourceKindPgBouncerVersion, db.Spec.Version, obj.Spec.UpdateVersion.TargetVersion, obj.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					obj.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.PgBouncerOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, obj, &opsapi.PgBouncerOpsRequest{}, obj.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				obj.Name,
+				err.Error()))
+		}
 	case opsapi.PgBouncerOpsRequestTypeReconfigure:
 		return validatePgBouncerReconfigurationOpsRequest(obj, w.DefaultClient)
 	case opsapi.PgBouncerOpsRequestTypeRestart:
@@ -74,7 +74,7 @@ func (w *RedisOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, obj r
 	if err != nil {
 		return nil, err
 	}
-	return nil, w.validateCreateOrUpdate(req)
+	return withImpactWarnings(ctx, w.DefaultClient, req, nil, w.validateCreateOrUpdate(req))
 }
 
 func (w *RedisOpsRequestCustomWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
@@ -144,6 +144,13 @@ func (w *RedisOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.RedisO
 	switch opsapi.RedisOpsRequestType(req.GetRequestType()) {
 	case opsapi.RedisOpsRequestTypeRestart:
 
+	case opsapi.RedisOpsRequestTypeSwitchover:
+		if err := w.validateRedisSwitchoverOpsRequest(db, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("switchover"),
+				req.Name,
+				err.Error()))
+		}
+
 	case opsapi.RedisOpsRequestTypeHorizontalScaling:
 		if err := w.validateRedisHorizontalScalingOpsRequest(db, req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("horizontalScaling"),
@@ -168,6 +175,19 @@ func (w *RedisOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.RedisO
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindRedisVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.RedisOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.RedisOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.RedisOpsRequestTypeReconfigure:
 		if err := w.validateRedisReconfigureOpsRequest(req); err != nil {
 			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("configuration"),
@@ -205,6 +225,12 @@ func (w *RedisOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.RedisO
 				err.Error()))
 		}
 	}
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -555,3 +581,17 @@ func (w *RedisOpsRequestCustomWebhook) validateRedisReplaceSentinelOpsRequest(re
 
 	return nil
 }
+
+func (w *RedisOpsRequestCustomWebhook) validateRedisSwitchoverOpsRequest(db *dbapi.Redis, req *opsapi.RedisOpsRequest) error {
+	switch db.Spec.Mode {
+	case dbapi.RedisModeSentinel:
+	case dbapi.RedisModeCluster:
+		// each shard has a master of its own, the target replica picks the shard to fail over
+		if req.Spec.Switchover == nil || req.Spec.Switchover.Target == nil {
+			return fmt.Errorf("`spec.switchover.target` is required for Redis in %s mode", dbapi.RedisModeCluster)
+		}
+	default:
+		return fmt.Errorf("switchover is only supported for Redis in %s and %s modes", dbapi.RedisModeSentinel, dbapi.RedisModeCluster)
+	}
+	return validateSwitchoverTarget(w.DefaultClient, req, req.Spec.Switchover, db.OffshootSelectors())
+}
@@ -26,15 +26,20 @@ import (
 	"kubedb.dev/apimachinery/apis/kubedb"
 	dbapi "kubedb.dev/apimachinery/apis/kubedb/v1"
 	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+	"kubedb.dev/apimachinery/pkg/lib"
 
 	"github.com/Masterminds/semver/v3"
+	core "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 	cu "kmodules.xyz/client-go/client"
+	core_util "kmodules.xyz/client-go/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
 type DummyCatalog struct {
@@ -144,6 +149,178 @@ func getUpgradableVersions(allowList, denyList []string, versions *unstructured.
 	return allowedVersions, nil
 }
 
+// IsReversible returns true if the catalog of the current version declares an update to the target
+// version reversible, i.e. its spec.updateConstraints.reversible matches the target version.
+func IsReversible(kc client.Client, kind string, curr, target string) (bool, error) {
+	gvk := schema.GroupVersionKind{
+		Group:   catalog.SchemeGroupVersion.Group,
+		Version: catalog.SchemeGroupVersion.Version,
+		Kind:    kind,
+	}
+
+	var curVersion unstructured.Unstructured
+	curVersion.SetGroupVersionKind(gvk)
+	if err := kc.Get(context.Background(), types.NamespacedName{Name: curr}, &curVersion); err != nil {
+		return false, err
+	}
+	// read the field directly, since some catalogs (e.g. MySQLVersion) have their own update constraints type
+	reversible, _, err := unstructured.NestedStringSlice(curVersion.Object, "spec", "updateConstraints", "reversible")
+	if err != nil {
+		return false, err
+	}
+	if len(reversible) == 0 {
+		return false, nil
+	}
+
+	var targetVersion unstructured.Unstructured
+	targetVersion.SetGroupVersionKind(gvk)
+	if err := kc.Get(context.Background(), types.NamespacedName{Name: target}, &targetVersion); err != nil {
+		return false, err
+	}
+	list, err := getUpgradableVersions(reversible, nil, &unstructured.UnstructuredList{
+		Items: []unstructured.Unstructured{targetVersion},
+	})
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(list, target), nil
+}
+
+// validateUpdateVersionRollback checks that an UpdateVersion request with rollback enabled updates
+// to a version that is declared reversible.
+func validateUpdateVersionRollback(kc client.Client, req opsapi.Accessor, kind string, curr, target string, rollback *opsapi.UpdateVersionRollbackSpec) error {
+	if rollback == nil {
+		return nil
+	}
+	if phase := req.GetStatus().Phase; phase != "" && phase != opsapi.OpsRequestPhasePending {
+		return nil
+	}
+	if rollback.VolumeSnapshotClassName != nil && *rollback.VolumeSnapshotClassName == "" {
+		return errors.New("`rollback.volumeSnapshotClassName` must not be empty")
+	}
+	yes, err := IsReversible(kc, kind, curr, target)
+	if err != nil {
+		return err
+	}
+	if !yes {
+		return fmt.Errorf("update from version %v to %v is not declared reversible in %s %s", curr, target, kind, curr)
+	}
+	return nil
+}
+
+// validateRollbackOpsRequest checks the UpdateVersion request a Rollback request refers to. updated
+// must be an empty ops request of the same kind as req.
+func validateRollbackOpsRequest(kc client.Client, req, updated opsapi.Accessor, rollback *opsapi.RollbackSpec) error {
+	if rollback == nil {
+		return errors.New("`spec.rollback` nil not supported in Rollback type")
+	}
+	if phase := req.GetStatus().Phase; phase != "" && phase != opsapi.OpsRequestPhasePending {
+		return nil
+	}
+	if rollback.OpsRequestRef.Name == "" {
+		return errors.New("`spec.rollback.opsRequestRef.name` is required")
+	}
+	if err := kc.Get(context.TODO(), types.NamespacedName{Namespace: req.GetNamespace(), Name: rollback.OpsRequestRef.Name}, updated); err != nil {
+		return err
+	}
+	if updated.GetRequestType() != "UpdateVersion" {
+		return fmt.Errorf("ops request %s is of type %s, only UpdateVersion requests can be rolled back", updated.GetName(), updated.GetRequestType())
+	}
+	if updated.GetDBRefName() != req.GetDBRefName() {
+		return fmt.Errorf("ops request %s updated database %s, not %s", updated.GetName(), updated.GetDBRefName(), req.GetDBRefName())
+	}
+	if !isOpsReqCompleted(updated.GetStatus().Phase) {
+		return fmt.Errorf("ops request %s is still in %s phase", updated.GetName(), updated.GetStatus().Phase)
+	}
+	state := updated.GetStatus().PreUpdateState
+	if state == nil {
+		return fmt.Errorf("ops request %s has no pre-update state, it was created without `spec.updateVersion.rollback`", updated.GetName())
+	}
+	if state.RolledBack {
+		return fmt.Errorf("ops request %s has already been rolled back", updated.GetName())
+	}
+	if rollback.RestoreVolumes && len(state.VolumeSnapshots) == 0 {
+		return fmt.Errorf("ops request %s has no volume snapshots to restore", updated.GetName())
+	}
+	return nil
+}
+
+// validateSwitchoverTarget checks the spec of a Switchover request. The target, if any, must be a ready
+// pod of the database, i.e. a pod matching selector.
+func validateSwitchoverTarget(kc client.Client, req opsapi.Accessor, switchover *opsapi.SwitchoverSpec, selector map[string]string) error {
+	if switchover == nil {
+		return nil
+	}
+	if switchover.MaxLag != nil && switchover.MaxLag.Duration < 0 {
+		return errors.New("`spec.switchover.maxLag` can not be negative")
+	}
+	if switchover.Target == nil {
+		return nil
+	}
+	if phase := req.GetStatus().Phase; phase != "" && phase != opsapi.OpsRequestPhasePending {
+		return nil
+	}
+	var pod core.Pod
+	if err := kc.Get(context.TODO(), types.NamespacedName{Namespace: req.GetNamespace(), Name: switchover.Target.Name}, &pod); err != nil {
+		return fmt.Errorf("`spec.switchover.target` pod %s/%s is invalid or not found", req.GetNamespace(), switchover.Target.Name)
+	}
+	if !labels.SelectorFromSet(selector).Matches(labels.Set(pod.Labels)) {
+		return fmt.Errorf("`spec.switchover.target` pod %s is not a member of database %s", pod.Name, req.GetDBRefName())
+	}
+	if pod.Labels[kubedb.LabelRole] == kubedb.DatabasePodPrimary {
+		return fmt.Errorf("`spec.switchover.target` pod %s is already the primary", pod.Name)
+	}
+	if !core_util.IsPodReady(&pod) {
+		return fmt.Errorf("`spec.switchover.target` pod %s is not ready", pod.Name)
+	}
+	return nil
+}
+
+// canaryRolloutTypes are the ops request types that support the canary rollout strategy.
+var canaryRolloutTypes = []string{"VerticalScaling", "UpdateVersion", "Reconfigure", "ReconfigureTLS"}
+
+// validateRolloutStrategy checks the rollout strategy of an ops request.
+func validateRolloutStrategy(typ string, rollout *opsapi.RolloutStrategy) error {
+	if rollout == nil || rollout.Canary == nil {
+		return nil
+	}
+	if !slices.Contains(canaryRolloutTypes, typ) {
+		return fmt.Errorf("`spec.rollout.canary` is not supported for %s, supported types are %v", typ, canaryRolloutTypes)
+	}
+	canary := rollout.Canary
+	if canary.BakeTime != nil && canary.BakeTime.Duration < 0 {
+		return errors.New("`spec.rollout.canary.bakeTime` can not be negative")
+	}
+	if canary.MaxReplicationLag != nil && canary.MaxReplicationLag.Duration < 0 {
+		return errors.New("`spec.rollout.canary.maxReplicationLag` can not be negative")
+	}
+	if hc := canary.HealthCheck; hc != nil {
+		for name, v := range map[string]*int32{"periodSeconds": hc.PeriodSeconds, "timeoutSeconds": hc.TimeoutSeconds, "failureThreshold": hc.FailureThreshold} {
+			if v != nil && *v < 1 {
+				return fmt.Errorf("`spec.rollout.canary.healthCheck.%s` must be at least 1", name)
+			}
+		}
+		if hc.PeriodSeconds != nil && hc.TimeoutSeconds != nil && *hc.TimeoutSeconds >= *hc.PeriodSeconds {
+			return errors.New("`spec.rollout.canary.healthCheck.timeoutSeconds` must be less than periodSeconds")
+		}
+	}
+	return nil
+}
+
+// withImpactWarnings appends the estimated impact of a valid ops request to the warnings of its validation.
+// A failed estimate is reported as a warning too, it never rejects the request.
+func withImpactWarnings(ctx context.Context, kc client.Client, req opsapi.Accessor, warnings admission.Warnings, err error) (admission.Warnings, error) {
+	if err != nil {
+		return warnings, err
+	}
+	estimate, err := lib.EstimateImpact(ctx, kc, req)
+	if err != nil {
+		klog.Warningf("failed to estimate the impact of %s/%s: %v", req.GetNamespace(), req.GetName(), err)
+		return append(warnings, fmt.Sprintf("impact could not be estimated: %v", err)), nil
+	}
+	return append(warnings, lib.ImpactWarnings(req.GetRequestType(), estimate)...), nil
+}
+
 func isOpsReqCompleted(phase opsapi.OpsRequestPhase) bool {
 	return phase == opsapi.OpsRequestPhaseSuccessful || phase == opsapi.OpsRequestPhaseFailed
 }
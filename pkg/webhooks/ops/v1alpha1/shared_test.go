@@ -0,0 +1,101 @@
+/*
+Copyright AppsCode Inc. and Contributors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"kubedb.dev/apimachinery/apis/kubedb"
+	opsapi "kubedb.dev/apimachinery/apis/ops/v1alpha1"
+
+	core "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// podClient is a minimal client.Client mock for Pod lookups.
+type podClient struct {
+	client.Client
+	pods map[string]*core.Pod
+}
+
+func (m *podClient) Get(_ context.Context, key types.NamespacedName, obj client.Object, _ ...client.GetOption) error {
+	pod, ok := obj.(*core.Pod)
+	if !ok {
+		return nil
+	}
+	p, found := m.pods[key.Name]
+	if !found {
+		return apierrors.NewNotFound(schema.GroupResource{Resource: "pods"}, key.Name)
+	}
+	*pod = *p
+	return nil
+}
+
+func makeSwitchoverPod(name, role string, ready bool) *core.Pod {
+	pod := &core.Pod{}
+	pod.Name = name
+	pod.Namespace = "demo"
+	pod.Labels = map[string]string{"app": "pg", kubedb.LabelRole: role}
+	status := core.ConditionFalse
+	if ready {
+		status = core.ConditionTrue
+	}
+	pod.Status.Conditions = []core.PodCondition{{Type: core.PodReady, Status: status}}
+	return pod
+}
+
+func TestValidateSwitchoverTarget(t *testing.T) {
+	kc := &podClient{pods: map[string]*core.Pod{
+		"pg-0": makeSwitchoverPod("pg-0", kubedb.DatabasePodPrimary, true),
+		"pg-1": makeSwitchoverPod("pg-1", kubedb.DatabasePodStandby, true),
+		"pg-2": makeSwitchoverPod("pg-2", kubedb.DatabasePodStandby, false),
+	}}
+	selector := map[string]string{"app": "pg"}
+
+	tests := []struct {
+		name    string
+		target  string
+		wantErr string
+	}{
+		{name: "standby", target: "pg-1"},
+		{name: "primary", target: "pg-0", wantErr: "is already the primary"},
+		{name: "not ready", target: "pg-2", wantErr: "is not ready"},
+		{name: "missing", target: "pg-3", wantErr: "not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &opsapi.PostgresOpsRequest{}
+			req.Namespace = "demo"
+			switchover := &opsapi.SwitchoverSpec{Target: &core.LocalObjectReference{Name: tt.target}}
+			err := validateSwitchoverTarget(kc, req, switchover, selector)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -67,7 +67,7 @@ func (w *WeaviateOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, ob
 		return nil, fmt.Errorf("expected an WeaviateOpsRequest object but got %T", obj)
 	}
 	weaviateOpsReqLog.Info("validate create", "name", ops.Name)
-	return nil, w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, nil, w.validateCreateOrUpdate(ops))
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -172,6 +172,19 @@ func (w *WeaviateOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Wea
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(w.DefaultClient, req, catalog.ResourceKindWeaviateVersion, db.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.WeaviateOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(w.DefaultClient, req, &opsapi.WeaviateOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 
 	case opsapi.WeaviateOpsRequestTypeVerticalScaling:
 		if err := w.validateWeaviateVerticalScalingOpsRequest(req); err != nil {
@@ -188,6 +201,12 @@ func (w *WeaviateOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Wea
 		}
 	}
 
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
+	}
+
 	if len(allErr) == 0 {
 		return nil
 	}
@@ -28,6 +28,7 @@ import (
 	opsutil "kubedb.dev/apimachinery/pkg/webhooks/ops"
 
 	"gomodules.xyz/x/arrays"
+	storagev1 "k8s.io/api/storage/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -66,7 +67,8 @@ func (w *ZooKeeperOpsRequestCustomWebhook) ValidateCreate(ctx context.Context, o
 		return nil, fmt.Errorf("expected an ZooKeeperOpsRequest object but got %T", obj)
 	}
 	zookeeperLog.Info("validate create", "name", ops.Name)
-	return w.validateCreateOrUpdate(ops)
+	warnings, err := w.validateCreateOrUpdate(ops)
+	return withImpactWarnings(ctx, w.DefaultClient, ops, warnings, err)
 }
 
 // ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
@@ -139,6 +141,19 @@ func (z *ZooKeeperOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Zo
 				req.Name,
 				err.Error()))
 		}
+		if req.Spec.UpdateVersion != nil {
+			if err := validateUpdateVersionRollback(z.DefaultClient, req, catalog.ResourceKindZooKeeperVersion, zookeeper.Spec.Version, req.Spec.UpdateVersion.TargetVersion, req.Spec.UpdateVersion.Rollback); err != nil {
+				allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("updateVersion").Child("rollback"),
+					req.Name,
+					err.Error()))
+			}
+		}
+	case opsapi.ZooKeeperOpsRequestTypeRollback:
+		if err := validateRollbackOpsRequest(z.DefaultClient, req, &opsapi.ZooKeeperOpsRequest{}, req.Spec.Rollback); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollback"),
+				req.Name,
+				err.Error()))
+		}
 	case opsapi.ZooKeeperOpsRequestTypeVerticalScaling:
 		warns, err := z.validateZooKeeperVerticalScalingOpsRequest(req)
 		warnings = append(warnings, warns...)
@@ -165,6 +180,18 @@ func (z *ZooKeeperOpsRequestCustomWebhook) validateCreateOrUpdate(req *opsapi.Zo
 				req.Name,
 				err.Error()))
 		}
+	case opsapi.ZooKeeperOpsRequestTypeStorageMigration:
+		if err := z.validateZooKeeperStorageMigrationOpsRequest(zookeeper, req); err != nil {
+			allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("migration"),
+				req.Name,
+				err.Error()))
+		}
+	}
+
+	if err := validateRolloutStrategy(req.GetRequestType(), req.Spec.Rollout); err != nil {
+		allErr = append(allErr, field.Invalid(field.NewPath("spec").Child("rollout"),
+			req.Name,
+			err.Error()))
 	}
 
 	if len(allErr) == 0 {
@@ -268,3 +295,35 @@ func (z *ZooKeeperOpsRequestCustomWebhook) validateZooKeeperHorizontalScalingOps
 	}
 	return nil
 }
+
+func (z *ZooKeeperOpsRequestCustomWebhook) validateZooKeeperStorageMigrationOpsRequest(db *olddbapi.ZooKeeper, req *opsapi.ZooKeeperOpsRequest) error {
+	m := req.Spec.Migration
+	if m == nil {
+		return errors.New("spec.migration is required for StorageMigration type")
+	}
+	if m.StorageClassName == nil {
+		return errors.New("spec.migration.storageClassName is required")
+	}
+	if req.Spec.Timeout == nil {
+		return errors.New("spec.timeout is required for Storage Migration ops request, adjust timeout according to the size of your database")
+	}
+	if db.Spec.Storage == nil || db.Spec.Storage.StorageClassName == nil {
+		return fmt.Errorf("db.Spec.Storage.StorageClassName can't be nil in the database yaml")
+	}
+	var newstorage, oldstorage storagev1.StorageClass
+	if err := z.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: *m.StorageClassName}, &newstorage); err != nil {
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("storage class %s not found: %w", *m.StorageClassName, err)
+		}
+		return err
+	}
+	if err := z.DefaultClient.Get(context.TODO(), types.NamespacedName{Name: *db.Spec.Storage.StorageClassName}, &oldstorage); err != nil {
+		return err
+	}
+	if *oldstorage.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer {
+		if *newstorage.VolumeBindingMode != storagev1.VolumeBindingWaitForFirstConsumer {
+			return fmt.Errorf("volume binding mode should be WaitForFirstConsumer for %s storageClass", newstorage.Name)
+		}
+	}
+	return nil
+}